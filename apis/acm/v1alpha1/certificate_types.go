@@ -100,7 +100,10 @@ type ResourceRecord struct {
 type CertificateParameters struct {
 
 	// Region is the region you'd like your Certificate to be created in.
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// The Amazon Resource Name (ARN) of the private certificate authority (CA)that will be used to issue the certificate.
 	// +optional
@@ -25,7 +25,10 @@ import (
 // CertificateAuthorityParameters defines the desired state of an AWS CertificateAuthority.
 type CertificateAuthorityParameters struct {
 	// Region is the region you'd like your CertificateAuthority to be created in.
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// Type of the certificate authority
 	// +kubebuilder:validation:Enum=ROOT;SUBORDINATE
@@ -36,7 +36,10 @@ type CertificateAuthorityPermissionStatus struct {
 type CertificateAuthorityPermissionParameters struct {
 
 	// Region is the region of CertificateAuthorityPermission.
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// The Amazon Resource Name (ARN) of the private certificate authority (CA)that will be used to issue the certificate.
 	// +immutable
@@ -27,8 +27,10 @@ import (
 // APIParameters defines the desired state of API
 type APIParameters struct {
 	// Region is which region the API will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	APIKeySelectionExpression *string `json:"apiKeySelectionExpression,omitempty"`
 
@@ -27,8 +27,10 @@ import (
 // APIMappingParameters defines the desired state of APIMapping
 type APIMappingParameters struct {
 	// Region is which region the APIMapping will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	APIMappingKey              *string `json:"apiMappingKey,omitempty"`
 	CustomAPIMappingParameters `json:",inline"`
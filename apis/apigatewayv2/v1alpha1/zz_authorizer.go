@@ -27,8 +27,10 @@ import (
 // AuthorizerParameters defines the desired state of Authorizer
 type AuthorizerParameters struct {
 	// Region is which region the Authorizer will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	AuthorizerCredentialsARN *string `json:"authorizerCredentialsARN,omitempty"`
 
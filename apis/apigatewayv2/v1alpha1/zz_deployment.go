@@ -27,8 +27,10 @@ import (
 // DeploymentParameters defines the desired state of Deployment
 type DeploymentParameters struct {
 	// Region is which region the Deployment will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	Description *string `json:"description,omitempty"`
 
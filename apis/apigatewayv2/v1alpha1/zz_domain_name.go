@@ -27,8 +27,10 @@ import (
 // DomainNameParameters defines the desired state of DomainName
 type DomainNameParameters struct {
 	// Region is which region the DomainName will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	DomainNameConfigurations []*DomainNameConfiguration `json:"domainNameConfigurations,omitempty"`
 
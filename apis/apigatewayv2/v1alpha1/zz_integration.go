@@ -27,8 +27,10 @@ import (
 // IntegrationParameters defines the desired state of Integration
 type IntegrationParameters struct {
 	// Region is which region the Integration will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	ConnectionID *string `json:"connectionID,omitempty"`
 
@@ -27,8 +27,10 @@ import (
 // IntegrationResponseParameters defines the desired state of IntegrationResponse
 type IntegrationResponseParameters struct {
 	// Region is which region the IntegrationResponse will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	ContentHandlingStrategy *string `json:"contentHandlingStrategy,omitempty"`
 
@@ -27,8 +27,10 @@ import (
 // ModelParameters defines the desired state of Model
 type ModelParameters struct {
 	// Region is which region the Model will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	ContentType *string `json:"contentType,omitempty"`
 
@@ -27,8 +27,10 @@ import (
 // RouteResponseParameters defines the desired state of RouteResponse
 type RouteResponseParameters struct {
 	// Region is which region the RouteResponse will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	ModelSelectionExpression *string `json:"modelSelectionExpression,omitempty"`
 
@@ -27,8 +27,10 @@ import (
 // StageParameters defines the desired state of Stage
 type StageParameters struct {
 	// Region is which region the Stage will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	AccessLogSettings *AccessLogSettings `json:"accessLogSettings,omitempty"`
 
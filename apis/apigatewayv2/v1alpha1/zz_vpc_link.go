@@ -27,8 +27,10 @@ import (
 // VPCLinkParameters defines the desired state of VPCLink
 type VPCLinkParameters struct {
 	// Region is which region the VPCLink will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// +kubebuilder:validation:Required
 	Name *string `json:"name"`
@@ -27,8 +27,10 @@ import (
 // WorkGroupParameters defines the desired state of WorkGroup
 type WorkGroupParameters struct {
 	// Region is which region the WorkGroup will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The configuration for the workgroup, which includes the location in Amazon
 	// S3 where query results are stored, the encryption configuration, if any,
 	// used for encrypting query results, whether the Amazon CloudWatch Metrics
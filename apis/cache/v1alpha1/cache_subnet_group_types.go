@@ -24,7 +24,10 @@ import (
 // CacheSubnetGroupParameters define the desired state of an AWS ElasticCache Subnet Group.
 type CacheSubnetGroupParameters struct {
 	// Region is the region you'd like your CacheSubnetGroup to be created in.
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// A description for the cache subnet group.
 	Description string `json:"description"`
@@ -27,8 +27,10 @@ import (
 // CachePolicyParameters defines the desired state of CachePolicy
 type CachePolicyParameters struct {
 	// Region is which region the CachePolicy will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// A cache policy configuration.
 	// +kubebuilder:validation:Required
 	CachePolicyConfig           *CachePolicyConfig `json:"cachePolicyConfig"`
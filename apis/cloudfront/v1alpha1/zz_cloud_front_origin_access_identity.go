@@ -27,8 +27,10 @@ import (
 // CloudFrontOriginAccessIdentityParameters defines the desired state of CloudFrontOriginAccessIdentity
 type CloudFrontOriginAccessIdentityParameters struct {
 	// Region is which region the CloudFrontOriginAccessIdentity will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The current configuration information for the identity.
 	// +kubebuilder:validation:Required
 	CloudFrontOriginAccessIdentityConfig           *OriginAccessIdentityConfig `json:"cloudFrontOriginAccessIdentityConfig"`
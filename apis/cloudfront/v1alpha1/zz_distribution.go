@@ -27,8 +27,10 @@ import (
 // DistributionParameters defines the desired state of Distribution
 type DistributionParameters struct {
 	// Region is which region the Distribution will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The distribution's configuration information.
 	// +kubebuilder:validation:Required
 	DistributionConfig           *DistributionConfig `json:"distributionConfig"`
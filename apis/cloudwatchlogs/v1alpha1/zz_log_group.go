@@ -27,8 +27,10 @@ import (
 // LogGroupParameters defines the desired state of LogGroup
 type LogGroupParameters struct {
 	// Region is which region the LogGroup will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The name of the log group.
 	// +kubebuilder:validation:Required
 	LogGroupName *string `json:"logGroupName"`
@@ -27,8 +27,10 @@ import (
 // DBClusterParameterGroupParameters defines the desired state of DBClusterParameterGroup
 type DBClusterParameterGroupParameters struct {
 	// Region is which region the DBClusterParameterGroup will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The cluster parameter group family name.
 	// +kubebuilder:validation:Required
 	DBParameterGroupFamily *string `json:"dbParameterGroupFamily"`
@@ -27,8 +27,10 @@ import (
 // DBSubnetGroupParameters defines the desired state of DBSubnetGroup
 type DBSubnetGroupParameters struct {
 	// Region is which region the DBSubnetGroup will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The description for the subnet group.
 	// +kubebuilder:validation:Required
 	DBSubnetGroupDescription *string `json:"dbSubnetGroupDescription"`
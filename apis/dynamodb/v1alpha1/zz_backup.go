@@ -27,8 +27,10 @@ import (
 // BackupParameters defines the desired state of Backup
 type BackupParameters struct {
 	// Region is which region the Backup will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// Specified name for the backup.
 	// +kubebuilder:validation:Required
 	BackupName             *string `json:"backupName"`
@@ -27,8 +27,10 @@ import (
 // GlobalTableParameters defines the desired state of GlobalTable
 type GlobalTableParameters struct {
 	// Region is which region the GlobalTable will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The Regions where the global table needs to be created.
 	// +kubebuilder:validation:Required
 	ReplicationGroup            []*Replica `json:"replicationGroup"`
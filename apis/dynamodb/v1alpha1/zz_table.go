@@ -27,8 +27,10 @@ import (
 // TableParameters defines the desired state of Table
 type TableParameters struct {
 	// Region is which region the Table will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// An array of attributes that describe the key schema for the table and indexes.
 	// +kubebuilder:validation:Required
 	AttributeDefinitions []*AttributeDefinition `json:"attributeDefinitions"`
@@ -18,9 +18,76 @@ package manualv1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// Power states an Instance may be kept in.
+const (
+	PowerStateRunning    = "running"
+	PowerStateStopped    = "stopped"
+	PowerStateHibernated = "hibernated"
+)
+
+// TypePowerState indicates whether an Instance has reached its desired power
+// state, or is being stopped and started by the controller.
+const TypePowerState xpv1.ConditionType = "PowerState"
+
+// Reasons an Instance is or is not in its desired power state.
+const (
+	ReasonStoppingToModify  xpv1.ConditionReason = "StoppingToModify"
+	ReasonStopping          xpv1.ConditionReason = "Stopping"
+	ReasonStarting          xpv1.ConditionReason = "Starting"
+	ReasonPowerStateReached xpv1.ConditionReason = "PowerStateReached"
+)
+
+// StoppingToModify returns a condition that indicates the Instance is being
+// stopped so that attributes which can only be modified while it is stopped
+// can be applied. The Instance is started again once they are applied, unless
+// its desired power state is stopped or hibernated.
+func StoppingToModify() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePowerState,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonStoppingToModify,
+		Message:            "Stopping the instance to modify attributes that require it to be stopped",
+	}
+}
+
+// Stopping returns a condition that indicates the Instance is being stopped
+// to reach its desired power state.
+func Stopping() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePowerState,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonStopping,
+	}
+}
+
+// Starting returns a condition that indicates the Instance is being started,
+// either to reach its desired power state or after it has been modified.
+func Starting() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePowerState,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonStarting,
+	}
+}
+
+// PowerStateReached returns a condition that indicates the Instance is in its
+// desired power state.
+func PowerStateReached() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePowerState,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPowerStateReached,
+	}
+}
+
 // InstanceParameters define the desired state of the Instances
 type InstanceParameters struct {
 	// The block device mapping entries.
@@ -165,8 +232,17 @@ type InstanceParameters struct {
 	// If you do not specify a key pair, you can't connect to the instance unless
 	// you choose an AMI that is configured to allow users another way to log in.
 	// +optional
+	// +crossplane:generate:reference:type=KeyPair
 	KeyName *string `json:"keyName,omitempty"`
 
+	// KeyNameRef references a KeyPair to retrieve its name.
+	// +optional
+	KeyNameRef *xpv1.Reference `json:"keyNameRef,omitempty"`
+
+	// KeyNameSelector selects a reference to a KeyPair to retrieve its name.
+	// +optional
+	KeyNameSelector *xpv1.Selector `json:"keyNameSelector,omitempty"`
+
 	// The launch template to use to launch the instances. Any parameters that you
 	// specify in RunInstances override the same parameters in the launch template.
 	// You can specify either the name or ID of a launch template, but not both.
@@ -196,6 +272,19 @@ type InstanceParameters struct {
 	// +optional
 	Placement *Placement `json:"placement,omitempty"`
 
+	// PowerState is the desired power state of the instance. A running
+	// instance is stopped, or hibernated, when it is set to stopped or
+	// hibernated, and a stopped instance is started when it is set to running.
+	// Hibernation must be enabled through HibernationOptions when the instance
+	// is launched. The power state is not managed when PowerState is unset.
+	//
+	// Regardless of PowerState, an instance is stopped to modify InstanceType,
+	// KernelID, RAMDiskID or UserData, and is started again afterwards unless
+	// its desired power state is stopped or hibernated.
+	// +optional
+	// +kubebuilder:validation:Enum=running;stopped;hibernated
+	PowerState string `json:"powerState,omitempty"`
+
 	// [EC2-VPC] The primary IPv4 address. You must specify a value from the IPv4
 	// address range of the subnet.
 	//
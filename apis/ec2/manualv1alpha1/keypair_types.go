@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// KeyPairPrivateKey is the key of the connection secret of a KeyPair that
+// holds the unencrypted PEM encoded private key created by AWS.
+const KeyPairPrivateKey = "privateKey"
+
+// KeyPairParameters define the desired state of an AWS EC2 Key Pair. The name
+// of the key pair is the external name of the KeyPair.
+type KeyPairParameters struct {
+	// Region is the region you'd like your KeyPair to be created in.
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// PublicKeySecretRef references the key of a Secret that holds the
+	// public key to import, in OpenSSH or base64 encoded DER format. If not
+	// set, AWS creates the key pair and its private key is published to the
+	// connection secret of the KeyPair under the privateKey key, which must
+	// then be set. The private key is only returned once, when the key pair
+	// is created.
+	// +optional
+	// +immutable
+	PublicKeySecretRef *xpv1.SecretKeySelector `json:"publicKeySecretRef,omitempty"`
+
+	// The type of key pair to create. Only used when AWS creates the key
+	// pair.
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=rsa;ed25519
+	KeyType *string `json:"keyType,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A KeyPairSpec defines the desired state of a KeyPair.
+type KeyPairSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KeyPairParameters `json:"forProvider"`
+}
+
+// KeyPairObservation keeps the state for the external resource
+type KeyPairObservation struct {
+	// KeyPairID is the ID of the key pair.
+	KeyPairID string `json:"keyPairId,omitempty"`
+
+	// The fingerprint of the key pair. For key pairs created by AWS it is the
+	// SHA-1 digest of the DER encoded private key, for imported key pairs the
+	// MD5 public key fingerprint.
+	KeyFingerprint string `json:"keyFingerprint,omitempty"`
+
+	// The type of the key pair.
+	KeyType string `json:"keyType,omitempty"`
+}
+
+// A KeyPairStatus represents the observed state of a KeyPair.
+type KeyPairStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KeyPairObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KeyPair is a managed resource that represents an AWS EC2 Key Pair, used
+// to prove the identity of a user connecting to an instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.keyPairId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type KeyPair struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeyPairSpec   `json:"spec"`
+	Status KeyPairStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyPairList contains a list of KeyPairs
+type KeyPairList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KeyPair `json:"items"`
+}
@@ -0,0 +1,286 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Actions of a NetworkACLRule.
+const (
+	NetworkACLRuleActionAllow = "allow"
+	NetworkACLRuleActionDeny  = "deny"
+)
+
+// NetworkACLRule describes a single numbered rule of a network ACL. Rules are
+// evaluated in ascending order of their rule numbers, and the first rule that
+// matches the traffic is applied.
+type NetworkACLRule struct {
+	// The rule number of the entry. Rule numbers must be unique per direction
+	// within a network ACL.
+	//
+	// Constraints: Positive integer from 1 to 32766. The number 32767 is
+	// reserved for the default rule that denies all traffic.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	RuleNumber int32 `json:"ruleNumber"`
+
+	// The protocol number or name (tcp, udp, icmp, icmpv6). A value of "-1"
+	// means all protocols. If you specify "-1" or a protocol other than tcp,
+	// udp, icmp or icmpv6, traffic on all ports is allowed, regardless of any
+	// ports you specify.
+	Protocol string `json:"protocol"`
+
+	// Indicates whether to allow or deny the traffic that matches the rule.
+	// +kubebuilder:validation:Enum=allow;deny
+	RuleAction string `json:"ruleAction"`
+
+	// The IPv4 network range to allow or deny, in CIDR notation (for example
+	// 172.16.0.0/24).
+	// +optional
+	CIDRBlock *string `json:"cidrBlock,omitempty"`
+
+	// The IPv6 network range to allow or deny, in CIDR notation (for example
+	// 2001:db8:1234:1a00::/64).
+	// +optional
+	IPv6CIDRBlock *string `json:"ipv6CidrBlock,omitempty"`
+
+	// The first port in the range. Required for tcp and udp.
+	// +optional
+	FromPort *int32 `json:"fromPort,omitempty"`
+
+	// The last port in the range. Required for tcp and udp.
+	// +optional
+	ToPort *int32 `json:"toPort,omitempty"`
+
+	// The ICMP type. A value of -1 means all types. Required for icmp and
+	// icmpv6.
+	// +optional
+	ICMPType *int32 `json:"icmpType,omitempty"`
+
+	// The ICMP code. A value of -1 means all codes for the given ICMP type.
+	// Required for icmp and icmpv6.
+	// +optional
+	ICMPCode *int32 `json:"icmpCode,omitempty"`
+}
+
+// NetworkACLParameters define the desired state of an AWS VPC Network ACL.
+type NetworkACLParameters struct {
+	// Region is the region you'd like your NetworkACL to be created in.
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// VPCID is the ID of the VPC.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.VPC
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	// +immutable
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets associated with the network ACL.
+	// A subnet is associated with exactly one network ACL at a time, so
+	// associating it moves it away from its current network ACL. Subnets
+	// that are removed from this list are moved back to the default network
+	// ACL of the VPC.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.Subnet
+	// +crossplane:generate:reference:refFieldName=SubnetIDRefs
+	// +crossplane:generate:reference:selectorFieldName=SubnetIDSelector
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a list of references to Subnets used to set
+	// the SubnetIDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// Ingress is the list of inbound rules of the network ACL. Rules are
+	// matched to the existing entries by their rule numbers. If Ingress is
+	// not set, the inbound entries are not managed by this resource so that
+	// they can be managed through NetworkACLEntry resources instead; an empty
+	// list removes all inbound entries. Do not use in-line rules in
+	// conjunction with NetworkACLEntry resources of the same direction.
+	// +optional
+	Ingress []NetworkACLRule `json:"ingress,omitempty"`
+
+	// Egress is the list of outbound rules of the network ACL. It follows the
+	// same rules as Ingress.
+	// +optional
+	Egress []NetworkACLRule `json:"egress,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A NetworkACLSpec defines the desired state of a NetworkACL.
+type NetworkACLSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkACLParameters `json:"forProvider"`
+}
+
+// NetworkACLAssociation describes an association between a network ACL and a
+// subnet.
+type NetworkACLAssociation struct {
+	// The ID of the association between a network ACL and a subnet.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// The ID of the subnet.
+	SubnetID string `json:"subnetId,omitempty"`
+}
+
+// NetworkACLObservation keeps the state for the external resource
+type NetworkACLObservation struct {
+	// NetworkACLID is the ID of the network ACL.
+	NetworkACLID string `json:"networkAclId,omitempty"`
+
+	// The ID of the AWS account that owns the network ACL.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// Indicates whether this is the default network ACL for the VPC.
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// The actual associations of the network ACL with subnets.
+	Associations []NetworkACLAssociation `json:"associations,omitempty"`
+}
+
+// A NetworkACLStatus represents the observed state of a NetworkACL.
+type NetworkACLStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetworkACLObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkACL is a managed resource that represents an AWS VPC Network ACL,
+// an optional layer of stateless firewalling for the subnets of a VPC.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NetworkACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkACLSpec   `json:"spec"`
+	Status NetworkACLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkACLList contains a list of NetworkACLs
+type NetworkACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkACL `json:"items"`
+}
+
+// NetworkACLEntryParameters define the desired state of a single rule of an
+// AWS VPC Network ACL.
+type NetworkACLEntryParameters struct {
+	// Region is the region you'd like your NetworkACLEntry to be created in.
+	// It must be the region of the network ACL.
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// NetworkACLID is the ID of the network ACL the entry belongs to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=NetworkACL
+	NetworkACLID *string `json:"networkAclId,omitempty"`
+
+	// NetworkACLIDRef references a NetworkACL to retrieve its ID.
+	// +optional
+	NetworkACLIDRef *xpv1.Reference `json:"networkAclIdRef,omitempty"`
+
+	// NetworkACLIDSelector selects a reference to a NetworkACL to retrieve
+	// its ID.
+	// +optional
+	NetworkACLIDSelector *xpv1.Selector `json:"networkAclIdSelector,omitempty"`
+
+	// Indicates whether this is an egress rule (rule is applied to traffic
+	// leaving the subnet).
+	// +optional
+	// +immutable
+	Egress bool `json:"egress,omitempty"`
+
+	// NetworkACLRule is the rule of the entry. Together with NetworkACLID and
+	// Egress its rule number identifies the entry and cannot be changed.
+	NetworkACLRule `json:",inline"`
+}
+
+// A NetworkACLEntrySpec defines the desired state of a NetworkACLEntry.
+type NetworkACLEntrySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkACLEntryParameters `json:"forProvider"`
+}
+
+// A NetworkACLEntryStatus represents the observed state of a NetworkACLEntry.
+type NetworkACLEntryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkACLEntry is a managed resource that represents a single numbered
+// rule of an AWS VPC Network ACL. An entry has no ID of its own; it is
+// identified by its network ACL, its direction and its rule number.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ACL",type="string",JSONPath=".spec.forProvider.networkAclId"
+// +kubebuilder:printcolumn:name="EGRESS",type="boolean",JSONPath=".spec.forProvider.egress"
+// +kubebuilder:printcolumn:name="RULE",type="integer",JSONPath=".spec.forProvider.ruleNumber"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NetworkACLEntry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkACLEntrySpec   `json:"spec"`
+	Status NetworkACLEntryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkACLEntryList contains a list of NetworkACLEntries
+type NetworkACLEntryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkACLEntry `json:"items"`
+}
@@ -56,7 +56,52 @@ var (
 	InstanceGroupVersionKind = SchemeGroupVersion.WithKind(InstanceKind)
 )
 
+// SecurityGroupRule type metadata.
+var (
+	SecurityGroupRuleKind             = reflect.TypeOf(SecurityGroupRule{}).Name()
+	SecurityGroupRuleGroupKind        = schema.GroupKind{Group: Group, Kind: SecurityGroupRuleKind}.String()
+	SecurityGroupRuleKindAPIVersion   = SecurityGroupRuleKind + "." + SchemeGroupVersion.String()
+	SecurityGroupRuleGroupVersionKind = SchemeGroupVersion.WithKind(SecurityGroupRuleKind)
+)
+
+// NetworkACL type metadata.
+var (
+	NetworkACLKind             = reflect.TypeOf(NetworkACL{}).Name()
+	NetworkACLGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkACLKind}.String()
+	NetworkACLKindAPIVersion   = NetworkACLKind + "." + SchemeGroupVersion.String()
+	NetworkACLGroupVersionKind = SchemeGroupVersion.WithKind(NetworkACLKind)
+)
+
+// NetworkACLEntry type metadata.
+var (
+	NetworkACLEntryKind             = reflect.TypeOf(NetworkACLEntry{}).Name()
+	NetworkACLEntryGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkACLEntryKind}.String()
+	NetworkACLEntryKindAPIVersion   = NetworkACLEntryKind + "." + SchemeGroupVersion.String()
+	NetworkACLEntryGroupVersionKind = SchemeGroupVersion.WithKind(NetworkACLEntryKind)
+)
+
+// KeyPair type metadata.
+var (
+	KeyPairKind             = reflect.TypeOf(KeyPair{}).Name()
+	KeyPairGroupKind        = schema.GroupKind{Group: Group, Kind: KeyPairKind}.String()
+	KeyPairKindAPIVersion   = KeyPairKind + "." + SchemeGroupVersion.String()
+	KeyPairGroupVersionKind = SchemeGroupVersion.WithKind(KeyPairKind)
+)
+
+// ManagedPrefixList type metadata.
+var (
+	ManagedPrefixListKind             = reflect.TypeOf(ManagedPrefixList{}).Name()
+	ManagedPrefixListGroupKind        = schema.GroupKind{Group: Group, Kind: ManagedPrefixListKind}.String()
+	ManagedPrefixListKindAPIVersion   = ManagedPrefixListKind + "." + SchemeGroupVersion.String()
+	ManagedPrefixListGroupVersionKind = SchemeGroupVersion.WithKind(ManagedPrefixListKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&VPCCIDRBlock{}, &VPCCIDRBlockList{})
 	SchemeBuilder.Register(&Instance{}, &InstanceList{})
+	SchemeBuilder.Register(&SecurityGroupRule{}, &SecurityGroupRuleList{})
+	SchemeBuilder.Register(&NetworkACL{}, &NetworkACLList{})
+	SchemeBuilder.Register(&NetworkACLEntry{}, &NetworkACLEntryList{})
+	SchemeBuilder.Register(&KeyPair{}, &KeyPairList{})
+	SchemeBuilder.Register(&ManagedPrefixList{}, &ManagedPrefixListList{})
 }
@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Types of SecurityGroupRules.
+const (
+	SecurityGroupRuleTypeIngress = "ingress"
+	SecurityGroupRuleTypeEgress  = "egress"
+)
+
+// SecurityGroupRuleParameters define the desired state of a single rule of an
+// AWS VPC Security Group.
+type SecurityGroupRuleParameters struct {
+	// Region is the region you'd like your SecurityGroupRule to be created in.
+	// It must be the region of the security group.
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Type is whether the rule is an ingress or an egress rule of the security
+	// group.
+	// +immutable
+	// +kubebuilder:validation:Enum=ingress;egress
+	Type string `json:"type"`
+
+	// SecurityGroupID is the ID of the security group the rule belongs to.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.SecurityGroup
+	SecurityGroupID *string `json:"securityGroupId,omitempty"`
+
+	// SecurityGroupIDRef references a SecurityGroup to retrieve its ID.
+	// +optional
+	SecurityGroupIDRef *xpv1.Reference `json:"securityGroupIdRef,omitempty"`
+
+	// SecurityGroupIDSelector selects a reference to a SecurityGroup to
+	// retrieve its ID.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// The IP protocol name (tcp, udp, icmp, icmpv6) or number (see Protocol Numbers
+	// (http://www.iana.org/assignments/protocol-numbers/protocol-numbers.xhtml)).
+	//
+	// Use -1 to specify all protocols. Specifying -1 or a protocol number other
+	// than tcp, udp, icmp, or icmpv6 allows traffic on all ports, regardless of
+	// any port range you specify.
+	IPProtocol string `json:"ipProtocol"`
+
+	// The start of port range for the TCP and UDP protocols, or an ICMP/ICMPv6
+	// type number. A value of -1 indicates all ICMP/ICMPv6 types.
+	// +optional
+	FromPort *int32 `json:"fromPort,omitempty"`
+
+	// The end of port range for the TCP and UDP protocols, or an ICMP/ICMPv6 code.
+	// A value of -1 indicates all ICMP/ICMPv6 codes.
+	// +optional
+	ToPort *int32 `json:"toPort,omitempty"`
+
+	// The IPv4 CIDR range the rule allows traffic from or to. Exactly one of
+	// CIDRIP, CIDRIPv6, PrefixListID and SourceSecurityGroupID must be set.
+	// +optional
+	CIDRIP *string `json:"cidrIp,omitempty"`
+
+	// The IPv6 CIDR range the rule allows traffic from or to.
+	// +optional
+	CIDRIPv6 *string `json:"cidrIpv6,omitempty"`
+
+	// The ID of the prefix list the rule allows traffic from or to.
+	// +optional
+	// +crossplane:generate:reference:type=ManagedPrefixList
+	PrefixListID *string `json:"prefixListId,omitempty"`
+
+	// PrefixListIDRef references a ManagedPrefixList to retrieve its ID.
+	// +optional
+	PrefixListIDRef *xpv1.Reference `json:"prefixListIdRef,omitempty"`
+
+	// PrefixListIDSelector selects a reference to a ManagedPrefixList to
+	// retrieve its ID.
+	// +optional
+	PrefixListIDSelector *xpv1.Selector `json:"prefixListIdSelector,omitempty"`
+
+	// SourceSecurityGroupID is the ID of the security group the rule allows
+	// traffic from, or to if it is an egress rule.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.SecurityGroup
+	SourceSecurityGroupID *string `json:"sourceSecurityGroupId,omitempty"`
+
+	// SourceSecurityGroupIDRef references a SecurityGroup to retrieve its ID.
+	// +optional
+	SourceSecurityGroupIDRef *xpv1.Reference `json:"sourceSecurityGroupIdRef,omitempty"`
+
+	// SourceSecurityGroupIDSelector selects a reference to a SecurityGroup to
+	// retrieve its ID.
+	// +optional
+	SourceSecurityGroupIDSelector *xpv1.Selector `json:"sourceSecurityGroupIdSelector,omitempty"`
+
+	// A description for the rule.
+	//
+	// Constraints: Up to 255 characters in length. Allowed characters are a-z,
+	// A-Z, 0-9, spaces, and ._-:/()#,@[]+=;{}!$*
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// A SecurityGroupRuleSpec defines the desired state of a SecurityGroupRule.
+type SecurityGroupRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecurityGroupRuleParameters `json:"forProvider"`
+}
+
+// SecurityGroupRuleObservation keeps the state for the external resource
+type SecurityGroupRuleObservation struct {
+	// SecurityGroupRuleID is the ID of the rule.
+	SecurityGroupRuleID string `json:"securityGroupRuleId,omitempty"`
+
+	// The AWS account ID of the owner of the security group.
+	GroupOwnerID string `json:"groupOwnerId,omitempty"`
+}
+
+// A SecurityGroupRuleStatus represents the observed state of a
+// SecurityGroupRule.
+type SecurityGroupRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SecurityGroupRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SecurityGroupRule is a managed resource that represents a single ingress
+// or egress rule of an AWS VPC Security Group. Rules can be added to a
+// security group that is managed elsewhere, e.g. a SecurityGroup that
+// preserves unowned rules.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="GROUP",type="string",JSONPath=".spec.forProvider.securityGroupId"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SecurityGroupRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecurityGroupRuleSpec   `json:"spec"`
+	Status SecurityGroupRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityGroupRuleList contains a list of SecurityGroupRules
+type SecurityGroupRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityGroupRule `json:"items"`
+}
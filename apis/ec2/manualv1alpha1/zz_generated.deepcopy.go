@@ -1136,6 +1136,16 @@ func (in *InstanceParameters) DeepCopyInto(out *InstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.KeyNameRef != nil {
+		in, out := &in.KeyNameRef, &out.KeyNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KeyNameSelector != nil {
+		in, out := &in.KeyNameSelector, &out.KeyNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LaunchTemplate != nil {
 		in, out := &in.LaunchTemplate, &out.LaunchTemplate
 		*out = new(LaunchTemplateSpecification)
@@ -1313,6 +1323,144 @@ func (in *InstanceStatus) DeepCopy() *InstanceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPair) DeepCopyInto(out *KeyPair) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPair.
+func (in *KeyPair) DeepCopy() *KeyPair {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyPair) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairList) DeepCopyInto(out *KeyPairList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyPair, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairList.
+func (in *KeyPairList) DeepCopy() *KeyPairList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyPairList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairObservation) DeepCopyInto(out *KeyPairObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairObservation.
+func (in *KeyPairObservation) DeepCopy() *KeyPairObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairParameters) DeepCopyInto(out *KeyPairParameters) {
+	*out = *in
+	if in.PublicKeySecretRef != nil {
+		in, out := &in.PublicKeySecretRef, &out.PublicKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.KeyType != nil {
+		in, out := &in.KeyType, &out.KeyType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairParameters.
+func (in *KeyPairParameters) DeepCopy() *KeyPairParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairSpec) DeepCopyInto(out *KeyPairSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairSpec.
+func (in *KeyPairSpec) DeepCopy() *KeyPairSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairStatus) DeepCopyInto(out *KeyPairStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairStatus.
+func (in *KeyPairStatus) DeepCopy() *KeyPairStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LaunchTemplateSpecification) DeepCopyInto(out *LaunchTemplateSpecification) {
 	*out = *in
@@ -1364,131 +1512,839 @@ func (in *LicenseConfigurationRequest) DeepCopy() *LicenseConfigurationRequest {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+func (in *ManagedPrefixList) DeepCopyInto(out *ManagedPrefixList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
-func (in *Monitoring) DeepCopy() *Monitoring {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixList.
+func (in *ManagedPrefixList) DeepCopy() *ManagedPrefixList {
 	if in == nil {
 		return nil
 	}
-	out := new(Monitoring)
+	out := new(ManagedPrefixList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedPrefixList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Placement) DeepCopyInto(out *Placement) {
+func (in *ManagedPrefixListList) DeepCopyInto(out *ManagedPrefixListList) {
 	*out = *in
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(string)
-		**out = **in
-	}
-	if in.AvailabilityZone != nil {
-		in, out := &in.AvailabilityZone, &out.AvailabilityZone
-		*out = new(string)
-		**out = **in
-	}
-	if in.GroupName != nil {
-		in, out := &in.GroupName, &out.GroupName
-		*out = new(string)
-		**out = **in
-	}
-	if in.HostID != nil {
-		in, out := &in.HostID, &out.HostID
-		*out = new(string)
-		**out = **in
-	}
-	if in.HostResourceGroupARN != nil {
-		in, out := &in.HostResourceGroupARN, &out.HostResourceGroupARN
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManagedPrefixList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.PartitionNumber != nil {
-		in, out := &in.PartitionNumber, &out.PartitionNumber
-		*out = new(int32)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixListList.
+func (in *ManagedPrefixListList) DeepCopy() *ManagedPrefixListList {
+	if in == nil {
+		return nil
 	}
-	if in.SpreadDomain != nil {
-		in, out := &in.SpreadDomain, &out.SpreadDomain
-		*out = new(string)
-		**out = **in
+	out := new(ManagedPrefixListList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedPrefixListList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Placement.
-func (in *Placement) DeepCopy() *Placement {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedPrefixListObservation) DeepCopyInto(out *ManagedPrefixListObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixListObservation.
+func (in *ManagedPrefixListObservation) DeepCopy() *ManagedPrefixListObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(Placement)
+	out := new(ManagedPrefixListObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrivateIPAddressSpecification) DeepCopyInto(out *PrivateIPAddressSpecification) {
+func (in *ManagedPrefixListParameters) DeepCopyInto(out *ManagedPrefixListParameters) {
 	*out = *in
-	if in.Primary != nil {
-		in, out := &in.Primary, &out.Primary
-		*out = new(bool)
-		**out = **in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]PrefixListEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
-		*out = new(string)
-		**out = **in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateIPAddressSpecification.
-func (in *PrivateIPAddressSpecification) DeepCopy() *PrivateIPAddressSpecification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixListParameters.
+func (in *ManagedPrefixListParameters) DeepCopy() *ManagedPrefixListParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(PrivateIPAddressSpecification)
+	out := new(ManagedPrefixListParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProductCode) DeepCopyInto(out *ProductCode) {
+func (in *ManagedPrefixListSpec) DeepCopyInto(out *ManagedPrefixListSpec) {
 	*out = *in
-	if in.ProductCodeID != nil {
-		in, out := &in.ProductCodeID, &out.ProductCodeID
-		*out = new(string)
-		**out = **in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixListSpec.
+func (in *ManagedPrefixListSpec) DeepCopy() *ManagedPrefixListSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(ManagedPrefixListSpec)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductCode.
-func (in *ProductCode) DeepCopy() *ProductCode {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedPrefixListStatus) DeepCopyInto(out *ManagedPrefixListStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixListStatus.
+func (in *ManagedPrefixListStatus) DeepCopy() *ManagedPrefixListStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ProductCode)
+	out := new(ManagedPrefixListStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunInstancesMonitoringEnabled) DeepCopyInto(out *RunInstancesMonitoringEnabled) {
+func (in *Monitoring) DeepCopyInto(out *Monitoring) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
+func (in *Monitoring) DeepCopy() *Monitoring {
+	if in == nil {
+		return nil
 	}
+	out := new(Monitoring)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunInstancesMonitoringEnabled.
-func (in *RunInstancesMonitoringEnabled) DeepCopy() *RunInstancesMonitoringEnabled {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACL) DeepCopyInto(out *NetworkACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACL.
+func (in *NetworkACL) DeepCopy() *NetworkACL {
 	if in == nil {
 		return nil
 	}
-	out := new(RunInstancesMonitoringEnabled)
+	out := new(NetworkACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLAssociation) DeepCopyInto(out *NetworkACLAssociation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLAssociation.
+func (in *NetworkACLAssociation) DeepCopy() *NetworkACLAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntry) DeepCopyInto(out *NetworkACLEntry) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntry.
+func (in *NetworkACLEntry) DeepCopy() *NetworkACLEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACLEntry) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntryList) DeepCopyInto(out *NetworkACLEntryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkACLEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntryList.
+func (in *NetworkACLEntryList) DeepCopy() *NetworkACLEntryList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACLEntryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntryParameters) DeepCopyInto(out *NetworkACLEntryParameters) {
+	*out = *in
+	if in.NetworkACLID != nil {
+		in, out := &in.NetworkACLID, &out.NetworkACLID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkACLIDRef != nil {
+		in, out := &in.NetworkACLIDRef, &out.NetworkACLIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.NetworkACLIDSelector != nil {
+		in, out := &in.NetworkACLIDSelector, &out.NetworkACLIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.NetworkACLRule.DeepCopyInto(&out.NetworkACLRule)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntryParameters.
+func (in *NetworkACLEntryParameters) DeepCopy() *NetworkACLEntryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntrySpec) DeepCopyInto(out *NetworkACLEntrySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntrySpec.
+func (in *NetworkACLEntrySpec) DeepCopy() *NetworkACLEntrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntryStatus) DeepCopyInto(out *NetworkACLEntryStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntryStatus.
+func (in *NetworkACLEntryStatus) DeepCopy() *NetworkACLEntryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLList) DeepCopyInto(out *NetworkACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLList.
+func (in *NetworkACLList) DeepCopy() *NetworkACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLObservation) DeepCopyInto(out *NetworkACLObservation) {
+	*out = *in
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]NetworkACLAssociation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLObservation.
+func (in *NetworkACLObservation) DeepCopy() *NetworkACLObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLParameters) DeepCopyInto(out *NetworkACLParameters) {
+	*out = *in
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDRefs != nil {
+		in, out := &in.SubnetIDRefs, &out.SubnetIDRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]NetworkACLRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]NetworkACLRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLParameters.
+func (in *NetworkACLParameters) DeepCopy() *NetworkACLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLRule) DeepCopyInto(out *NetworkACLRule) {
+	*out = *in
+	if in.CIDRBlock != nil {
+		in, out := &in.CIDRBlock, &out.CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6CIDRBlock != nil {
+		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ICMPType != nil {
+		in, out := &in.ICMPType, &out.ICMPType
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ICMPCode != nil {
+		in, out := &in.ICMPCode, &out.ICMPCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLRule.
+func (in *NetworkACLRule) DeepCopy() *NetworkACLRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLSpec) DeepCopyInto(out *NetworkACLSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLSpec.
+func (in *NetworkACLSpec) DeepCopy() *NetworkACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLStatus) DeepCopyInto(out *NetworkACLStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLStatus.
+func (in *NetworkACLStatus) DeepCopy() *NetworkACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Placement) DeepCopyInto(out *Placement) {
+	*out = *in
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostID != nil {
+		in, out := &in.HostID, &out.HostID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostResourceGroupARN != nil {
+		in, out := &in.HostResourceGroupARN, &out.HostResourceGroupARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.PartitionNumber != nil {
+		in, out := &in.PartitionNumber, &out.PartitionNumber
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SpreadDomain != nil {
+		in, out := &in.SpreadDomain, &out.SpreadDomain
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Placement.
+func (in *Placement) DeepCopy() *Placement {
+	if in == nil {
+		return nil
+	}
+	out := new(Placement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrefixListEntry) DeepCopyInto(out *PrefixListEntry) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixListEntry.
+func (in *PrefixListEntry) DeepCopy() *PrefixListEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(PrefixListEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateIPAddressSpecification) DeepCopyInto(out *PrivateIPAddressSpecification) {
+	*out = *in
+	if in.Primary != nil {
+		in, out := &in.Primary, &out.Primary
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateIPAddressSpecification.
+func (in *PrivateIPAddressSpecification) DeepCopy() *PrivateIPAddressSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateIPAddressSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductCode) DeepCopyInto(out *ProductCode) {
+	*out = *in
+	if in.ProductCodeID != nil {
+		in, out := &in.ProductCodeID, &out.ProductCodeID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductCode.
+func (in *ProductCode) DeepCopy() *ProductCode {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunInstancesMonitoringEnabled) DeepCopyInto(out *RunInstancesMonitoringEnabled) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunInstancesMonitoringEnabled.
+func (in *RunInstancesMonitoringEnabled) DeepCopy() *RunInstancesMonitoringEnabled {
+	if in == nil {
+		return nil
+	}
+	out := new(RunInstancesMonitoringEnabled)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRule.
+func (in *SecurityGroupRule) DeepCopy() *SecurityGroupRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleList) DeepCopyInto(out *SecurityGroupRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecurityGroupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleList.
+func (in *SecurityGroupRuleList) DeepCopy() *SecurityGroupRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleObservation) DeepCopyInto(out *SecurityGroupRuleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleObservation.
+func (in *SecurityGroupRuleObservation) DeepCopy() *SecurityGroupRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleParameters) DeepCopyInto(out *SecurityGroupRuleParameters) {
+	*out = *in
+	if in.SecurityGroupID != nil {
+		in, out := &in.SecurityGroupID, &out.SecurityGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroupIDRef != nil {
+		in, out := &in.SecurityGroupIDRef, &out.SecurityGroupIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SecurityGroupIDSelector != nil {
+		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CIDRIP != nil {
+		in, out := &in.CIDRIP, &out.CIDRIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.CIDRIPv6 != nil {
+		in, out := &in.CIDRIPv6, &out.CIDRIPv6
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrefixListID != nil {
+		in, out := &in.PrefixListID, &out.PrefixListID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrefixListIDRef != nil {
+		in, out := &in.PrefixListIDRef, &out.PrefixListIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PrefixListIDSelector != nil {
+		in, out := &in.PrefixListIDSelector, &out.PrefixListIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceSecurityGroupID != nil {
+		in, out := &in.SourceSecurityGroupID, &out.SourceSecurityGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceSecurityGroupIDRef != nil {
+		in, out := &in.SourceSecurityGroupIDRef, &out.SourceSecurityGroupIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SourceSecurityGroupIDSelector != nil {
+		in, out := &in.SourceSecurityGroupIDSelector, &out.SourceSecurityGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleParameters.
+func (in *SecurityGroupRuleParameters) DeepCopy() *SecurityGroupRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleSpec) DeepCopyInto(out *SecurityGroupRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleSpec.
+func (in *SecurityGroupRuleSpec) DeepCopy() *SecurityGroupRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleStatus) DeepCopyInto(out *SecurityGroupRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleStatus.
+func (in *SecurityGroupRuleStatus) DeepCopy() *SecurityGroupRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+// ResolveReferences of this LaunchTemplate
+func (mg *LaunchTemplate) ResolveReferences(ctx context.Context, c client.Reader) error {
+	if mg.Spec.ForProvider.LaunchTemplateData == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.launchTemplateData.keyName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LaunchTemplateData.KeyName),
+		Reference:    mg.Spec.ForProvider.KeyNameRef,
+		Selector:     mg.Spec.ForProvider.KeyNameSelector,
+		To:           reference.To{Managed: &manualv1alpha1.KeyPair{}, List: &manualv1alpha1.KeyPairList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.launchTemplateData.keyName")
+	}
+	mg.Spec.ForProvider.LaunchTemplateData.KeyName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.KeyNameRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -2204,6 +2204,16 @@ func (in *CustomLaunchTemplateParameters) DeepCopyInto(out *CustomLaunchTemplate
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.KeyNameRef != nil {
+		in, out := &in.KeyNameRef, &out.KeyNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KeyNameSelector != nil {
+		in, out := &in.KeyNameSelector, &out.KeyNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomLaunchTemplateParameters.
@@ -2344,6 +2354,21 @@ func (in *CustomRouteParameters) DeepCopyInto(out *CustomRouteParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DestinationPrefixListID != nil {
+		in, out := &in.DestinationPrefixListID, &out.DestinationPrefixListID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationPrefixListIDRef != nil {
+		in, out := &in.DestinationPrefixListIDRef, &out.DestinationPrefixListIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DestinationPrefixListIDSelector != nil {
+		in, out := &in.DestinationPrefixListIDSelector, &out.DestinationPrefixListIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRouteParameters.
@@ -8802,67 +8827,6 @@ func (in *LocalGatewayVirtualInterfaceGroup) DeepCopy() *LocalGatewayVirtualInte
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedPrefixList) DeepCopyInto(out *ManagedPrefixList) {
-	*out = *in
-	if in.AddressFamily != nil {
-		in, out := &in.AddressFamily, &out.AddressFamily
-		*out = new(string)
-		**out = **in
-	}
-	if in.MaxEntries != nil {
-		in, out := &in.MaxEntries, &out.MaxEntries
-		*out = new(int64)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PrefixListID != nil {
-		in, out := &in.PrefixListID, &out.PrefixListID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PrefixListName != nil {
-		in, out := &in.PrefixListName, &out.PrefixListName
-		*out = new(string)
-		**out = **in
-	}
-	if in.StateMessage != nil {
-		in, out := &in.StateMessage, &out.StateMessage
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Version != nil {
-		in, out := &in.Version, &out.Version
-		*out = new(int64)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixList.
-func (in *ManagedPrefixList) DeepCopy() *ManagedPrefixList {
-	if in == nil {
-		return nil
-	}
-	out := new(ManagedPrefixList)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModifyTransitGatewayOptions) DeepCopyInto(out *ModifyTransitGatewayOptions) {
 	*out = *in
@@ -9117,52 +9081,6 @@ func (in *NATGatewayAddress) DeepCopy() *NATGatewayAddress {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkACL) DeepCopyInto(out *NetworkACL) {
-	*out = *in
-	if in.IsDefault != nil {
-		in, out := &in.IsDefault, &out.IsDefault
-		*out = new(bool)
-		**out = **in
-	}
-	if in.NetworkACLID != nil {
-		in, out := &in.NetworkACLID, &out.NetworkACLID
-		*out = new(string)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACL.
-func (in *NetworkACL) DeepCopy() *NetworkACL {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkACL)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkACLAssociation) DeepCopyInto(out *NetworkACLAssociation) {
 	*out = *in
@@ -9193,46 +9111,6 @@ func (in *NetworkACLAssociation) DeepCopy() *NetworkACLAssociation {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkACLEntry) DeepCopyInto(out *NetworkACLEntry) {
-	*out = *in
-	if in.CIDRBlock != nil {
-		in, out := &in.CIDRBlock, &out.CIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.Egress != nil {
-		in, out := &in.Egress, &out.Egress
-		*out = new(bool)
-		**out = **in
-	}
-	if in.IPv6CIDRBlock != nil {
-		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.Protocol != nil {
-		in, out := &in.Protocol, &out.Protocol
-		*out = new(string)
-		**out = **in
-	}
-	if in.RuleNumber != nil {
-		in, out := &in.RuleNumber, &out.RuleNumber
-		*out = new(int64)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntry.
-func (in *NetworkACLEntry) DeepCopy() *NetworkACLEntry {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkACLEntry)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkInsightsAnalysis) DeepCopyInto(out *NetworkInsightsAnalysis) {
 	*out = *in
@@ -11813,11 +11691,6 @@ func (in *RouteParameters) DeepCopyInto(out *RouteParameters) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.DestinationPrefixListID != nil {
-		in, out := &in.DestinationPrefixListID, &out.DestinationPrefixListID
-		*out = new(string)
-		**out = **in
-	}
 	if in.EgressOnlyInternetGatewayID != nil {
 		in, out := &in.EgressOnlyInternetGatewayID, &out.EgressOnlyInternetGatewayID
 		*out = new(string)
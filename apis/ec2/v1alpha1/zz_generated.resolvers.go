@@ -157,6 +157,22 @@ func (mg *Route) ResolveReferences(ctx context.Context, c client.Reader) error {
 	mg.Spec.ForProvider.CustomRouteParameters.GatewayID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.CustomRouteParameters.GatewayIDRef = rsp.ResolvedReference
 
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListID),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListIDRef,
+		Selector:     mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListIDSelector,
+		To: reference.To{
+			List:    &manualv1alpha1.ManagedPrefixListList{},
+			Managed: &manualv1alpha1.ManagedPrefixList{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListID")
+	}
+	mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListIDRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -27,8 +27,10 @@ import (
 // LaunchTemplateParameters defines the desired state of LaunchTemplate
 type LaunchTemplateParameters struct {
 	// Region is which region the LaunchTemplate will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The information for the launch template.
 	// +kubebuilder:validation:Required
 	LaunchTemplateData *RequestLaunchTemplateData `json:"launchTemplateData"`
@@ -27,8 +27,10 @@ import (
 // RouteParameters defines the desired state of Route
 type RouteParameters struct {
 	// Region is which region the Route will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The ID of the carrier gateway.
 	//
 	// You can only use this option when the VPC contains a subnet which is associated
@@ -42,8 +44,6 @@ type RouteParameters struct {
 	// The IPv6 CIDR block used for the destination match. Routing decisions are
 	// based on the most specific match.
 	DestinationIPv6CIDRBlock *string `json:"destinationIPv6CIDRBlock,omitempty"`
-	// The ID of a prefix list used for the destination match.
-	DestinationPrefixListID *string `json:"destinationPrefixListID,omitempty"`
 	// [IPv6 traffic only] The ID of an egress-only internet gateway.
 	EgressOnlyInternetGatewayID *string `json:"egressOnlyInternetGatewayID,omitempty"`
 	// The ID of the local gateway.
@@ -27,8 +27,10 @@ import (
 // TransitGatewayParameters defines the desired state of TransitGateway
 type TransitGatewayParameters struct {
 	// Region is which region the TransitGateway will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// A description of the transit gateway.
 	Description *string `json:"description,omitempty"`
 	// The transit gateway options.
@@ -27,8 +27,10 @@ import (
 // TransitGatewayRouteParameters defines the desired state of TransitGatewayRoute
 type TransitGatewayRouteParameters struct {
 	// Region is which region the TransitGatewayRoute will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// Indicates whether to drop traffic that matches this route.
 	Blackhole *bool `json:"blackhole,omitempty"`
 	// The CIDR range used for destination matches. Routing decisions are based
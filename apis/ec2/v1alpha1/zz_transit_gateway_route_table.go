@@ -27,8 +27,10 @@ import (
 // TransitGatewayRouteTableParameters defines the desired state of TransitGatewayRouteTable
 type TransitGatewayRouteTableParameters struct {
 	// Region is which region the TransitGatewayRouteTable will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The tags to apply to the transit gateway route table.
 	TagSpecifications                        []*TagSpecification `json:"tagSpecifications,omitempty"`
 	CustomTransitGatewayRouteTableParameters `json:",inline"`
@@ -27,8 +27,10 @@ import (
 // TransitGatewayVPCAttachmentParameters defines the desired state of TransitGatewayVPCAttachment
 type TransitGatewayVPCAttachmentParameters struct {
 	// Region is which region the TransitGatewayVPCAttachment will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The VPC attachment options.
 	Options *CreateTransitGatewayVPCAttachmentRequestOptions `json:"options,omitempty"`
 	// The tags to apply to the VPC attachment.
@@ -2362,25 +2362,6 @@ type LocalGatewayVirtualInterfaceGroup struct {
 	Tags []*Tag `json:"tags,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type ManagedPrefixList struct {
-	AddressFamily *string `json:"addressFamily,omitempty"`
-
-	MaxEntries *int64 `json:"maxEntries,omitempty"`
-
-	OwnerID *string `json:"ownerID,omitempty"`
-
-	PrefixListID *string `json:"prefixListID,omitempty"`
-
-	PrefixListName *string `json:"prefixListName,omitempty"`
-
-	StateMessage *string `json:"stateMessage,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-
-	Version *int64 `json:"version,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type ModifyTransitGatewayOptions struct {
 	AutoAcceptSharedAttachments *string `json:"autoAcceptSharedAttachments,omitempty"`
@@ -2463,19 +2444,6 @@ type NATGatewayAddress struct {
 	PublicIP *string `json:"publicIP,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type NetworkACL struct {
-	IsDefault *bool `json:"isDefault,omitempty"`
-
-	NetworkACLID *string `json:"networkACLID,omitempty"`
-
-	OwnerID *string `json:"ownerID,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-
-	VPCID *string `json:"vpcID,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type NetworkACLAssociation struct {
 	NetworkACLAssociationID *string `json:"networkACLAssociationID,omitempty"`
@@ -2485,19 +2453,6 @@ type NetworkACLAssociation struct {
 	SubnetID *string `json:"subnetID,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type NetworkACLEntry struct {
-	CIDRBlock *string `json:"cidrBlock,omitempty"`
-
-	Egress *bool `json:"egress,omitempty"`
-
-	IPv6CIDRBlock *string `json:"ipv6CIDRBlock,omitempty"`
-
-	Protocol *string `json:"protocol,omitempty"`
-
-	RuleNumber *int64 `json:"ruleNumber,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type NetworkInsightsAnalysis struct {
 	NetworkPathFound *bool `json:"networkPathFound,omitempty"`
@@ -27,8 +27,10 @@ import (
 // VolumeParameters defines the desired state of Volume
 type VolumeParameters struct {
 	// Region is which region the Volume will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The Availability Zone in which to create the volume.
 	// +kubebuilder:validation:Required
 	AvailabilityZone *string `json:"availabilityZone"`
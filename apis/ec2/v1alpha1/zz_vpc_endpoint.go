@@ -27,8 +27,10 @@ import (
 // VPCEndpointParameters defines the desired state of VPCEndpoint
 type VPCEndpointParameters struct {
 	// Region is which region the VPCEndpoint will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// Unique, case-sensitive identifier that you provide to ensure the idempotency
 	// of the request. For more information, see How to Ensure Idempotency (https://docs.aws.amazon.com/AWSEC2/latest/APIReference/Run_Instance_Idempotency.html).
 	ClientToken *string `json:"clientToken,omitempty"`
@@ -27,8 +27,10 @@ import (
 // VPCPeeringConnectionParameters defines the desired state of VPCPeeringConnection
 type VPCPeeringConnectionParameters struct {
 	// Region is which region the VPCPeeringConnection will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The AWS account ID of the owner of the accepter VPC.
 	//
 	// Default: Your AWS account ID
@@ -25,7 +25,10 @@ import (
 // AddressParameters define the desired state of an AWS Elastic IP
 type AddressParameters struct {
 	// Region is the region you'd like your Address to be created in.
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// [EC2-VPC] The Elastic IP address to recover or an IPv4 address from an address
 	// pool.
@@ -23,8 +23,11 @@ type NATGatewayParameters struct {
 	// keeping it optional for now. Reconsider before v1beta2 or v1.
 
 	// Region is the region you'd like your NATGateway to be created in.
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
 	// +immutable
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// AllocationID is the Elastic IP allocation ID
 	// +immutable
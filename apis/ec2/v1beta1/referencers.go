@@ -21,12 +21,28 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
 
+const (
+	errGetManagedPrefixList   = "cannot get referenced ManagedPrefixList"
+	errListManagedPrefixLists = "cannot list ManagedPrefixLists that match selector"
+	errNoManagedPrefixList    = "no ManagedPrefixLists matched selector"
+	errNoManagedPrefixListID  = "referenced ManagedPrefixList has no ID (it may not yet be ready)"
+)
+
+// managedPrefixListGVK is the kind of ManagedPrefixLists. They are resolved as
+// unstructured objects because the manualv1alpha1 API group imports this one.
+var managedPrefixListGVK = schema.GroupVersionKind{Group: Group, Version: "v1alpha1", Kind: "ManagedPrefixList"}
+
 // SecurityGroupName returns the spec.groupName of a SecurityGroup.
 func SecurityGroupName() reference.ExtractValueFn {
 	return func(mg resource.Managed) string {
@@ -139,9 +155,71 @@ func (mg *SecurityGroup) ResolveReferences(ctx context.Context, c client.Reader)
 		}
 	}
 
+	// Resolve spec.forProvider.ingress[*].prefixListIds[*].prefixListId
+	for i := range mg.Spec.ForProvider.Ingress {
+		for j := range mg.Spec.ForProvider.Ingress[i].PrefixListIDs {
+			if err := resolvePrefixListID(ctx, c, mg, &mg.Spec.ForProvider.Ingress[i].PrefixListIDs[j]); err != nil {
+				return errors.Wrapf(err, "spec.forProvider.ingress[%d].prefixListIds[%d].prefixListId", i, j)
+			}
+		}
+	}
+
+	// Resolve spec.forProvider.egress[*].prefixListIds[*].prefixListId
+	for i := range mg.Spec.ForProvider.Egress {
+		for j := range mg.Spec.ForProvider.Egress[i].PrefixListIDs {
+			if err := resolvePrefixListID(ctx, c, mg, &mg.Spec.ForProvider.Egress[i].PrefixListIDs[j]); err != nil {
+				return errors.Wrapf(err, "spec.forProvider.egress[%d].prefixListIds[%d].prefixListId", i, j)
+			}
+		}
+	}
+
 	return nil
 }
 
+// resolvePrefixListID resolves the ManagedPrefixList the supplied prefix list
+// ID references. Like reference.APIResolver, a reference is only resolved if
+// its value is not yet set.
+func resolvePrefixListID(ctx context.Context, c client.Reader, mg resource.Managed, v *PrefixListID) error {
+	if meta.WasDeleted(mg) || v.PrefixListID != "" || (v.PrefixListIDRef == nil && v.PrefixListIDSelector == nil) {
+		return nil
+	}
+
+	// The reference is already set - resolve it.
+	if v.PrefixListIDRef != nil {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(managedPrefixListGVK)
+		if err := c.Get(ctx, types.NamespacedName{Name: v.PrefixListIDRef.Name}, u); err != nil {
+			return errors.Wrap(err, errGetManagedPrefixList)
+		}
+		id := meta.GetExternalName(u)
+		if id == "" {
+			return errors.New(errNoManagedPrefixListID)
+		}
+		v.PrefixListID = id
+		return nil
+	}
+
+	// The reference was not set, but a selector was. Select a reference.
+	l := &unstructured.UnstructuredList{}
+	l.SetGroupVersionKind(managedPrefixListGVK.GroupVersion().WithKind(managedPrefixListGVK.Kind + "List"))
+	if err := c.List(ctx, l, client.MatchingLabels(v.PrefixListIDSelector.MatchLabels)); err != nil {
+		return errors.Wrap(err, errListManagedPrefixLists)
+	}
+	for i := range l.Items {
+		if reference.ControllersMustMatch(v.PrefixListIDSelector) && !meta.HaveSameController(mg, &l.Items[i]) {
+			continue
+		}
+		id := meta.GetExternalName(&l.Items[i])
+		if id == "" {
+			return errors.New(errNoManagedPrefixListID)
+		}
+		v.PrefixListID = id
+		v.PrefixListIDRef = &xpv1.Reference{Name: l.Items[i].GetName()}
+		return nil
+	}
+	return errors.New(errNoManagedPrefixList)
+}
+
 // ResolveReferences of this Subnet
 func (mg *Subnet) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -49,6 +49,13 @@ type SecurityGroupParameters struct {
 	// +optional
 	Egress []IPPermission `json:"egress,omitempty"`
 
+	// PreserveUnownedRules stops the security group from revoking rules that
+	// are not in Ingress or Egress, so that rules can be added to it by
+	// SecurityGroupRules or other tools. Rules removed from Ingress or Egress
+	// are not revoked either while it is set.
+	// +optional
+	PreserveUnownedRules *bool `json:"preserveUnownedRules,omitempty"`
+
 	// Tags represents to current ec2 tags.
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
@@ -109,7 +116,17 @@ type PrefixListID struct {
 	Description *string `json:"description,omitempty"`
 
 	// The ID of the prefix.
-	PrefixListID string `json:"prefixListId"`
+	// +optional
+	PrefixListID string `json:"prefixListId,omitempty"`
+
+	// PrefixListIDRef references a ManagedPrefixList to retrieve its ID.
+	// +optional
+	PrefixListIDRef *xpv1.Reference `json:"prefixListIdRef,omitempty"`
+
+	// PrefixListIDSelector selects a reference to a ManagedPrefixList to
+	// retrieve its ID.
+	// +optional
+	PrefixListIDSelector *xpv1.Selector `json:"prefixListIdSelector,omitempty"`
 }
 
 // UserIDGroupPair describes a security group and AWS account ID pair.
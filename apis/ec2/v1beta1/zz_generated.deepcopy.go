@@ -683,6 +683,16 @@ func (in *PrefixListID) DeepCopyInto(out *PrefixListID) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PrefixListIDRef != nil {
+		in, out := &in.PrefixListIDRef, &out.PrefixListIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PrefixListIDSelector != nil {
+		in, out := &in.PrefixListIDSelector, &out.PrefixListIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixListID.
@@ -1060,6 +1070,11 @@ func (in *SecurityGroupParameters) DeepCopyInto(out *SecurityGroupParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PreserveUnownedRules != nil {
+		in, out := &in.PreserveUnownedRules, &out.PreserveUnownedRules
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
@@ -26,7 +26,10 @@ import (
 type RepositoryParameters struct {
 
 	// Region is the region you'd like your Repository to be created in.
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// The image scanning configuration for the repository. This determines whether
 	// images are scanned for known vulnerabilities after being pushed to the repository.
@@ -26,7 +26,10 @@ import (
 type RepositoryPolicyParameters struct {
 
 	// Region is the region you'd like your RepositoryPolicy to be created in.
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// If the policy you are attempting to set on a repository policy would prevent
 	// you from setting another policy in the future, you must force the SetRepositoryPolicy
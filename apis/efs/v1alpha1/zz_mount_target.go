@@ -27,8 +27,10 @@ import (
 // MountTargetParameters defines the desired state of MountTarget
 type MountTargetParameters struct {
 	// Region is which region the MountTarget will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// Valid IPv4 address within the address range of the specified subnet.
 	IPAddress                   *string `json:"ipAddress,omitempty"`
 	CustomMountTargetParameters `json:",inline"`
@@ -101,8 +101,11 @@ type OIDCIdentityProvider struct {
 // Service Identity Provider.
 type IdentityProviderConfigParameters struct {
 	// Region is the region you'd like the identity provider to be created in.
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
 	// +immutable
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// The name of the cluster to associate the identity provider with.
 	// +immutable
@@ -40,7 +40,10 @@ const (
 // Service NodeGroup.
 type NodeGroupParameters struct {
 	// Region is the region you'd like  the NodeGroup to be created in.
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// The AMI type for your node group.
 	// GPU instance can use
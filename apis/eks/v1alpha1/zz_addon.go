@@ -27,8 +27,10 @@ import (
 // AddonParameters defines the desired state of Addon
 type AddonParameters struct {
 	// Region is which region the Addon will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The name of the add-on. The name must match one of the names returned by
 	// ListAddons (https://docs.aws.amazon.com/eks/latest/APIReference/API_ListAddons.html).
 	// +kubebuilder:validation:Required
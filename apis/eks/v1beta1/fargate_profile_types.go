@@ -64,8 +64,11 @@ type FargateProfileObservation struct {
 type FargateProfileParameters struct {
 
 	// Region is the region you'd like  the FargateProfile to be created in.
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
 	// +immutable
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// The name of the Amazon EKS cluster to apply the Fargate profile to.
 	//
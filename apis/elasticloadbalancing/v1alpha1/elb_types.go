@@ -92,7 +92,10 @@ type HealthCheck struct {
 // ELBParameters define the desired state of an AWS ELB.
 type ELBParameters struct {
 	// Region is the region you'd like your ELB to be created in.
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// One or more Availability Zones from the same region as the load balancer.
 	// +optional
@@ -27,8 +27,10 @@ import (
 // ListenerParameters defines the desired state of Listener
 type ListenerParameters struct {
 	// Region is which region the Listener will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// [TLS listeners] The name of the Application-Layer Protocol Negotiation (ALPN)
 	// policy. You can specify one policy name. The following are the possible values:
 	//
@@ -27,8 +27,10 @@ import (
 // LoadBalancerParameters defines the desired state of LoadBalancer
 type LoadBalancerParameters struct {
 	// Region is which region the LoadBalancer will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// [Application Load Balancers on Outposts] The ID of the customer-owned address
 	// pool (CoIP pool).
 	CustomerOwnedIPv4Pool *string `json:"customerOwnedIPv4Pool,omitempty"`
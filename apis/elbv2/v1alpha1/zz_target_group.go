@@ -27,8 +27,10 @@ import (
 // TargetGroupParameters defines the desired state of TargetGroup
 type TargetGroupParameters struct {
 	// Region is which region the TargetGroup will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// Indicates whether health checks are enabled. If the target type is lambda,
 	// health checks are disabled by default but can be enabled. If the target type
 	// is instance or ip, health checks are always enabled and cannot be disabled.
@@ -27,8 +27,10 @@ import (
 // ClassifierParameters defines the desired state of Classifier
 type ClassifierParameters struct {
 	// Region is which region the Classifier will be created.
-	// +kubebuilder:validation:Required
-	Region                     string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region                     string `json:"region,omitempty"`
 	CustomClassifierParameters `json:",inline"`
 }
 
@@ -27,8 +27,10 @@ import (
 // ConnectionParameters defines the desired state of Connection
 type ConnectionParameters struct {
 	// Region is which region the Connection will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The ID of the Data Catalog in which to create the connection. If none is
 	// provided, the AWS account ID is used by default.
 	CatalogID                  *string `json:"catalogID,omitempty"`
@@ -27,8 +27,10 @@ import (
 // CrawlerParameters defines the desired state of Crawler
 type CrawlerParameters struct {
 	// Region is which region the Crawler will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// A list of custom classifiers that the user has registered. By default, all
 	// built-in classifiers are included in a crawl, but these custom classifiers
 	// always override the default classifiers for a given classification.
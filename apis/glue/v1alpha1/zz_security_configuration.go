@@ -27,8 +27,10 @@ import (
 // SecurityConfigurationParameters defines the desired state of SecurityConfiguration
 type SecurityConfigurationParameters struct {
 	// Region is which region the SecurityConfiguration will be created.
-	// +kubebuilder:validation:Required
-	Region                                string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region                                string `json:"region,omitempty"`
 	CustomSecurityConfigurationParameters `json:",inline"`
 }
 
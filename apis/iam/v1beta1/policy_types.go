@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	policyv1alpha1 "github.com/crossplane/provider-aws/apis/policy/v1alpha1"
 )
 
 // PolicyParameters define the desired state of an AWS IAM Policy.
@@ -32,8 +34,15 @@ type PolicyParameters struct {
 	// +optional
 	Path *string `json:"path,omitempty"`
 
-	// The JSON policy document that is the content for the policy.
-	Document string `json:"document"`
+	// The JSON policy document that is the content for the policy. Either
+	// Document or DocumentBody must be set.
+	// +optional
+	Document string `json:"document,omitempty"`
+
+	// DocumentBody is the typed policy document that is the content for the
+	// policy. It is only used if Document is not set.
+	// +optional
+	DocumentBody *policyv1alpha1.Document `json:"documentBody,omitempty"`
 
 	// The name of the policy.
 	Name string `json:"name"`
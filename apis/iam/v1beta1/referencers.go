@@ -17,6 +17,10 @@ limitations under the License.
 package v1beta1
 
 import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
@@ -54,3 +58,13 @@ func UserARN() reference.ExtractValueFn {
 		return r.Status.AtProvider.ARN
 	}
 }
+
+// ResolveReferences of this Policy
+func (mg *Policy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return mg.Spec.ForProvider.DocumentBody.ResolveReferences(ctx, c, mg, "spec.forProvider.documentBody")
+}
+
+// ResolveReferences of this Role
+func (mg *Role) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return mg.Spec.ForProvider.AssumeRolePolicyDocumentBody.ResolveReferences(ctx, c, mg, "spec.forProvider.assumeRolePolicyDocumentBody")
+}
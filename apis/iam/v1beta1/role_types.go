@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	policyv1alpha1 "github.com/crossplane/provider-aws/apis/policy/v1alpha1"
 )
 
 // Tag represents user-provided metadata that can be associated
@@ -49,9 +51,17 @@ type Tag struct {
 type RoleParameters struct {
 
 	// AssumeRolePolicyDocument is the the trust relationship policy document
-	// that grants an entity permission to assume the role.
+	// that grants an entity permission to assume the role. Either
+	// AssumeRolePolicyDocument or AssumeRolePolicyDocumentBody must be set.
 	// +immutable
-	AssumeRolePolicyDocument string `json:"assumeRolePolicyDocument"`
+	// +optional
+	AssumeRolePolicyDocument string `json:"assumeRolePolicyDocument,omitempty"`
+
+	// AssumeRolePolicyDocumentBody is the typed trust relationship policy
+	// document that grants an entity permission to assume the role. It is
+	// only used if AssumeRolePolicyDocument is not set.
+	// +optional
+	AssumeRolePolicyDocumentBody *policyv1alpha1.Document `json:"assumeRolePolicyDocumentBody,omitempty"`
 
 	// Description is a description of the role.
 	// +optional
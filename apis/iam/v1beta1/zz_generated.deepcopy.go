@@ -22,6 +22,7 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/policy/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -780,6 +781,11 @@ func (in *PolicyParameters) DeepCopyInto(out *PolicyParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DocumentBody != nil {
+		in, out := &in.DocumentBody, &out.DocumentBody
+		*out = new(v1alpha1.Document)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
@@ -908,6 +914,11 @@ func (in *RoleList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleParameters) DeepCopyInto(out *RoleParameters) {
 	*out = *in
+	if in.AssumeRolePolicyDocumentBody != nil {
+		in, out := &in.AssumeRolePolicyDocumentBody, &out.AssumeRolePolicyDocumentBody
+		*out = new(v1alpha1.Document)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
@@ -27,8 +27,10 @@ import (
 // PolicyParameters defines the desired state of Policy
 type PolicyParameters struct {
 	// Region is which region the Policy will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The JSON document that describes the policy. policyDocument must have a minimum
 	// length of 1, with a maximum length of 2048, excluding whitespace.
 	// +kubebuilder:validation:Required
@@ -27,8 +27,10 @@ import (
 // ThingParameters defines the desired state of Thing
 type ThingParameters struct {
 	// Region is which region the Thing will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The attribute payload, which consists of up to three name/value pairs in
 	// a JSON document. For example:
 	//
@@ -27,8 +27,10 @@ import (
 // ClusterParameters defines the desired state of Cluster
 type ClusterParameters struct {
 	// Region is which region the Cluster will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// Includes all client authentication related information.
 	ClientAuthentication *ClientAuthentication `json:"clientAuthentication,omitempty"`
 	// Includes all encryption-related information.
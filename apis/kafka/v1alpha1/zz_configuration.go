@@ -27,8 +27,10 @@ import (
 // ConfigurationParameters defines the desired state of Configuration
 type ConfigurationParameters struct {
 	// Region is which region the Configuration will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The description of the configuration.
 	Description *string `json:"description,omitempty"`
 	// The versions of Apache Kafka with which you can use this MSK configuration.
@@ -28,8 +28,10 @@ import (
 // AliasParameters defines the desired state of Alias
 type AliasParameters struct {
 	// Region is which region the Alias will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// Associates the alias with the specified customer managed CMK (https://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#customer-cmk).
 	// The CMK must be in the same AWS Region.
@@ -1,5 +1,9 @@
 package v1alpha1
 
+import (
+	policyv1alpha1 "github.com/crossplane/provider-aws/apis/policy/v1alpha1"
+)
+
 // CustomKeyParameters are custom parameters for Key.
 type CustomKeyParameters struct {
 	// Specifies whether the CMK is enabled.
@@ -7,4 +11,9 @@ type CustomKeyParameters struct {
 
 	// Specifies how many days the Key is retained when scheduled for deletion. Defaults to 30 days.
 	PendingWindowInDays *int64 `json:"pendingWindowInDays,omitempty"`
+
+	// PolicyBody is the typed key policy to attach to the CMK. It is only used
+	// if Policy is not set.
+	// +optional
+	PolicyBody *policyv1alpha1.Document `json:"policyBody,omitempty"`
 }
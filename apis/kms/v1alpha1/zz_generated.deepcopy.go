@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	policyv1alpha1 "github.com/crossplane/provider-aws/apis/policy/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -209,6 +210,11 @@ func (in *CustomKeyParameters) DeepCopyInto(out *CustomKeyParameters) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.PolicyBody != nil {
+		in, out := &in.PolicyBody, &out.PolicyBody
+		*out = new(policyv1alpha1.Document)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomKeyParameters.
@@ -27,8 +27,10 @@ import (
 // UserParameters defines the desired state of User
 type UserParameters struct {
 	// Region is which region the User will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	ConsoleAccess *bool `json:"consoleAccess,omitempty"`
 
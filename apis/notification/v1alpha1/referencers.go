@@ -46,3 +46,8 @@ func (mg *SNSSubscription) ResolveReferences(ctx context.Context, c client.Reade
 
 	return nil
 }
+
+// ResolveReferences for SNS Topic managed type
+func (mg *SNSTopic) ResolveReferences(ctx context.Context, c client.Reader) error {
+	return mg.Spec.ForProvider.PolicyBody.ResolveReferences(ctx, c, mg, "spec.forProvider.policyBody")
+}
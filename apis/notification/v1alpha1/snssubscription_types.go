@@ -25,7 +25,10 @@ import (
 // SNSSubscriptionParameters define the desired state of a AWS SNS Topic
 type SNSSubscriptionParameters struct {
 	// Region is the region you'd like your SNSSubscription to be in.
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// TopicArn is the Arn of the SNS Topic
 	// +immutable
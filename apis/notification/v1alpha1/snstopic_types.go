@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	policyv1alpha1 "github.com/crossplane/provider-aws/apis/policy/v1alpha1"
 )
 
 // Tag represent a user-provided metadata that can be associated with a
@@ -48,7 +50,10 @@ type Tag struct {
 // SNSTopicParameters define the desired state of a AWS SNS Topic
 type SNSTopicParameters struct {
 	// Region is the region you'd like your SNSTopic to be created in.
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// Name refers to the name of the AWS SNS Topic
 	// +immutable
@@ -71,6 +76,11 @@ type SNSTopicParameters struct {
 	// +optional
 	Policy *string `json:"policy,omitempty"`
 
+	// PolicyBody is the typed policy that defines who can access your topic.
+	// It is only used if Policy is not set.
+	// +optional
+	PolicyBody *policyv1alpha1.Document `json:"policyBody,omitempty"`
+
 	// DeliveryRetryPolicy - the JSON serialization of the effective
 	// delivery policy, taking system defaults into account
 	// +optional
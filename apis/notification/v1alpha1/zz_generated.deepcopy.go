@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	policyv1alpha1 "github.com/crossplane/provider-aws/apis/policy/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -305,6 +306,11 @@ func (in *SNSTopicParameters) DeepCopyInto(out *SNSTopicParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PolicyBody != nil {
+		in, out := &in.PolicyBody, &out.PolicyBody
+		*out = new(policyv1alpha1.Document)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DeliveryPolicy != nil {
 		in, out := &in.DeliveryPolicy, &out.DeliveryPolicy
 		*out = new(string)
@@ -0,0 +1,221 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Document is a typed AWS policy document, i.e. an IAM identity policy, a
+// trust policy or a resource policy.
+type Document struct {
+	// Version is the version of the policy language.
+	// +kubebuilder:validation:Enum="2012-10-17";"2008-10-17"
+	// +kubebuilder:default:="2012-10-17"
+	Version string `json:"version"`
+
+	// ID is the optional identifier of the policy.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Statements of the policy.
+	Statements []Statement `json:"statements"`
+}
+
+// Statement is an individual statement of a Document.
+type Statement struct {
+	// SID is the optional identifier of the statement. It must be unique
+	// within the policy if provided.
+	// +optional
+	SID *string `json:"sid,omitempty"`
+
+	// Effect specifies whether the statement results in an allow or an
+	// explicit deny.
+	// +kubebuilder:validation:Enum=Allow;Deny
+	Effect string `json:"effect"`
+
+	// Principal is the principal that is allowed or denied access to a
+	// resource. It must not be set in identity policies.
+	// +optional
+	Principal *Principal `json:"principal,omitempty"`
+
+	// NotPrincipal are the principals the statement does not apply to.
+	// +optional
+	NotPrincipal *Principal `json:"notPrincipal,omitempty"`
+
+	// Action are the actions the statement allows or denies.
+	// +optional
+	Action []string `json:"action,omitempty"`
+
+	// NotAction are the actions the statement does not apply to.
+	// +optional
+	NotAction []string `json:"notAction,omitempty"`
+
+	// Resource are the ARNs of the resources the statement applies to.
+	// +optional
+	Resource []string `json:"resource,omitempty"`
+
+	// ResourceFrom are references to managed resources whose ARNs the
+	// statement applies to in addition to those of resource.
+	// +optional
+	ResourceFrom []ResourceSource `json:"resourceFrom,omitempty"`
+
+	// NotResource are the ARNs of the resources the statement does not
+	// apply to.
+	// +optional
+	NotResource []string `json:"notResource,omitempty"`
+
+	// Condition specifies when the statement is in effect.
+	// +optional
+	Condition []Condition `json:"condition,omitempty"`
+}
+
+// ResourceSource is the ARN of a resource a Statement applies to. The ARN is
+// either given or resolved from one of the references.
+type ResourceSource struct {
+	// ARN of the resource.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
+
+	// BucketARNRef references an S3 Bucket to retrieve its ARN.
+	// +optional
+	BucketARNRef *xpv1.Reference `json:"bucketArnRef,omitempty"`
+
+	// BucketARNSelector selects a reference to an S3 Bucket to retrieve its
+	// ARN.
+	// +optional
+	BucketARNSelector *xpv1.Selector `json:"bucketArnSelector,omitempty"`
+
+	// QueueARNRef references an SQS Queue to retrieve its ARN.
+	// +optional
+	QueueARNRef *xpv1.Reference `json:"queueArnRef,omitempty"`
+
+	// QueueARNSelector selects a reference to an SQS Queue to retrieve its
+	// ARN.
+	// +optional
+	QueueARNSelector *xpv1.Selector `json:"queueArnSelector,omitempty"`
+
+	// RoleARNRef references an IAM Role to retrieve its ARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to an IAM Role to retrieve its
+	// ARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
+
+	// Suffix is appended to the ARN, e.g. /* to apply to all objects of a
+	// bucket.
+	// +optional
+	Suffix string `json:"suffix,omitempty"`
+}
+
+// Principal is the set of principals a Statement applies to.
+type Principal struct {
+	// AllowAnon makes the statement apply to everyone, including anonymous
+	// users.
+	// +optional
+	AllowAnon bool `json:"allowAnon,omitempty"`
+
+	// AWSPrincipals are the AWS accounts, IAM users and IAM roles the
+	// statement applies to.
+	// +optional
+	AWSPrincipals []AWSPrincipal `json:"awsPrincipals,omitempty"`
+
+	// Federated is the identity provider of web identity or SAML federated
+	// users the statement applies to.
+	// +optional
+	Federated *string `json:"federated,omitempty"`
+
+	// Service are the AWS services the statement applies to, e.g.
+	// ec2.amazonaws.com.
+	// +optional
+	Service []string `json:"service,omitempty"`
+}
+
+// AWSPrincipal is an AWS account, IAM user or IAM role. Only one of the values
+// should be set.
+type AWSPrincipal struct {
+	// UserARN is the ARN of an IAM user.
+	// +optional
+	UserARN *string `json:"iamUserArn,omitempty"`
+
+	// UserARNRef references an IAM User to retrieve its ARN.
+	// +optional
+	UserARNRef *xpv1.Reference `json:"iamUserArnRef,omitempty"`
+
+	// UserARNSelector selects a reference to an IAM User to retrieve its
+	// ARN.
+	// +optional
+	UserARNSelector *xpv1.Selector `json:"iamUserArnSelector,omitempty"`
+
+	// AWSAccountID is the ID of an AWS account.
+	// +optional
+	AWSAccountID *string `json:"awsAccountId,omitempty"`
+
+	// IAMRoleARN is the ARN of an IAM role.
+	// +optional
+	IAMRoleARN *string `json:"iamRoleArn,omitempty"`
+
+	// IAMRoleARNRef references an IAM Role to retrieve its ARN.
+	// +optional
+	IAMRoleARNRef *xpv1.Reference `json:"iamRoleArnRef,omitempty"`
+
+	// IAMRoleARNSelector selects a reference to an IAM Role to retrieve its
+	// ARN.
+	// +optional
+	IAMRoleARNSelector *xpv1.Selector `json:"iamRoleArnSelector,omitempty"`
+}
+
+// Condition is a condition operator and the conditions it applies to.
+type Condition struct {
+	// OperatorKey is the condition operator, e.g. StringEquals.
+	OperatorKey string `json:"operatorKey"`
+
+	// Conditions are the condition keys and values the operator is applied
+	// to.
+	Conditions []ConditionPair `json:"conditions"`
+}
+
+// ConditionPair is a condition key and its value. Exactly one of the values
+// must be set.
+type ConditionPair struct {
+	// ConditionKey is the condition key, e.g. aws:SourceArn.
+	ConditionKey string `json:"key"`
+
+	// ConditionStringValue is a string value.
+	// +optional
+	ConditionStringValue *string `json:"stringValue,omitempty"`
+
+	// ConditionDateValue is a date value. The time is always midnight UTC.
+	// +optional
+	ConditionDateValue *metav1.Time `json:"dateValue,omitempty"`
+
+	// ConditionNumericValue is a numeric value.
+	// +optional
+	ConditionNumericValue *int64 `json:"numericValue,omitempty"`
+
+	// ConditionBooleanValue is a boolean value.
+	// +optional
+	ConditionBooleanValue *bool `json:"booleanValue,omitempty"`
+
+	// ConditionListValue is a list of string values.
+	// +optional
+	ConditionListValue []string `json:"listValue,omitempty"`
+}
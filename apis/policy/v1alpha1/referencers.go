@@ -0,0 +1,164 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	errGetManaged  = "cannot get referenced resource"
+	errListManaged = "cannot list resources that match selector"
+	errNoMatches   = "no resources matched selector"
+	errNoValue     = "referenced field was empty (referenced resource may not yet be ready)"
+)
+
+// The kinds a Document can reference. They are resolved as unstructured
+// objects because the API groups that define them use Documents themselves.
+var (
+	bucketGVK = schema.GroupVersionKind{Group: "s3.aws.crossplane.io", Version: "v1beta1", Kind: "Bucket"}
+	queueGVK  = schema.GroupVersionKind{Group: "sqs.aws.crossplane.io", Version: "v1beta1", Kind: "Queue"}
+	roleGVK   = schema.GroupVersionKind{Group: "iam.aws.crossplane.io", Version: "v1beta1", Kind: "Role"}
+	userGVK   = schema.GroupVersionKind{Group: "iam.aws.crossplane.io", Version: "v1beta1", Kind: "User"}
+)
+
+// fieldPathARN is the field path of the ARN of all kinds a Document can
+// reference.
+const fieldPathARN = "status.atProvider.arn"
+
+// ResolveReferences of the supplied Document, which is at the supplied field
+// path of the supplied managed resource. Like reference.APIResolver, a
+// reference is only resolved if its value is not yet set.
+func (d *Document) ResolveReferences(ctx context.Context, c client.Reader, mg resource.Managed, path string) error {
+	if d == nil {
+		return nil
+	}
+	r := &resolver{client: c, from: mg}
+	for i := range d.Statements {
+		s := &d.Statements[i]
+		sp := fmt.Sprintf("%s.statements[%d]", path, i)
+		if err := r.resolvePrincipal(ctx, s.Principal, sp+".principal"); err != nil {
+			return err
+		}
+		if err := r.resolvePrincipal(ctx, s.NotPrincipal, sp+".notPrincipal"); err != nil {
+			return err
+		}
+		for j := range s.ResourceFrom {
+			if err := r.resolveResource(ctx, &s.ResourceFrom[j], fmt.Sprintf("%s.resourceFrom[%d].arn", sp, j)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type resolver struct {
+	client client.Reader
+	from   resource.Managed
+}
+
+func (r *resolver) resolvePrincipal(ctx context.Context, p *Principal, path string) error {
+	if p == nil {
+		return nil
+	}
+	for i := range p.AWSPrincipals {
+		ap := &p.AWSPrincipals[i]
+		var err error
+		ap.UserARN, ap.UserARNRef, err = r.resolve(ctx, ap.UserARN, ap.UserARNRef, ap.UserARNSelector, userGVK)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("%s.awsPrincipals[%d].iamUserArn", path, i))
+		}
+		ap.IAMRoleARN, ap.IAMRoleARNRef, err = r.resolve(ctx, ap.IAMRoleARN, ap.IAMRoleARNRef, ap.IAMRoleARNSelector, roleGVK)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("%s.awsPrincipals[%d].iamRoleArn", path, i))
+		}
+	}
+	return nil
+}
+
+func (r *resolver) resolveResource(ctx context.Context, s *ResourceSource, path string) error {
+	var err error
+	s.ARN, s.BucketARNRef, err = r.resolve(ctx, s.ARN, s.BucketARNRef, s.BucketARNSelector, bucketGVK)
+	if err != nil {
+		return errors.Wrap(err, path)
+	}
+	s.ARN, s.QueueARNRef, err = r.resolve(ctx, s.ARN, s.QueueARNRef, s.QueueARNSelector, queueGVK)
+	if err != nil {
+		return errors.Wrap(err, path)
+	}
+	s.ARN, s.RoleARNRef, err = r.resolve(ctx, s.ARN, s.RoleARNRef, s.RoleARNSelector, roleGVK)
+	return errors.Wrap(err, path)
+}
+
+func (r *resolver) resolve(ctx context.Context, current *string, ref *xpv1.Reference, sel *xpv1.Selector, gvk schema.GroupVersionKind) (*string, *xpv1.Reference, error) {
+	if meta.WasDeleted(r.from) || reference.FromPtrValue(current) != "" || (ref == nil && sel == nil) {
+		return current, ref, nil
+	}
+
+	// The reference is already set - resolve it.
+	if ref != nil {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(gvk)
+		if err := r.client.Get(ctx, types.NamespacedName{Name: ref.Name}, u); err != nil {
+			return current, ref, errors.Wrap(err, errGetManaged)
+		}
+		v, err := arn(u)
+		if err != nil {
+			return current, ref, err
+		}
+		return v, ref, nil
+	}
+
+	// The reference was not set, but a selector was. Select a reference.
+	l := &unstructured.UnstructuredList{}
+	l.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := r.client.List(ctx, l, client.MatchingLabels(sel.MatchLabels)); err != nil {
+		return current, ref, errors.Wrap(err, errListManaged)
+	}
+	for i := range l.Items {
+		if reference.ControllersMustMatch(sel) && !meta.HaveSameController(r.from, &l.Items[i]) {
+			continue
+		}
+		v, err := arn(&l.Items[i])
+		if err != nil {
+			return current, ref, err
+		}
+		return v, &xpv1.Reference{Name: l.Items[i].GetName()}, nil
+	}
+	return current, ref, errors.New(errNoMatches)
+}
+
+func arn(u *unstructured.Unstructured) (*string, error) {
+	v, err := fieldpath.Pave(u.Object).GetString(fieldPathARN)
+	if err != nil || v == "" {
+		return nil, errors.New(errNoValue)
+	}
+	return &v, nil
+}
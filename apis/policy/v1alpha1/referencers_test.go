@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var (
+	roleARN   = "arn:aws:iam::111122223333:role/a"
+	bucketARN = "arn:aws:s3:::b"
+	queueARN  = "arn:aws:sqs:us-east-1:111122223333:q"
+	errBoom   = errors.New("boom")
+)
+
+func withARN(arn string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		u := obj.(*unstructured.Unstructured)
+		if arn != "" {
+			_ = fieldpath.Pave(u.Object).SetString(fieldPathARN, arn)
+		}
+		return nil
+	}
+}
+
+func TestResolveReferences(t *testing.T) {
+	type args struct {
+		client client.Reader
+		doc    *Document
+	}
+	type want struct {
+		doc *Document
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NilDocument": {
+			args: args{
+				client: &test.MockClient{},
+			},
+			want: want{},
+		},
+		"ResolvedRole": {
+			args: args{
+				client: &test.MockClient{MockGet: withARN(roleARN)},
+				doc: &Document{Statements: []Statement{{
+					Principal: &Principal{AWSPrincipals: []AWSPrincipal{{IAMRoleARNRef: &xpv1.Reference{Name: "a"}}}},
+				}}},
+			},
+			want: want{
+				doc: &Document{Statements: []Statement{{
+					Principal: &Principal{AWSPrincipals: []AWSPrincipal{{IAMRoleARN: &roleARN, IAMRoleARNRef: &xpv1.Reference{Name: "a"}}}},
+				}}},
+			},
+		},
+		"ResolvedBucket": {
+			args: args{
+				client: &test.MockClient{MockGet: withARN(bucketARN)},
+				doc: &Document{Statements: []Statement{{
+					ResourceFrom: []ResourceSource{{BucketARNRef: &xpv1.Reference{Name: "b"}, Suffix: "/*"}},
+				}}},
+			},
+			want: want{
+				doc: &Document{Statements: []Statement{{
+					ResourceFrom: []ResourceSource{{ARN: &bucketARN, BucketARNRef: &xpv1.Reference{Name: "b"}, Suffix: "/*"}},
+				}}},
+			},
+		},
+		"SelectedQueue": {
+			args: args{
+				client: &test.MockClient{MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+					u := unstructured.Unstructured{Object: map[string]interface{}{}}
+					u.SetName("q")
+					_ = fieldpath.Pave(u.Object).SetString(fieldPathARN, queueARN)
+					obj.(*unstructured.UnstructuredList).Items = []unstructured.Unstructured{u}
+					return nil
+				}},
+				doc: &Document{Statements: []Statement{{
+					ResourceFrom: []ResourceSource{{QueueARNSelector: &xpv1.Selector{MatchLabels: map[string]string{"k": "v"}}}},
+				}}},
+			},
+			want: want{
+				doc: &Document{Statements: []Statement{{
+					ResourceFrom: []ResourceSource{{
+						ARN:              &queueARN,
+						QueueARNRef:      &xpv1.Reference{Name: "q"},
+						QueueARNSelector: &xpv1.Selector{MatchLabels: map[string]string{"k": "v"}},
+					}},
+				}}},
+			},
+		},
+		"NotReady": {
+			args: args{
+				client: &test.MockClient{MockGet: withARN("")},
+				doc: &Document{Statements: []Statement{{
+					ResourceFrom: []ResourceSource{{QueueARNRef: &xpv1.Reference{Name: "q"}}},
+				}}},
+			},
+			want: want{
+				doc: &Document{Statements: []Statement{{
+					ResourceFrom: []ResourceSource{{QueueARNRef: &xpv1.Reference{Name: "q"}}},
+				}}},
+				err: errors.Wrap(errors.New(errNoValue), "spec.forProvider.policy.statements[0].resourceFrom[0].arn"),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				client: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				doc: &Document{Statements: []Statement{{
+					Principal: &Principal{AWSPrincipals: []AWSPrincipal{{UserARNRef: &xpv1.Reference{Name: "u"}}}},
+				}}},
+			},
+			want: want{
+				doc: &Document{Statements: []Statement{{
+					Principal: &Principal{AWSPrincipals: []AWSPrincipal{{UserARNRef: &xpv1.Reference{Name: "u"}}}},
+				}}},
+				err: errors.Wrap(errors.Wrap(errBoom, errGetManaged), "spec.forProvider.policy.statements[0].principal.awsPrincipals[0].iamUserArn"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.doc.ResolveReferences(context.Background(), tc.args.client, &fake.Managed{}, "spec.forProvider.policy")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.doc, tc.args.doc); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
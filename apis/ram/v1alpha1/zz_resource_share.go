@@ -27,8 +27,10 @@ import (
 // ResourceShareParameters defines the desired state of ResourceShare
 type ResourceShareParameters struct {
 	// Region is which region the ResourceShare will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// Indicates whether principals outside your AWS organization can be associated
 	// with a resource share.
 	AllowExternalPrincipals *bool `json:"allowExternalPrincipals,omitempty"`
@@ -27,8 +27,10 @@ import (
 // GlobalClusterParameters defines the desired state of GlobalCluster
 type GlobalClusterParameters struct {
 	// Region is which region the GlobalCluster will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The name for your database of up to 64 alpha-numeric characters. If you do
 	// not provide a name, Amazon Aurora will not create a database in the global
 	// database cluster you are creating.
@@ -39,7 +39,10 @@ const (
 // ClusterParameters define the parameters available for an AWS Redshift cluster
 type ClusterParameters struct {
 	// Region is the region you'd like the Cluster to be created in.
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// NodeType is the node type defining its size and compute capacity to be
 	// provisioned for the cluster. For information about node types,
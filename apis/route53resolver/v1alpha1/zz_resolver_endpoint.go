@@ -27,8 +27,10 @@ import (
 // ResolverEndpointParameters defines the desired state of ResolverEndpoint
 type ResolverEndpointParameters struct {
 	// Region is which region the ResolverEndpoint will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// Specify the applicable value:
 	//
 	//    * INBOUND: Resolver forwards DNS queries to the DNS service for a VPC
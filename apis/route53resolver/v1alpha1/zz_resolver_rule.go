@@ -27,8 +27,10 @@ import (
 // ResolverRuleParameters defines the desired state of ResolverRule
 type ResolverRuleParameters struct {
 	// Region is which region the ResolverRule will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// DNS queries for this domain name are forwarded to the IP addresses that you
 	// specify in TargetIps. If a query matches multiple Resolver rules (example.com
 	// and www.example.com), outbound DNS queries are routed using the Resolver
@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// AccessPointParameters define the desired state of an AWS S3 AccessPoint.
+// The name of the access point is the external name of the AccessPoint.
+type AccessPointParameters struct {
+	// Region is where the Bucket of this AccessPoint resides. If not set,
+	// the default region of the referenced ProviderConfig is used.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// AccountID is the ID of the AWS account that owns the AccessPoint. If
+	// not set, the account of the credentials of the referenced
+	// ProviderConfig is used and recorded here.
+	// +immutable
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// BucketName is the name of the bucket the AccessPoint is attached to.
+	// +optional
+	// +immutable
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references a Bucket to retrieve its bucketName.
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to a Bucket to retrieve its
+	// bucketName.
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// VPCConfiguration restricts the AccessPoint to requests from a VPC. If
+	// not set, the AccessPoint accepts requests from the internet.
+	// +immutable
+	// +optional
+	VPCConfiguration *AccessPointVPCConfiguration `json:"vpcConfiguration,omitempty"`
+
+	// PublicAccessBlockConfiguration blocks public access through the
+	// AccessPoint. If not set, all public access is blocked.
+	// +immutable
+	// +optional
+	PublicAccessBlockConfiguration *AccessPointPublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+}
+
+// AccessPointVPCConfiguration is the VPC an AccessPoint accepts requests from.
+type AccessPointVPCConfiguration struct {
+	// VPCID is the ID of the VPC.
+	// +optional
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId.
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId.
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+}
+
+// AccessPointPublicAccessBlockConfiguration configures how public access
+// through an AccessPoint is blocked. Unset fields default to true.
+type AccessPointPublicAccessBlockConfiguration struct {
+	// BlockPublicACLs rejects PUT requests that carry public ACLs.
+	// +optional
+	BlockPublicACLs *bool `json:"blockPublicAcls,omitempty"`
+
+	// IgnorePublicACLs ignores public ACLs of objects.
+	// +optional
+	IgnorePublicACLs *bool `json:"ignorePublicAcls,omitempty"`
+
+	// BlockPublicPolicy rejects access point policies that grant public
+	// access.
+	// +optional
+	BlockPublicPolicy *bool `json:"blockPublicPolicy,omitempty"`
+
+	// RestrictPublicBuckets restricts access through the AccessPoint to AWS
+	// services and authorized users of the bucket owner account if its
+	// policy is public.
+	// +optional
+	RestrictPublicBuckets *bool `json:"restrictPublicBuckets,omitempty"`
+}
+
+// AccessPointObservation keeps the state for the external resource
+type AccessPointObservation struct {
+	// ARN is the Amazon Resource Name of the AccessPoint.
+	ARN string `json:"arn,omitempty"`
+
+	// NetworkOrigin is Internet if the AccessPoint accepts requests from the
+	// internet, or VPC if it only accepts requests from its VPC.
+	NetworkOrigin string `json:"networkOrigin,omitempty"`
+}
+
+// An AccessPointSpec defines the desired state of an AccessPoint.
+type AccessPointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessPointParameters `json:"forProvider"`
+}
+
+// An AccessPointStatus represents the observed state of an AccessPoint.
+type AccessPointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessPointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessPoint is a managed resource that represents an AWS S3 Access Point.
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucketName"
+// +kubebuilder:printcolumn:name="NETWORK",type="string",JSONPath=".status.atProvider.networkOrigin"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccessPoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessPointSpec   `json:"spec"`
+	Status AccessPointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessPointList contains a list of AccessPoints
+type AccessPointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessPoint `json:"items"`
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	policyv1alpha1 "github.com/crossplane/provider-aws/apis/policy/v1alpha1"
+)
+
+// AccessPointPolicyParameters define the desired state of an AWS S3
+// AccessPointPolicy.
+type AccessPointPolicyParameters struct {
+	// Region is where the AccessPoint of this AccessPointPolicy resides. If
+	// not set, the default region of the referenced ProviderConfig is used.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// AccountID is the ID of the AWS account that owns the AccessPoint. If
+	// not set, the account of the credentials of the referenced
+	// ProviderConfig is used and recorded here.
+	// +immutable
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// AccessPointName is the name of the access point the policy is
+	// attached to.
+	// +optional
+	// +immutable
+	AccessPointName *string `json:"accessPointName,omitempty"`
+
+	// AccessPointNameRef references an AccessPoint to retrieve its name.
+	// +optional
+	AccessPointNameRef *xpv1.Reference `json:"accessPointNameRef,omitempty"`
+
+	// AccessPointNameSelector selects a reference to an AccessPoint to
+	// retrieve its name.
+	// +optional
+	AccessPointNameSelector *xpv1.Selector `json:"accessPointNameSelector,omitempty"`
+
+	// RawPolicy is a stringified version of the access point policy.
+	// Either policy or rawPolicy must be specified.
+	// +optional
+	RawPolicy *string `json:"rawPolicy,omitempty"`
+
+	// Policy is a well defined type which can be parsed into a JSON access
+	// point policy. Either policy or rawPolicy must be specified.
+	// +optional
+	Policy *policyv1alpha1.Document `json:"policy,omitempty"`
+}
+
+// An AccessPointPolicySpec defines the desired state of an
+// AccessPointPolicy.
+type AccessPointPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessPointPolicyParameters `json:"forProvider"`
+}
+
+// An AccessPointPolicyStatus represents the observed state of an
+// AccessPointPolicy.
+type AccessPointPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessPointPolicy is a managed resource that represents the policy of an
+// AWS S3 Access Point.
+// +kubebuilder:printcolumn:name="ACCESSPOINT",type="string",JSONPath=".spec.forProvider.accessPointName"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccessPointPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessPointPolicySpec   `json:"spec"`
+	Status AccessPointPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessPointPolicyList contains a list of AccessPointPolicies
+type AccessPointPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessPointPolicy `json:"items"`
+}
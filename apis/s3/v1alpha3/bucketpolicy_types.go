@@ -25,8 +25,11 @@ import (
 // BucketPolicyParameters define the desired state of an AWS BucketPolicy.
 type BucketPolicyParameters struct {
 	// Region is where the Bucket referenced by this BucketPolicy resides.
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
 	// +immutable
-	Region string `json:"region"`
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// RawPolicy is a stringified version of the S3 Bucket Policy.
 	// either policy or rawPolicy must be specified in the policy
@@ -0,0 +1,180 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ObjectParameters define the desired state of an AWS S3 Object.
+type ObjectParameters struct {
+	// Region is where the Bucket of this Object resides. If not set, the
+	// default region of the referenced ProviderConfig is used.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// BucketName is the name of the bucket the Object is stored in.
+	// +optional
+	// +immutable
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references a Bucket to retrieve its bucketName.
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to a Bucket to retrieve its
+	// bucketName.
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// Key is the object key of the Object in its bucket.
+	// +immutable
+	Key string `json:"key"`
+
+	// Content is the UTF-8 content of the Object. Exactly one of content,
+	// contentBase64 or contentFrom must be specified.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentBase64 is the base64 encoded binary content of the Object.
+	// Exactly one of content, contentBase64 or contentFrom must be specified.
+	// +optional
+	ContentBase64 *string `json:"contentBase64,omitempty"`
+
+	// ContentFrom is a key of a ConfigMap or Secret whose value is the
+	// content of the Object. Exactly one of content, contentBase64 or
+	// contentFrom must be specified.
+	// +optional
+	ContentFrom *ObjectContentSource `json:"contentFrom,omitempty"`
+
+	// ContentType is a standard MIME type describing the format of the
+	// Object content, e.g. text/html. If not set, S3 uses
+	// binary/octet-stream.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// ServerSideEncryption is the server-side encryption algorithm used when
+	// storing the Object. Options are AES256 or aws:kms.
+	// +optional
+	ServerSideEncryption *string `json:"serverSideEncryption,omitempty"`
+
+	// SSEKMSKeyID is the ID or ARN of the AWS KMS symmetric customer managed
+	// key used to encrypt the Object if serverSideEncryption is aws:kms. If
+	// not set, the AWS managed key of S3 is used.
+	// +optional
+	SSEKMSKeyID *string `json:"sseKmsKeyId,omitempty"`
+
+	// SSEKMSKeyIDRef references a kms/v1alpha1.Key to retrieve its ID.
+	// +optional
+	SSEKMSKeyIDRef *xpv1.Reference `json:"sseKmsKeyIdRef,omitempty"`
+
+	// SSEKMSKeyIDSelector selects a reference to a kms/v1alpha1.Key to
+	// retrieve its ID.
+	// +optional
+	SSEKMSKeyIDSelector *xpv1.Selector `json:"sseKmsKeyIdSelector,omitempty"`
+
+	// Tags of the Object.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ObjectContentSource selects a key of a ConfigMap or Secret. Exactly one of
+// configMapKeyRef or secretKeyRef must be specified.
+type ObjectContentSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap. Both data and binaryData
+	// of the ConfigMap are considered.
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// SecretKeyRef selects a key of a Secret.
+	// +optional
+	SecretKeyRef *xpv1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// ConfigMapKeySelector is a reference to a key of a ConfigMap in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// Tag is a key-value pair of an Object tag.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	Value string `json:"value"`
+}
+
+// ObjectObservation keeps the state for the external resource
+type ObjectObservation struct {
+	// ETag is the entity tag of the current version of the Object.
+	ETag *string `json:"eTag,omitempty"`
+
+	// VersionID is the version of the Object if versioning is enabled for its
+	// bucket.
+	VersionID *string `json:"versionId,omitempty"`
+}
+
+// An ObjectSpec defines the desired state of an Object.
+type ObjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ObjectParameters `json:"forProvider"`
+}
+
+// An ObjectStatus represents the observed state of an Object.
+type ObjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ObjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Object is a managed resource that represents an AWS S3 Object.
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucketName"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Object struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ObjectSpec   `json:"spec"`
+	Status ObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectList contains a list of Objects
+type ObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Object `json:"items"`
+}
@@ -24,7 +24,9 @@ import (
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
 )
 
@@ -101,3 +103,96 @@ func ResolvePrincipal(ctx context.Context, r *reference.APIResolver, principal *
 	}
 	return nil
 }
+
+// ResolveReferences of this Object
+func (mg *Object) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.bucketName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.BucketName),
+		Reference:    mg.Spec.ForProvider.BucketNameRef,
+		Selector:     mg.Spec.ForProvider.BucketNameSelector,
+		To:           reference.To{Managed: &v1beta1.Bucket{}, List: &v1beta1.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucketName")
+	}
+	mg.Spec.ForProvider.BucketName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.BucketNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.sseKmsKeyId
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SSEKMSKeyID),
+		Reference:    mg.Spec.ForProvider.SSEKMSKeyIDRef,
+		Selector:     mg.Spec.ForProvider.SSEKMSKeyIDSelector,
+		To:           reference.To{Managed: &kmsv1alpha1.Key{}, List: &kmsv1alpha1.KeyList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sseKmsKeyId")
+	}
+	mg.Spec.ForProvider.SSEKMSKeyID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SSEKMSKeyIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this AccessPoint
+func (mg *AccessPoint) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.bucketName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.BucketName),
+		Reference:    mg.Spec.ForProvider.BucketNameRef,
+		Selector:     mg.Spec.ForProvider.BucketNameSelector,
+		To:           reference.To{Managed: &v1beta1.Bucket{}, List: &v1beta1.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucketName")
+	}
+	mg.Spec.ForProvider.BucketName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.BucketNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.vpcConfiguration.vpcId
+	if mg.Spec.ForProvider.VPCConfiguration != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPCConfiguration.VPCID),
+			Reference:    mg.Spec.ForProvider.VPCConfiguration.VPCIDRef,
+			Selector:     mg.Spec.ForProvider.VPCConfiguration.VPCIDSelector,
+			To:           reference.To{Managed: &ec2v1beta1.VPC{}, List: &ec2v1beta1.VPCList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.vpcConfiguration.vpcId")
+		}
+		mg.Spec.ForProvider.VPCConfiguration.VPCID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.VPCConfiguration.VPCIDRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this AccessPointPolicy
+func (mg *AccessPointPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.accessPointName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AccessPointName),
+		Reference:    mg.Spec.ForProvider.AccessPointNameRef,
+		Selector:     mg.Spec.ForProvider.AccessPointNameSelector,
+		To:           reference.To{Managed: &AccessPoint{}, List: &AccessPointList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.accessPointName")
+	}
+	mg.Spec.ForProvider.AccessPointName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AccessPointNameRef = rsp.ResolvedReference
+
+	return mg.Spec.ForProvider.Policy.ResolveReferences(ctx, c, mg, "spec.forProvider.policy")
+}
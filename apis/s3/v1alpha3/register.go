@@ -45,6 +45,33 @@ var (
 	BucketPolicyGroupVersionKind = SchemeGroupVersion.WithKind(BucketPolicyKind)
 )
 
+// Object type metadata.
+var (
+	ObjectKind             = reflect.TypeOf(Object{}).Name()
+	ObjectGroupKind        = schema.GroupKind{Group: Group, Kind: ObjectKind}.String()
+	ObjectKindAPIVersion   = ObjectKind + "." + SchemeGroupVersion.String()
+	ObjectGroupVersionKind = SchemeGroupVersion.WithKind(ObjectKind)
+)
+
+// AccessPoint type metadata.
+var (
+	AccessPointKind             = reflect.TypeOf(AccessPoint{}).Name()
+	AccessPointGroupKind        = schema.GroupKind{Group: Group, Kind: AccessPointKind}.String()
+	AccessPointKindAPIVersion   = AccessPointKind + "." + SchemeGroupVersion.String()
+	AccessPointGroupVersionKind = SchemeGroupVersion.WithKind(AccessPointKind)
+)
+
+// AccessPointPolicy type metadata.
+var (
+	AccessPointPolicyKind             = reflect.TypeOf(AccessPointPolicy{}).Name()
+	AccessPointPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: AccessPointPolicyKind}.String()
+	AccessPointPolicyKindAPIVersion   = AccessPointPolicyKind + "." + SchemeGroupVersion.String()
+	AccessPointPolicyGroupVersionKind = SchemeGroupVersion.WithKind(AccessPointPolicyKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&BucketPolicy{}, &BucketPolicyList{})
+	SchemeBuilder.Register(&Object{}, &ObjectList{})
+	SchemeBuilder.Register(&AccessPoint{}, &AccessPointList{})
+	SchemeBuilder.Register(&AccessPointPolicy{}, &AccessPointPolicyList{})
 }
@@ -22,6 +22,7 @@ package v1alpha3
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/policy/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -75,6 +76,361 @@ func (in *AWSPrincipal) DeepCopy() *AWSPrincipal {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPoint) DeepCopyInto(out *AccessPoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPoint.
+func (in *AccessPoint) DeepCopy() *AccessPoint {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessPoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointList) DeepCopyInto(out *AccessPointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessPoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointList.
+func (in *AccessPointList) DeepCopy() *AccessPointList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessPointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointObservation) DeepCopyInto(out *AccessPointObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointObservation.
+func (in *AccessPointObservation) DeepCopy() *AccessPointObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointParameters) DeepCopyInto(out *AccessPointParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketName != nil {
+		in, out := &in.BucketName, &out.BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketNameRef != nil {
+		in, out := &in.BucketNameRef, &out.BucketNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BucketNameSelector != nil {
+		in, out := &in.BucketNameSelector, &out.BucketNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCConfiguration != nil {
+		in, out := &in.VPCConfiguration, &out.VPCConfiguration
+		*out = new(AccessPointVPCConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PublicAccessBlockConfiguration != nil {
+		in, out := &in.PublicAccessBlockConfiguration, &out.PublicAccessBlockConfiguration
+		*out = new(AccessPointPublicAccessBlockConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointParameters.
+func (in *AccessPointParameters) DeepCopy() *AccessPointParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointPolicy) DeepCopyInto(out *AccessPointPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointPolicy.
+func (in *AccessPointPolicy) DeepCopy() *AccessPointPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessPointPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointPolicyList) DeepCopyInto(out *AccessPointPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessPointPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointPolicyList.
+func (in *AccessPointPolicyList) DeepCopy() *AccessPointPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessPointPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointPolicyParameters) DeepCopyInto(out *AccessPointPolicyParameters) {
+	*out = *in
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessPointName != nil {
+		in, out := &in.AccessPointName, &out.AccessPointName
+		*out = new(string)
+		**out = **in
+	}
+	if in.AccessPointNameRef != nil {
+		in, out := &in.AccessPointNameRef, &out.AccessPointNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.AccessPointNameSelector != nil {
+		in, out := &in.AccessPointNameSelector, &out.AccessPointNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RawPolicy != nil {
+		in, out := &in.RawPolicy, &out.RawPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(v1alpha1.Document)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointPolicyParameters.
+func (in *AccessPointPolicyParameters) DeepCopy() *AccessPointPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointPolicySpec) DeepCopyInto(out *AccessPointPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointPolicySpec.
+func (in *AccessPointPolicySpec) DeepCopy() *AccessPointPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointPolicyStatus) DeepCopyInto(out *AccessPointPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointPolicyStatus.
+func (in *AccessPointPolicyStatus) DeepCopy() *AccessPointPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointPublicAccessBlockConfiguration) DeepCopyInto(out *AccessPointPublicAccessBlockConfiguration) {
+	*out = *in
+	if in.BlockPublicACLs != nil {
+		in, out := &in.BlockPublicACLs, &out.BlockPublicACLs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IgnorePublicACLs != nil {
+		in, out := &in.IgnorePublicACLs, &out.IgnorePublicACLs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlockPublicPolicy != nil {
+		in, out := &in.BlockPublicPolicy, &out.BlockPublicPolicy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestrictPublicBuckets != nil {
+		in, out := &in.RestrictPublicBuckets, &out.RestrictPublicBuckets
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointPublicAccessBlockConfiguration.
+func (in *AccessPointPublicAccessBlockConfiguration) DeepCopy() *AccessPointPublicAccessBlockConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointPublicAccessBlockConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointSpec) DeepCopyInto(out *AccessPointSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointSpec.
+func (in *AccessPointSpec) DeepCopy() *AccessPointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointStatus) DeepCopyInto(out *AccessPointStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointStatus.
+func (in *AccessPointStatus) DeepCopy() *AccessPointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessPointVPCConfiguration) DeepCopyInto(out *AccessPointVPCConfiguration) {
+	*out = *in
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessPointVPCConfiguration.
+func (in *AccessPointVPCConfiguration) DeepCopy() *AccessPointVPCConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessPointVPCConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketPolicy) DeepCopyInto(out *BucketPolicy) {
 	*out = *in
@@ -378,3 +734,251 @@ func (in *ConditionPair) DeepCopy() *ConditionPair {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Object) DeepCopyInto(out *Object) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Object.
+func (in *Object) DeepCopy() *Object {
+	if in == nil {
+		return nil
+	}
+	out := new(Object)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Object) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectContentSource) DeepCopyInto(out *ObjectContentSource) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectContentSource.
+func (in *ObjectContentSource) DeepCopy() *ObjectContentSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectContentSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectList) DeepCopyInto(out *ObjectList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Object, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectList.
+func (in *ObjectList) DeepCopy() *ObjectList {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ObjectList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectObservation) DeepCopyInto(out *ObjectObservation) {
+	*out = *in
+	if in.ETag != nil {
+		in, out := &in.ETag, &out.ETag
+		*out = new(string)
+		**out = **in
+	}
+	if in.VersionID != nil {
+		in, out := &in.VersionID, &out.VersionID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectObservation.
+func (in *ObjectObservation) DeepCopy() *ObjectObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectParameters) DeepCopyInto(out *ObjectParameters) {
+	*out = *in
+	if in.BucketName != nil {
+		in, out := &in.BucketName, &out.BucketName
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketNameRef != nil {
+		in, out := &in.BucketNameRef, &out.BucketNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BucketNameSelector != nil {
+		in, out := &in.BucketNameSelector, &out.BucketNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentBase64 != nil {
+		in, out := &in.ContentBase64, &out.ContentBase64
+		*out = new(string)
+		**out = **in
+	}
+	if in.ContentFrom != nil {
+		in, out := &in.ContentFrom, &out.ContentFrom
+		*out = new(ObjectContentSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServerSideEncryption != nil {
+		in, out := &in.ServerSideEncryption, &out.ServerSideEncryption
+		*out = new(string)
+		**out = **in
+	}
+	if in.SSEKMSKeyID != nil {
+		in, out := &in.SSEKMSKeyID, &out.SSEKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SSEKMSKeyIDRef != nil {
+		in, out := &in.SSEKMSKeyIDRef, &out.SSEKMSKeyIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SSEKMSKeyIDSelector != nil {
+		in, out := &in.SSEKMSKeyIDSelector, &out.SSEKMSKeyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectParameters.
+func (in *ObjectParameters) DeepCopy() *ObjectParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectSpec) DeepCopyInto(out *ObjectSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectSpec.
+func (in *ObjectSpec) DeepCopy() *ObjectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStatus) DeepCopyInto(out *ObjectStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStatus.
+func (in *ObjectStatus) DeepCopy() *ObjectStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
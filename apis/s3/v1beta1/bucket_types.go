@@ -17,15 +17,59 @@ limitations under the License.
 package v1beta1
 
 import (
+	"fmt"
+
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
 	// ResourceCredentialsSecretRegionKey is the key for region that the S3 bucket is located
 	ResourceCredentialsSecretRegionKey = "region"
+
+	// AnnotationKeyAdopt may be set to "true" on a Bucket whose external name
+	// refers to an existing bucket. The next observation late-initializes
+	// every subresource of the spec from the live bucket, including those
+	// that are unset, so that adopting the bucket never removes any of its
+	// configuration. The annotation is removed once the spec is populated.
+	AnnotationKeyAdopt = "s3.aws.crossplane.io/adopt"
+)
+
+// TypeEmptied indicates whether all objects of a Bucket that is being force
+// destroyed have been deleted.
+const TypeEmptied xpv1.ConditionType = "Emptied"
+
+// Reasons a Bucket is or is not emptied.
+const (
+	ReasonEmptying xpv1.ConditionReason = "Emptying"
+	ReasonEmpty    xpv1.ConditionReason = "Empty"
 )
 
+// Emptying returns a condition that indicates the objects of a Bucket are
+// being deleted, and how many object versions and delete markers have been
+// deleted since the Bucket was last reconciled.
+func Emptying(deleted int) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeEmptied,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonEmptying,
+		Message:            fmt.Sprintf("Deleted %d object versions and delete markers, more remain", deleted),
+	}
+}
+
+// Emptied returns a condition that indicates all objects of a Bucket have
+// been deleted.
+func Emptied() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeEmptied,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonEmpty,
+	}
+}
+
 // BucketParameters are parameters for configuring the calls made to AWS Bucket API.
 type BucketParameters struct {
 	// The canned ACL to apply to the bucket. Note that either canned ACL or specific access
@@ -36,8 +80,10 @@ type BucketParameters struct {
 	ACL *string `json:"acl,omitempty"`
 
 	// LocationConstraint specifies the Region where the bucket will be created.
-	// It is a required field.
-	LocationConstraint string `json:"locationConstraint"`
+	// If not set, the default region of the referenced ProviderConfig is used
+	// and recorded here.
+	// +optional
+	LocationConstraint string `json:"locationConstraint,omitempty"`
 
 	// Allows grantee the read, write, read ACP, and write ACP permissions on the
 	// bucket.
@@ -142,6 +188,56 @@ type BucketParameters struct {
 	// PublicAccessBlockConfiguration that you want to apply to this Amazon
 	// S3 bucket.
 	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+
+	// Specifies the ownership of the objects uploaded to the bucket.
+	// For more information, see Using Object Ownership
+	// (https://docs.aws.amazon.com/AmazonS3/latest/userguide/about-object-ownership.html)
+	// in the Amazon S3 User Guide.
+	// +optional
+	OwnershipControls *OwnershipControls `json:"ownershipControls,omitempty"`
+
+	// Specifies the Object Lock configuration of the bucket, including the
+	// default retention of new objects. Object Lock must have been enabled
+	// when the bucket was created.
+	// For more information, see Locking Objects
+	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/object-lock.html).
+	// +optional
+	ObjectLockConfiguration *ObjectLockConfiguration `json:"objectLockConfiguration,omitempty"`
+
+	// Specifies the analytics configurations of the bucket, identified by
+	// their IDs. Analytics configurations that are not specified are removed.
+	// For more information, see Amazon S3 Analytics – Storage Class Analysis
+	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/analytics-storage-class.html).
+	// +optional
+	AnalyticsConfigurations []AnalyticsConfiguration `json:"analyticsConfigurations,omitempty"`
+
+	// Specifies the inventory configurations of the bucket, identified by
+	// their IDs. Inventory configurations that are not specified are removed.
+	// For more information, see Amazon S3 Inventory
+	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/storage-inventory.html).
+	// +optional
+	InventoryConfigurations []InventoryConfiguration `json:"inventoryConfigurations,omitempty"`
+
+	// Specifies the CloudWatch request metrics configurations of the bucket,
+	// identified by their IDs. Metrics configurations that are not specified
+	// are removed.
+	// +optional
+	MetricsConfigurations []MetricsConfiguration `json:"metricsConfigurations,omitempty"`
+
+	// Specifies the S3 Intelligent-Tiering configurations of the bucket,
+	// identified by their IDs. Intelligent-Tiering configurations that are
+	// not specified are removed.
+	// +optional
+	IntelligentTieringConfigurations []IntelligentTieringConfiguration `json:"intelligentTieringConfigurations,omitempty"`
+
+	// ForceDestroy indicates whether all objects of the bucket, including
+	// all object versions and delete markers, are deleted when the bucket is
+	// deleted so that the bucket can be deleted even if it is not empty.
+	// Objects are deleted in batches that may span several reconciles, and
+	// the progress is reported by the Emptied condition. Deleted objects
+	// cannot be recovered.
+	// +optional
+	ForceDestroy *bool `json:"forceDestroy,omitempty"`
 }
 
 // BucketSpec represents the desired state of the Bucket.
@@ -35,6 +35,16 @@ type NotificationConfiguration struct {
 	// are generated.
 	// +optional
 	TopicConfigurations []TopicConfiguration `json:"topicConfigurations,omitempty"`
+
+	// ManageTargetPolicies adds a statement that allows this bucket to publish
+	// notifications to the resource policy of every queue, topic and function
+	// it publishes to before the notification configuration is put, and adds
+	// it again whenever it is missing. Amazon S3 rejects notification
+	// configurations with targets it is not allowed to publish to. Statements
+	// are never removed from target policies. Queues and SNSTopics keep these
+	// statements when their own policies are updated.
+	// +optional
+	ManageTargetPolicies *bool `json:"manageTargetPolicies,omitempty"`
 }
 
 // PublicAccessBlockConfiguration that you want to apply to this Amazon
@@ -106,9 +116,19 @@ type LambdaFunctionConfiguration struct {
 
 	// The Amazon Resource Name (ARN) of the AWS Lambda function that Amazon S3
 	// invokes when the specified event type occurs.
-	//
-	// LambdaFunctionArn is a required field
-	LambdaFunctionArn string `json:"lambdaFunctionArn"`
+	// At least one of lambdaFunctionArn, lambdaFunctionRef or
+	// lambdaFunctionSelector is required.
+	// +optional
+	LambdaFunctionArn string `json:"lambdaFunctionArn,omitempty"`
+
+	// LambdaFunctionArnRef references a Lambda Function to retrieve its Arn
+	// +optional
+	LambdaFunctionArnRef *xpv1.Reference `json:"lambdaFunctionRef,omitempty"`
+
+	// LambdaFunctionArnSelector selects a reference to a Lambda Function to
+	// retrieve its Arn
+	// +optional
+	LambdaFunctionArnSelector *xpv1.Selector `json:"lambdaFunctionSelector,omitempty"`
 }
 
 // QueueConfiguration specifies the configuration for publishing messages to an Amazon Simple Queue
@@ -133,9 +153,17 @@ type QueueConfiguration struct {
 
 	// The Amazon Resource Name (ARN) of the Amazon SQS queue to which Amazon S3
 	// publishes a message when it detects events of the specified type.
-	//
-	// QueueArn is a required field
-	QueueArn string `json:"queueArn"`
+	// At least one of queueArn, queueRef or queueSelector is required.
+	// +optional
+	QueueArn string `json:"queueArn,omitempty"`
+
+	// QueueArnRef references an SQS Queue to retrieve its Arn
+	// +optional
+	QueueArnRef *xpv1.Reference `json:"queueRef,omitempty"`
+
+	// QueueArnSelector selects a reference to an SQS Queue to retrieve its Arn
+	// +optional
+	QueueArnSelector *xpv1.Selector `json:"queueSelector,omitempty"`
 }
 
 // TopicConfiguration specifies the configuration for publication of messages
@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// ObjectLockConfiguration specifies the Object Lock configuration of an
+// Amazon S3 bucket.
+type ObjectLockConfiguration struct {
+	// Indicates whether this bucket has an Object Lock configuration enabled.
+	// Object Lock can only be enabled when the bucket is created, see
+	// objectLockEnabledForBucket.
+	// +kubebuilder:validation:Enum=Enabled
+	// +optional
+	ObjectLockEnabled *string `json:"objectLockEnabled,omitempty"`
+
+	// Specifies the Object Lock rule for the bucket. The rule applies to
+	// every new object placed in the bucket.
+	// +optional
+	Rule *ObjectLockRule `json:"rule,omitempty"`
+}
+
+// ObjectLockRule specifies an Object Lock rule.
+type ObjectLockRule struct {
+	// The default retention period that you want to apply to new objects
+	// placed in the bucket.
+	// +optional
+	DefaultRetention *DefaultRetention `json:"defaultRetention,omitempty"`
+}
+
+// DefaultRetention specifies the default Object Lock retention mode and
+// period of new objects. Either days or years must be specified, but not
+// both.
+type DefaultRetention struct {
+	// The number of days that you want to specify for the default retention
+	// period.
+	// +optional
+	Days *int32 `json:"days,omitempty"`
+
+	// The default Object Lock retention mode you want to apply to new objects
+	// placed in the bucket.
+	// +kubebuilder:validation:Enum=GOVERNANCE;COMPLIANCE
+	Mode string `json:"mode"`
+
+	// The number of years that you want to specify for the default retention
+	// period.
+	// +optional
+	Years *int32 `json:"years,omitempty"`
+}
@@ -20,15 +20,36 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
 )
 
+const (
+	errGetFunction   = "cannot get referenced Function"
+	errListFunctions = "cannot list Functions that match selector"
+	errNoFunction    = "no Functions matched selector"
+	errNoFunctionARN = "referenced Function has no ARN (it may not yet be ready)"
+)
+
+// functionGVK is the kind of Lambda Functions. They are resolved as
+// unstructured objects because the lambda API group references Buckets.
+var functionGVK = schema.GroupVersionKind{Group: "lambda.aws.crossplane.io", Version: "v1alpha1", Kind: "Function"}
+
+// fieldPathFunctionARN is the field path of the ARN of a Lambda Function.
+const fieldPathFunctionARN = "status.atProvider.functionARN"
+
 // SNSTopicARN returns a function that returns the ARN of the given SNS Topic.
 func SNSTopicARN() reference.ExtractValueFn {
 	return func(mg resource.Managed) string {
@@ -74,6 +95,34 @@ func (mg *Bucket) ResolveReferences(ctx context.Context, c client.Reader) error
 		}
 	}
 
+	// Resolve spec.forProvider.notificationConfiguration.queueConfigurations[].queueArn
+	if mg.Spec.ForProvider.NotificationConfiguration != nil {
+		for i, v := range mg.Spec.ForProvider.NotificationConfiguration.QueueConfigurations {
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: v.QueueArn,
+				Reference:    v.QueueArnRef,
+				Selector:     v.QueueArnSelector,
+				To:           reference.To{Managed: &sqsv1beta1.Queue{}, List: &sqsv1beta1.QueueList{}},
+				Extract:      sqsv1beta1.QueueARN(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.notificationConfiguration.queueConfigurations[%d].queueArn", i)
+			}
+			mg.Spec.ForProvider.NotificationConfiguration.QueueConfigurations[i].QueueArn = rsp.ResolvedValue
+			mg.Spec.ForProvider.NotificationConfiguration.QueueConfigurations[i].QueueArnRef = rsp.ResolvedReference
+		}
+	}
+
+	// Resolve spec.forProvider.notificationConfiguration.lambdaFunctionConfigurations[].lambdaFunctionArn
+	if mg.Spec.ForProvider.NotificationConfiguration != nil {
+		for i := range mg.Spec.ForProvider.NotificationConfiguration.LambdaFunctionConfigurations {
+			v := &mg.Spec.ForProvider.NotificationConfiguration.LambdaFunctionConfigurations[i]
+			if err := resolveFunctionARN(ctx, c, mg, v); err != nil {
+				return errors.Wrapf(err, "spec.forProvider.notificationConfiguration.lambdaFunctionConfigurations[%d].lambdaFunctionArn", i)
+			}
+		}
+	}
+
 	// Resolve spec.forProvider.loggingConfiguration.targetBucket
 	if mg.Spec.ForProvider.LoggingConfiguration != nil {
 		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
@@ -126,3 +175,55 @@ func (mg *Bucket) ResolveReferences(ctx context.Context, c client.Reader) error
 
 	return nil
 }
+
+// resolveFunctionARN resolves the Lambda Function the supplied configuration
+// references. Like reference.APIResolver, a reference is only resolved if its
+// value is not yet set.
+func resolveFunctionARN(ctx context.Context, c client.Reader, mg resource.Managed, v *LambdaFunctionConfiguration) error {
+	if meta.WasDeleted(mg) || v.LambdaFunctionArn != "" || (v.LambdaFunctionArnRef == nil && v.LambdaFunctionArnSelector == nil) {
+		return nil
+	}
+
+	// The reference is already set - resolve it.
+	if v.LambdaFunctionArnRef != nil {
+		u := &unstructured.Unstructured{}
+		u.SetGroupVersionKind(functionGVK)
+		if err := c.Get(ctx, types.NamespacedName{Name: v.LambdaFunctionArnRef.Name}, u); err != nil {
+			return errors.Wrap(err, errGetFunction)
+		}
+		arn, err := functionARN(u)
+		if err != nil {
+			return err
+		}
+		v.LambdaFunctionArn = arn
+		return nil
+	}
+
+	// The reference was not set, but a selector was. Select a reference.
+	l := &unstructured.UnstructuredList{}
+	l.SetGroupVersionKind(functionGVK.GroupVersion().WithKind(functionGVK.Kind + "List"))
+	if err := c.List(ctx, l, client.MatchingLabels(v.LambdaFunctionArnSelector.MatchLabels)); err != nil {
+		return errors.Wrap(err, errListFunctions)
+	}
+	for i := range l.Items {
+		if reference.ControllersMustMatch(v.LambdaFunctionArnSelector) && !meta.HaveSameController(mg, &l.Items[i]) {
+			continue
+		}
+		arn, err := functionARN(&l.Items[i])
+		if err != nil {
+			return err
+		}
+		v.LambdaFunctionArn = arn
+		v.LambdaFunctionArnRef = &xpv1.Reference{Name: l.Items[i].GetName()}
+		return nil
+	}
+	return errors.New(errNoFunction)
+}
+
+func functionARN(u *unstructured.Unstructured) (string, error) {
+	arn, err := fieldpath.Pave(u.Object).GetString(fieldPathFunctionARN)
+	if err != nil || arn == "" {
+		return "", errors.New(errNoFunctionARN)
+	}
+	return arn, nil
+}
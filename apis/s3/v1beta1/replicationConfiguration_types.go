@@ -145,6 +145,11 @@ type Destination struct {
 	// this is the account ID of the destination bucket owner. For more information,
 	// see Replication Additional Configuration: Changing the Replica Owner (https://docs.aws.amazon.com/AmazonS3/latest/dev/replication-change-owner.html)
 	// in the Amazon Simple Storage Service Developer Guide.
+	//
+	// If this is omitted and bucketRef or bucketSelector selects a Bucket that
+	// uses a different ProviderConfig than this Bucket, the ID of the account
+	// that ProviderConfig authenticates to is used.
+	// +optional
 	Account *string `json:"account,omitempty"`
 
 	// The Amazon Resource Name (ARN) of the bucket where you want Amazon S3 to
@@ -306,14 +311,33 @@ type ReplicationRuleAndOperator struct {
 
 // SourceSelectionCriteria describes additional filters for identifying the source
 // objects that you want to replicate. You can choose to enable or disable the
-// replication of these objects. Currently, Amazon S3 supports only the filter
-// that you can specify for objects created with server-side encryption using
-// a customer master key (CMK) stored in AWS Key Management Service (SSE-KMS).
+// replication of these objects. Amazon S3 supports filters for objects created
+// with server-side encryption using a customer master key (CMK) stored in AWS
+// Key Management Service (SSE-KMS) and for modifications made to replicas.
 type SourceSelectionCriteria struct {
+	// A filter that you can specify for selections for modifications on replicas.
+	// Amazon S3 doesn't replicate replica modifications by default. In the latest
+	// version of replication configuration (when Filter is specified), you can
+	// specify this element and set the status to Enabled to replicate modifications
+	// on replicas. This element is not allowed in V1 replication configurations.
+	// +optional
+	ReplicaModifications *ReplicaModifications `json:"replicaModifications,omitempty"`
+
 	// A container for filter information for the selection of Amazon S3 objects
-	// encrypted with AWS KMS. If you include SourceSelectionCriteria in the replication
-	// configuration, this element is required.
-	SseKmsEncryptedObjects SseKmsEncryptedObjects `json:"sseKmsEncryptedObjects"`
+	// encrypted with AWS KMS.
+	// +optional
+	SseKmsEncryptedObjects *SseKmsEncryptedObjects `json:"sseKmsEncryptedObjects,omitempty"`
+}
+
+// ReplicaModifications specifies whether Amazon S3 replicates modifications
+// made to replicas, such as metadata and ACL changes.
+type ReplicaModifications struct {
+	// Specifies whether Amazon S3 replicates modifications on replicas.
+	//
+	// Status is a required field
+	// Valid values are "Enabled" or "Disabled"
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	Status string `json:"status"`
 }
 
 // SseKmsEncryptedObjects is the container for filter information
@@ -27,8 +27,10 @@ import (
 // PrivateDNSNamespaceParameters defines the desired state of PrivateDNSNamespace
 type PrivateDNSNamespaceParameters struct {
 	// Region is which region the PrivateDNSNamespace will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// A description for the namespace.
 	Description *string `json:"description,omitempty"`
 	// The name that you want to assign to this namespace. When you create a private
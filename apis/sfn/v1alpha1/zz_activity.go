@@ -27,8 +27,10 @@ import (
 // ActivityParameters defines the desired state of Activity
 type ActivityParameters struct {
 	// Region is which region the Activity will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The name of the activity to create. This name must be unique for your AWS
 	// account and region for 90 days. For more information, see Limits Related
 	// to State Machine Executions (https://docs.aws.amazon.com/step-functions/latest/dg/limits.html#service-limits-state-machine-executions)
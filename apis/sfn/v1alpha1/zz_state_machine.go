@@ -27,8 +27,10 @@ import (
 // StateMachineParameters defines the desired state of StateMachine
 type StateMachineParameters struct {
 	// Region is which region the StateMachine will be created.
-	// +kubebuilder:validation:Required
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 	// The Amazon States Language definition of the state machine. See Amazon States
 	// Language (https://docs.aws.amazon.com/step-functions/latest/dg/concepts-amazon-states-language.html).
 	// +kubebuilder:validation:Required
@@ -41,5 +41,5 @@ func (mg *Queue) ResolveReferences(ctx context.Context, c client.Reader) error {
 		mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARN = aws.String(rsp.ResolvedValue)
 		mg.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARNRef = rsp.ResolvedReference
 	}
-	return nil
+	return mg.Spec.ForProvider.PolicyBody.ResolveReferences(ctx, c, mg, "spec.forProvider.policyBody")
 }
@@ -22,6 +22,7 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/policy/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -130,6 +131,11 @@ func (in *QueueParameters) DeepCopyInto(out *QueueParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PolicyBody != nil {
+		in, out := &in.PolicyBody, &out.PolicyBody
+		*out = new(v1alpha1.Document)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ReceiveMessageWaitTimeSeconds != nil {
 		in, out := &in.ReceiveMessageWaitTimeSeconds, &out.ReceiveMessageWaitTimeSeconds
 		*out = new(int64)
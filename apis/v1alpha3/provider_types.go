@@ -30,7 +30,10 @@ type ProviderSpec struct {
 	CredentialsSecretRef *xpv1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
 
 	// Region for managed resources created using this AWS provider.
-	Region string `json:"region"`
+	// If not set, the default region of the referenced ProviderConfig is
+	// used.
+	// +optional
+	Region string `json:"region,omitempty"`
 
 	// UseServiceAccount indicates to use an IAM Role associated Kubernetes
 	// ServiceAccount for authentication instead of a credentials Secret.
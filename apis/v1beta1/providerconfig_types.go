@@ -28,24 +28,184 @@ type ProviderConfigSpec struct {
 	Credentials ProviderCredentials `json:"credentials"`
 
 	// AssumeRoleARN to assume with provider credentials
+	// Deprecated: Use AssumeRoleChain instead.
 	// +optional
 	AssumeRoleARN *string `json:"assumeRoleARN,omitempty"`
 
+	// AssumeRoleChain is the list of IAM roles to assume in order. The first
+	// role is assumed with the provider credentials and every following one
+	// with the credentials of the role before it. If AssumeRoleARN is given
+	// as well, it is assumed before the roles in this chain.
+	// +optional
+	AssumeRoleChain []AssumeRoleOptions `json:"assumeRoleChain,omitempty"`
+
 	// Endpoint is where you can override the default endpoint configuration
 	// of AWS calls made by the provider.
 	// +optional
 	Endpoint *EndpointConfig `json:"endpoint,omitempty"`
+
+	// DefaultRegion is the region used by the managed resources that
+	// reference this ProviderConfig but do not specify a region themselves.
+	// +optional
+	DefaultRegion *string `json:"defaultRegion,omitempty"`
+
+	// DefaultTags are added to every taggable managed resource that
+	// references this ProviderConfig. Tags specified on a managed resource
+	// take precedence over the default tags with the same key.
+	// +optional
+	DefaultTags map[string]string `json:"defaultTags,omitempty"`
+
+	// Retry configures how the AWS API calls made with this ProviderConfig
+	// are retried and rate limited. Unset fields default to the values the
+	// provider is started with.
+	// +optional
+	Retry *RetryConfig `json:"retry,omitempty"`
 }
 
+// CredentialsSourceWebIdentity indicates that the provider should assume an
+// IAM role with a web identity token read from a file.
+const CredentialsSourceWebIdentity xpv1.CredentialsSource = "WebIdentity"
+
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	// Source of the provider credentials.
-	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem;WebIdentity
 	Source xpv1.CredentialsSource `json:"source"`
 
+	// Profile of the shared credentials file to use when the source is Secret
+	// or Filesystem. Profiles that do not contain static access keys are
+	// resolved with the full semantics of the AWS shared configuration, e.g.
+	// credential_process or role_arn and source_profile. For security reasons
+	// credential_process is only allowed with the Filesystem source, and
+	// Secrets that contain it are rejected. Defaults to the default profile.
+	// +optional
+	Profile *string `json:"profile,omitempty"`
+
+	// WebIdentity defines the role to assume with a web identity token. It is
+	// required when the source is WebIdentity.
+	// +optional
+	WebIdentity *WebIdentityConfig `json:"webIdentity,omitempty"`
+
 	xpv1.CommonCredentialSelectors `json:",inline"`
 }
 
+// WebIdentityConfig configures how the provider assumes an IAM role with a
+// web identity token, i.e. by calling AssumeRoleWithWebIdentity. This lets
+// the provider authenticate with a projected service account token of any
+// cluster whose OIDC issuer is registered as an identity provider in AWS.
+type WebIdentityConfig struct {
+	// RoleARN of the IAM role to assume.
+	RoleARN string `json:"roleARN"`
+
+	// TokenFile is the path of the file that contains the web identity
+	// token. The file is read whenever the credentials are refreshed, so
+	// projected tokens that are rotated by the kubelet are picked up.
+	TokenFile string `json:"tokenFile"`
+
+	// RoleSessionName is the name of the assumed role session. A name is
+	// generated if it is not given.
+	// +optional
+	RoleSessionName *string `json:"roleSessionName,omitempty"`
+}
+
+// AssumeRoleOptions define the options for assuming an IAM role.
+type AssumeRoleOptions struct {
+	// RoleARN of the IAM role to assume.
+	RoleARN string `json:"roleARN"`
+
+	// ExternalID is a unique identifier that the trust policy of the role
+	// may require to be passed when the role is assumed, typically when it
+	// belongs to another account.
+	// +optional
+	ExternalID *string `json:"externalID,omitempty"`
+
+	// RoleSessionName is the name of the assumed role session. A name is
+	// generated if it is not given.
+	// +optional
+	RoleSessionName *string `json:"roleSessionName,omitempty"`
+
+	// Duration of the assumed role session. Defaults to 15 minutes. It
+	// cannot exceed the maximum session duration of the role, and is
+	// limited to one hour for roles assumed with the credentials of another
+	// role.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Tags are the session tags to pass when assuming the role.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// TransitiveTagKeys are the keys of the session tags that persist to
+	// the roles assumed later in the chain.
+	// +optional
+	TransitiveTagKeys []string `json:"transitiveTagKeys,omitempty"`
+}
+
+// Tag is a key-value pair.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	Value string `json:"value"`
+}
+
+// A RetryMode determines how AWS API calls are retried.
+type RetryMode string
+
+// Retry modes.
+const (
+	// RetryModeStandard retries failed calls with an exponential backoff.
+	RetryModeStandard RetryMode = "Standard"
+
+	// RetryModeAdaptive retries failed calls like RetryModeStandard and in
+	// addition lowers the client-side request rate of a service whenever it
+	// throttles a call, recovering gradually as calls succeed again.
+	RetryModeAdaptive RetryMode = "Adaptive"
+)
+
+// RetryConfig configures how AWS API calls are retried and rate limited.
+type RetryConfig struct {
+	// MaxRetries is the maximum number of times a failed call is retried.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxRetries *int `json:"maxRetries,omitempty"`
+
+	// MaxBackoff is the maximum delay between two attempts of a call.
+	// +optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+
+	// Mode determines how failed calls are retried.
+	// +optional
+	// +kubebuilder:validation:Enum=Standard;Adaptive
+	Mode *RetryMode `json:"mode,omitempty"`
+
+	// RateLimits are client-side limits of the rate of calls made to AWS
+	// services. Services are limited independently of each other so that
+	// throttling of one service does not delay calls to the others. They
+	// override the limits the provider is started with for the same service.
+	// +optional
+	RateLimits []ServiceRateLimit `json:"rateLimits,omitempty"`
+}
+
+// ServiceRateLimit is a token bucket limit of the rate of calls made to an
+// AWS service.
+type ServiceRateLimit struct {
+	// Service is the ID of the AWS service to limit, e.g. EC2 or IAM. It is
+	// matched case-insensitively and regardless of spaces.
+	Service string `json:"service"`
+
+	// RequestsPerSecond is the sustained rate of calls allowed.
+	// +kubebuilder:validation:Minimum=1
+	RequestsPerSecond int `json:"requestsPerSecond"`
+
+	// Burst is the maximum number of calls allowed at once. Defaults to
+	// RequestsPerSecond.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Burst *int `json:"burst,omitempty"`
+}
+
 // EndpointConfig is used to configure the AWS client for a custom endpoint.
 type EndpointConfig struct {
 	// URL lets you configure the endpoint URL to be used in SDK calls.
@@ -148,13 +308,24 @@ type DynamicURLConfig struct {
 // A ProviderConfigStatus represents the status of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// AccountID of the AWS account that the credentials resolve to.
+	// +optional
+	AccountID *string `json:"accountID,omitempty"`
+
+	// ARN of the identity that the credentials resolve to.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 
 // A ProviderConfig configures how AWS controllers will connect to AWS API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT-ID",type="string",JSONPath=".status.accountID"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentialsSecretRef.name",priority=1
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.arn",priority=1
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,aws}
 // +kubebuilder:subresource:status
 type ProviderConfig struct {
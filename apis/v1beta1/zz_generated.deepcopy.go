@@ -21,9 +21,50 @@ limitations under the License.
 package v1beta1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssumeRoleOptions) DeepCopyInto(out *AssumeRoleOptions) {
+	*out = *in
+	if in.ExternalID != nil {
+		in, out := &in.ExternalID, &out.ExternalID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleSessionName != nil {
+		in, out := &in.RoleSessionName, &out.RoleSessionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.TransitiveTagKeys != nil {
+		in, out := &in.TransitiveTagKeys, &out.TransitiveTagKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssumeRoleOptions.
+func (in *AssumeRoleOptions) DeepCopy() *AssumeRoleOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AssumeRoleOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DynamicURLConfig) DeepCopyInto(out *DynamicURLConfig) {
 	*out = *in
@@ -153,11 +194,35 @@ func (in *ProviderConfigSpec) DeepCopyInto(out *ProviderConfigSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AssumeRoleChain != nil {
+		in, out := &in.AssumeRoleChain, &out.AssumeRoleChain
+		*out = make([]AssumeRoleOptions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Endpoint != nil {
 		in, out := &in.Endpoint, &out.Endpoint
 		*out = new(EndpointConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DefaultRegion != nil {
+		in, out := &in.DefaultRegion, &out.DefaultRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultTags != nil {
+		in, out := &in.DefaultTags, &out.DefaultTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetryConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigSpec.
@@ -174,6 +239,16 @@ func (in *ProviderConfigSpec) DeepCopy() *ProviderConfigSpec {
 func (in *ProviderConfigStatus) DeepCopyInto(out *ProviderConfigStatus) {
 	*out = *in
 	in.ProviderConfigStatus.DeepCopyInto(&out.ProviderConfigStatus)
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderConfigStatus.
@@ -247,6 +322,16 @@ func (in *ProviderConfigUsageList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderCredentials) DeepCopyInto(out *ProviderCredentials) {
 	*out = *in
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(string)
+		**out = **in
+	}
+	if in.WebIdentity != nil {
+		in, out := &in.WebIdentity, &out.WebIdentity
+		*out = new(WebIdentityConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	in.CommonCredentialSelectors.DeepCopyInto(&out.CommonCredentialSelectors)
 }
 
@@ -260,6 +345,78 @@ func (in *ProviderCredentials) DeepCopy() *ProviderCredentials {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryConfig) DeepCopyInto(out *RetryConfig) {
+	*out = *in
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(RetryMode)
+		**out = **in
+	}
+	if in.RateLimits != nil {
+		in, out := &in.RateLimits, &out.RateLimits
+		*out = make([]ServiceRateLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryConfig.
+func (in *RetryConfig) DeepCopy() *RetryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceRateLimit) DeepCopyInto(out *ServiceRateLimit) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceRateLimit.
+func (in *ServiceRateLimit) DeepCopy() *ServiceRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *URLConfig) DeepCopyInto(out *URLConfig) {
 	*out = *in
@@ -284,3 +441,23 @@ func (in *URLConfig) DeepCopy() *URLConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebIdentityConfig) DeepCopyInto(out *WebIdentityConfig) {
+	*out = *in
+	if in.RoleSessionName != nil {
+		in, out := &in.RoleSessionName, &out.RoleSessionName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebIdentityConfig.
+func (in *WebIdentityConfig) DeepCopy() *WebIdentityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WebIdentityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
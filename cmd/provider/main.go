@@ -19,6 +19,7 @@ package main
 import (
 	"os"
 	"path/filepath"
+	"strconv"
 
 	"gopkg.in/alecthomas/kingpin.v2"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -29,6 +30,8 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 
 	"github.com/crossplane/provider-aws/apis"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/controller"
 )
 
@@ -39,6 +42,14 @@ func main() {
 		syncInterval   = app.Flag("sync", "Sync interval controls how often all resources will be double checked for drift.").Short('s').Default("1h").Duration()
 		pollInterval   = app.Flag("poll", "Poll interval controls how often an individual resource should be checked for drift.").Default("1m").Duration()
 		leaderElection = app.Flag("leader-election", "Use leader election for the conroller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
+		configCacheTTL = app.Flag("config-cache-ttl", "Maximum duration the AWS client configs built for a ProviderConfig are reused. Set to 0 to disable caching.").Default(awsclient.DefaultConfigCacheTTL.String()).Duration()
+		maxRetries     = app.Flag("max-retries", "Maximum number of times a failed AWS API call is retried. Defaults to the SDK default of each AWS service.").Default(strconv.Itoa(awsclient.UseServiceDefaultRetries)).Int()
+		maxBackoff     = app.Flag("max-backoff", "Maximum delay between two attempts of an AWS API call.").Default(awsclient.DefaultMaxBackoff.String()).Duration()
+		retryMode      = app.Flag("retry-mode", "How failed AWS API calls are retried. Adaptive mode also lowers the rate of calls to an AWS service whenever it throttles them.").Default(string(v1beta1.RetryModeStandard)).Enum(string(v1beta1.RetryModeStandard), string(v1beta1.RetryModeAdaptive))
+		enable         = app.Flag("enable-controllers", "Set up only the controllers of the kinds that match these GROUP[/KIND] globs, e.g. s3 or ec2/VPC. Defaults to all kinds. Can be repeated or comma separated.").Strings()
+		disable        = app.Flag("disable-controllers", "Do not set up the controllers of the kinds that match these GROUP[/KIND] globs, even if they are enabled. Can be repeated or comma separated.").Strings()
+		pollOverrides  = app.Flag("poll-override", "Poll interval of the controllers of the kinds that match a comma separated list of GROUP[/KIND] globs, in the form PATTERNS=DURATION, e.g. ec2/SecurityGroup=30s. Overrides --poll. Can be repeated; the last matching override applies.").Strings()
+		rateLimits     = app.Flag("rate-limit", "Client-side limit of the rate of calls to an AWS service per ProviderConfig, in the form SERVICE=RPS[:BURST], e.g. EC2=20:40. Can be repeated.").Strings()
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
@@ -53,6 +64,27 @@ func main() {
 
 	log.Debug("Starting", "sync-period", syncInterval.String())
 
+	filter, err := controller.NewFilter(*enable, *disable)
+	kingpin.FatalIfError(err, "Cannot parse enabled and disabled controllers")
+
+	polls, err := controller.NewPollIntervals(*pollInterval, *pollOverrides)
+	kingpin.FatalIfError(err, "Cannot parse poll interval overrides")
+
+	awsclient.SetConfigCacheTTL(*configCacheTTL)
+
+	ro := awsclient.RetryOptions{
+		MaxRetries: *maxRetries,
+		MaxBackoff: *maxBackoff,
+		Mode:       v1beta1.RetryMode(*retryMode),
+		RateLimits: map[string]awsclient.RateLimit{},
+	}
+	for _, rl := range *rateLimits {
+		svc, l, err := awsclient.ParseRateLimit(rl)
+		kingpin.FatalIfError(err, "Cannot parse rate limit")
+		ro.RateLimits[svc] = l
+	}
+	awsclient.SetRetryOptions(ro)
+
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
@@ -64,7 +96,7 @@ func main() {
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add AWS APIs to scheme")
-	kingpin.FatalIfError(controller.Setup(mgr, log, ratelimiter.NewGlobal(ratelimiter.DefaultGlobalRPS), *pollInterval), "Cannot setup AWS controllers")
+	kingpin.FatalIfError(controller.Setup(mgr, log, ratelimiter.NewGlobal(ratelimiter.DefaultGlobalRPS), polls, filter), "Cannot setup AWS controllers")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 
 }
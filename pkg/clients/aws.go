@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net"
 	"net/url"
+	"os"
 	"strings"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	stscreds "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	ec2type "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	awsv1 "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
@@ -40,8 +42,6 @@ import (
 	"github.com/aws/smithy-go"
 	jsonpatch "github.com/evanphx/json-patch"
 	"github.com/go-ini/ini"
-	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -49,6 +49,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/v1alpha3"
@@ -88,14 +89,22 @@ func GetConfig(ctx context.Context, c client.Client, mg resource.Managed, region
 	case mg.GetProviderConfigReference() != nil:
 		return UseProviderConfig(ctx, c, mg, region)
 	case mg.GetProviderReference() != nil:
-		return UseProvider(ctx, c, mg, region)
+		cfg, err := UseProvider(ctx, c, mg, region)
+		if err != nil {
+			return nil, err
+		}
+		withRetryOptions(cfg, providerLimiterScope(mg.GetProviderReference().Name), retryOptions(nil))
+		withMetrics(cfg)
+		return cfg, nil
 	default:
 		return nil, errors.New("neither providerConfigRef nor providerRef is given")
 	}
 }
 
 // UseProviderConfig to produce a config that can be used to authenticate to AWS.
-func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) { // nolint:gocyclo
+// Configs are cached per ProviderConfig generation, credentials and region so
+// that managed resources referencing the same ProviderConfig share them.
+func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) {
 	pc := &v1beta1.ProviderConfig{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
 		return nil, errors.Wrap(err, "cannot get referenced Provider")
@@ -106,33 +115,219 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
+	return GetConfigForProviderConfig(ctx, c, pc, region)
+}
+
+// GetConfigForProviderConfig produces a config that can be used to
+// authenticate to AWS with the supplied ProviderConfig. Unlike
+// UseProviderConfig, it does not track the usage of the ProviderConfig.
+func GetConfigForProviderConfig(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
+	data, err := providerConfigCredentials(ctx, c, pc)
+	if err != nil {
+		return nil, err
+	}
+	region = providerConfigRegion(pc, region)
+	k := newConfigCacheKey(pc, data, region)
+	if cfg, ok := configCache.getConfig(k); ok {
+		return cfg, nil
+	}
+	cfg, err := configForProviderConfig(ctx, pc, data, region)
+	if err != nil {
+		return nil, err
+	}
+	withRetryOptions(cfg, pc.GetName(), retryOptions(pc))
+	withMetrics(cfg)
+	configCache.setConfig(k, cfg)
+	return cfg, nil
+}
+
+// GetCallerIdentity returns the identity that the supplied ProviderConfig
+// authenticates to AWS as.
+func GetCallerIdentity(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*sts.GetCallerIdentityOutput, error) {
+	cfg, err := GetConfigForProviderConfig(ctx, c, pc, region)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get config")
+	}
+	id, err := sts.NewFromConfig(*cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	return id, errors.Wrap(err, "cannot get caller identity")
+}
+
+// GetAccountID returns the ID of the AWS account that the supplied
+// ProviderConfig authenticates to. The account the ProviderConfig health check
+// records in its status is used if present, so that STS is only called for
+// ProviderConfigs that have not been checked yet.
+func GetAccountID(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (string, error) {
+	if id := aws.ToString(pc.Status.AccountID); id != "" {
+		return id, nil
+	}
+	id, err := GetCallerIdentity(ctx, c, pc, region)
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(id.Account), nil
+}
+
+// GetDefaultTags returns the default tags of the ProviderConfig the supplied
+// managed resource references. Callers own the returned map.
+func GetDefaultTags(ctx context.Context, c client.Client, mg resource.Managed) (map[string]string, error) {
+	tags := map[string]string{}
+	if mg.GetProviderConfigReference() == nil {
+		return tags, nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, "cannot get referenced ProviderConfig")
+	}
+	for k, v := range pc.Spec.DefaultTags {
+		tags[k] = v
+	}
+	return tags, nil
+}
+
+// AnnotationKeyDefaultTags is the key in the annotations map of a managed
+// resource under which the ProviderConfig default tags that were last merged
+// into its tags are recorded, encoded as a JSON object.
+const AnnotationKeyDefaultTags = "aws.crossplane.io/default-tags"
+
+// MergeDefaultTags returns the default tags of the ProviderConfig the supplied
+// managed resource references, overridden by the supplied tags and then by
+// the external tags Crossplane adds to every resource. Supplied tags that
+// equal a default tag recorded by a previous merge are considered to stem from
+// that default, and are dropped or changed along with it. The default tags
+// that were merged are recorded in the AnnotationKeyDefaultTags annotation of
+// the supplied managed resource, which callers must persist. Callers own the
+// returned map.
+func MergeDefaultTags(ctx context.Context, c client.Client, mg resource.Managed, tags map[string]string) (map[string]string, error) {
+	defaults, err := GetDefaultTags(ctx, c, mg)
+	if err != nil {
+		return nil, err
+	}
+	previous := map[string]string{}
+	if a, ok := mg.GetAnnotations()[AnnotationKeyDefaultTags]; ok {
+		// NOTE: An annotation we can't decode is treated as if no default
+		// tags were recorded yet.
+		_ = json.Unmarshal([]byte(a), &previous)
+	}
+	merged := map[string]string{}
+	for k, v := range tags {
+		if p, ok := previous[k]; ok && p == v {
+			continue
+		}
+		merged[k] = v
+	}
+	for k, v := range defaults {
+		if _, ok := merged[k]; ok {
+			// NOTE: Tags that equal a default tag are recorded too, so that
+			// defaults that were merged before they were recorded follow
+			// the ProviderConfig from now on.
+			if merged[k] != v {
+				delete(defaults, k)
+			}
+			continue
+		}
+		merged[k] = v
+	}
+	for k, v := range resource.GetExternalTags(mg) {
+		merged[k] = v
+		delete(defaults, k)
+	}
+	if len(defaults) == 0 {
+		meta.RemoveAnnotations(mg, AnnotationKeyDefaultTags)
+		return merged, nil
+	}
+	a, err := json.Marshal(defaults)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot encode default tags")
+	}
+	meta.AddAnnotations(mg, map[string]string{AnnotationKeyDefaultTags: string(a)})
+	return merged, nil
+}
+
+// providerConfigCredentials returns the credentials data the supplied
+// ProviderConfig refers to, if any.
+func providerConfigCredentials(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) ([]byte, error) {
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
+	case xpv1.CredentialsSourceInjectedIdentity, v1beta1.CredentialsSourceWebIdentity:
+		return []byte{}, nil
+	case xpv1.CredentialsSourceSecret:
+		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot get credentials")
+		}
+		return data, errors.Wrap(rejectCredentialProcess(data), "cannot use credentials secret")
+	default:
+		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
+		return data, errors.Wrap(err, "cannot get credentials")
+	}
+}
+
+// rejectCredentialProcess returns an error if any profile of the supplied
+// shared credentials or config file runs a credential_process. Anyone who
+// can write the Secret a ProviderConfig references could otherwise run
+// arbitrary commands in the provider pod. Credential processes have to be
+// configured in a file of the pod, i.e. with the Filesystem source.
+func rejectCredentialProcess(data []byte) error {
+	cfg, err := ini.InsensitiveLoad(data)
+	if err != nil {
+		// NOTE: Parse errors are reported when the profile is loaded.
+		return nil
+	}
+	for _, s := range cfg.Sections() {
+		if s.HasKey("credential_process") {
+			return errors.Errorf("credential_process of profile %q is not allowed in a Secret, use the Filesystem credentials source instead", s.Name())
+		}
+	}
+	return nil
+}
+
+// providerConfigRegion returns the supplied region, or the default region of
+// the supplied ProviderConfig if no region is supplied.
+func providerConfigRegion(pc *v1beta1.ProviderConfig, region string) string {
+	if region == "" && pc.Spec.DefaultRegion != nil {
+		return *pc.Spec.DefaultRegion
+	}
+	return region
+}
+
+// credentialsProfile returns the profile of the credentials file to use for
+// the supplied ProviderConfig.
+func credentialsProfile(pc *v1beta1.ProviderConfig) string {
+	if pc.Spec.Credentials.Profile != nil {
+		return *pc.Spec.Credentials.Profile
+	}
+	return DefaultSection
+}
+
+func configForProviderConfig(ctx context.Context, pc *v1beta1.ProviderConfig, data []byte, region string) (*aws.Config, error) { // nolint:gocyclo
+	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
+	case v1beta1.CredentialsSourceWebIdentity:
+		cfg, err := UseWebIdentity(ctx, region, pc)
+		if err != nil {
+			return nil, err
+		}
+		return SetResolver(pc, cfg), nil
 	case xpv1.CredentialsSourceInjectedIdentity:
-		if pc.Spec.AssumeRoleARN != nil {
-			cfg, err := UsePodServiceAccountAssumeRole(ctx, []byte{}, DefaultSection, region, pc)
+		if len(assumeRoleChain(pc)) > 0 {
+			cfg, err := UsePodServiceAccountAssumeRole(ctx, data, DefaultSection, region, pc)
 			if err != nil {
 				return nil, err
 			}
 			return SetResolver(pc, cfg), nil
 		}
-		cfg, err := UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
+		cfg, err := UsePodServiceAccount(ctx, data, DefaultSection, region)
 		if err != nil {
 			return nil, err
 		}
 		return SetResolver(pc, cfg), nil
 	default:
-		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot get credentials")
-		}
-		if pc.Spec.AssumeRoleARN != nil {
-			cfg, err := UseProviderSecretAssumeRole(ctx, data, DefaultSection, region, pc)
+		if len(assumeRoleChain(pc)) > 0 {
+			cfg, err := UseProviderSecretAssumeRole(ctx, data, credentialsProfile(pc), region, pc)
 			if err != nil {
 				return nil, err
 			}
 			return SetResolver(pc, cfg), nil
 		}
-		cfg, err := UseProviderSecret(ctx, data, DefaultSection, region)
+		cfg, err := UseProviderSecret(ctx, data, credentialsProfile(pc), region)
 		if err != nil {
 			return nil, err
 		}
@@ -272,8 +467,51 @@ func CredentialsIDSecret(data []byte, profile string) (aws.Credentials, error) {
 // AuthMethod is a method of authenticating to the AWS API
 type AuthMethod func(context.Context, []byte, string, string) (*aws.Config, error)
 
+// assumeRoleChain returns the IAM roles to assume for the supplied
+// ProviderConfig in order.
+func assumeRoleChain(pc *v1beta1.ProviderConfig) []v1beta1.AssumeRoleOptions {
+	chain := make([]v1beta1.AssumeRoleOptions, 0, len(pc.Spec.AssumeRoleChain)+1)
+	if pc.Spec.AssumeRoleARN != nil {
+		chain = append(chain, v1beta1.AssumeRoleOptions{RoleARN: *pc.Spec.AssumeRoleARN})
+	}
+	return append(chain, pc.Spec.AssumeRoleChain...)
+}
+
+// assumeRoles returns a credentials provider that assumes the roles in the
+// assume role chain of the supplied ProviderConfig, starting with the
+// credentials of the supplied config. Every role is assumed with the
+// credentials of the role before it.
+func assumeRoles(cfg aws.Config, pc *v1beta1.ProviderConfig) aws.CredentialsProvider {
+	for _, o := range assumeRoleChain(pc) {
+		cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), o.RoleARN, withAssumeRoleOptions(o)))
+	}
+	return cfg.Credentials
+}
+
+func withAssumeRoleOptions(o v1beta1.AssumeRoleOptions) func(*stscreds.AssumeRoleOptions) {
+	return func(ao *stscreds.AssumeRoleOptions) {
+		ao.ExternalID = o.ExternalID
+		ao.RoleSessionName = StringValue(o.RoleSessionName)
+		if o.Duration != nil {
+			ao.Duration = o.Duration.Duration
+		}
+		for _, t := range o.Tags {
+			ao.Tags = append(ao.Tags, ststypes.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+		}
+		ao.TransitiveTagKeys = o.TransitiveTagKeys
+	}
+}
+
 // UseProviderSecret - AWS configuration which can be used to issue requests against AWS API
 func UseProviderSecret(ctx context.Context, data []byte, profile, region string) (*aws.Config, error) {
+	shared, err := usesSharedConfig(data, profile)
+	if err != nil {
+		return nil, err
+	}
+	if shared {
+		return UseSharedConfig(ctx, data, profile, region)
+	}
+
 	creds, err := CredentialsIDSecret(data, profile)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot parse credentials secret")
@@ -288,20 +526,68 @@ func UseProviderSecret(ctx context.Context, data []byte, profile, region string)
 // UseProviderSecretAssumeRole - AWS configuration which can be used to issue requests against AWS API
 // assume Cross account IAM roles
 func UseProviderSecretAssumeRole(ctx context.Context, data []byte, profile, region string, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
-	creds, err := CredentialsIDSecret(data, profile)
+	config, err := UseProviderSecret(ctx, data, profile, region)
 	if err != nil {
-		return nil, errors.Wrap(err, "cannot parse credentials secret")
+		return nil, errors.Wrap(err, "failed to load credentials")
 	}
+	config.Credentials = assumeRoles(*config, pc)
 
-	config, err := config.LoadDefaultConfig(ctx, config.WithRegion(region), config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
-		Value: creds,
-	}))
+	return config, nil
+}
 
-	stsSvc := sts.NewFromConfig(config)
-	stsAssume := stscreds.NewAssumeRoleProvider(stsSvc, StringValue(pc.Spec.AssumeRoleARN))
-	config.Credentials = aws.NewCredentialsCache(stsAssume)
+// usesSharedConfig returns whether the given profile of the supplied
+// credentials file needs to be resolved with the AWS shared configuration
+// semantics, i.e. it does not contain static access keys.
+func usesSharedConfig(data []byte, profile string) (bool, error) {
+	cfg, err := ini.InsensitiveLoad(data)
+	if err != nil {
+		return false, errors.Wrap(err, "cannot parse credentials secret")
+	}
+	iniProfile, err := cfg.GetSection(profile)
+	if err != nil {
+		// NOTE: Profiles of shared config files are prefixed.
+		if _, perr := cfg.GetSection("profile " + profile); perr != nil {
+			return false, errors.Wrap(err, fmt.Sprintf("cannot get %s profile in credentials secret", profile))
+		}
+		return true, nil
+	}
+	return !iniProfile.HasKey("aws_access_key_id"), nil
+}
 
-	return &config, err
+// UseSharedConfig loads the AWS configuration of the given profile from the
+// supplied shared credentials or config file. All settings of the profile
+// are honoured, so credentials can be sourced from a credential_process or
+// by assuming a role_arn with the credentials of a source_profile. Callers
+// must only supply files that are trusted to run a credential_process.
+// https://docs.aws.amazon.com/sdkref/latest/guide/file-format.html
+func UseSharedConfig(ctx context.Context, data []byte, profile, region string) (*aws.Config, error) {
+	// NOTE: The SDK can only load shared configuration from files. The file
+	// is only read while the config is loaded, so it's removed right after.
+	f, err := os.CreateTemp("", "provider-aws-")
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create shared config file")
+	}
+	defer os.Remove(f.Name()) // nolint:errcheck
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return nil, errors.Wrap(err, "cannot write shared config file")
+	}
+	if err := f.Close(); err != nil {
+		return nil, errors.Wrap(err, "cannot write shared config file")
+	}
+
+	// The file is given both as credentials and config file so that profiles
+	// can be defined in either format.
+	cfg, err := config.LoadDefaultConfig(ctx,
+		config.WithRegion(region),
+		config.WithSharedConfigProfile(profile),
+		config.WithSharedCredentialsFiles([]string{f.Name()}),
+		config.WithSharedConfigFiles([]string{f.Name()}),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("cannot load %s profile in shared config", profile))
+	}
+	return &cfg, nil
 }
 
 // UsePodServiceAccountAssumeRole assumes an IAM role configured via a ServiceAccount
@@ -312,16 +598,10 @@ func UsePodServiceAccountAssumeRole(ctx context.Context, _ []byte, _, region str
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
-	stsclient := sts.NewFromConfig(cfg)
 	cnf, err := config.LoadDefaultConfig(
 		ctx,
 		config.WithRegion(region),
-		config.WithCredentialsProvider(aws.NewCredentialsCache(
-			stscreds.NewAssumeRoleProvider(
-				stsclient,
-				StringValue(pc.Spec.AssumeRoleARN),
-			)),
-		),
+		config.WithCredentialsProvider(assumeRoles(cfg, pc)),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
@@ -342,12 +622,40 @@ func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws
 	return &cfg, err
 }
 
+// UseWebIdentity assumes the IAM role configured in the ProviderConfig with the
+// web identity token read from the configured file. The assumed role is then
+// used to assume the roles in the assume role chain of the ProviderConfig.
+// https://docs.aws.amazon.com/STS/latest/APIReference/API_AssumeRoleWithWebIdentity.html
+func UseWebIdentity(ctx context.Context, region string, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
+	wi := pc.Spec.Credentials.WebIdentity
+	if wi == nil {
+		return nil, errors.New("webIdentity configuration is required for WebIdentity credentials source")
+	}
+	// NOTE: AssumeRoleWithWebIdentity calls are not signed, the web identity
+	// token is what authenticates them.
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(region), config.WithCredentialsProvider(aws.AnonymousCredentials{}))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load default AWS config")
+	}
+	cfg.Credentials = aws.NewCredentialsCache(stscreds.NewWebIdentityRoleProvider(
+		sts.NewFromConfig(cfg),
+		wi.RoleARN,
+		stscreds.IdentityTokenFile(wi.TokenFile),
+		func(o *stscreds.WebIdentityRoleOptions) {
+			o.RoleSessionName = StringValue(wi.RoleSessionName)
+		},
+	))
+	cfg.Credentials = assumeRoles(cfg, pc)
+	return &cfg, nil
+}
+
 // NOTE(muvaf): ACK-generated controllers use aws/aws-sdk-go instead of
 // aws/aws-sdk-go-v2. These functions are implemented to be used by those controllers.
 
 // GetConfigV1 constructs an *awsv1.Config that can be used to authenticate to AWS
-// API by the AWSv1 clients.
-func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) { // nolint:gocyclo
+// API by the AWSv1 clients. Sessions are cached the same way UseProviderConfig
+// caches configs.
+func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) {
 	if mg.GetProviderConfigReference() == nil {
 		return nil, errors.New("providerConfigRef cannot be empty")
 	}
@@ -360,70 +668,68 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 	if err := t.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
+
+	data, err := providerConfigCredentials(ctx, c, pc)
+	if err != nil {
+		return nil, err
+	}
+	region = providerConfigRegion(pc, region)
+	k := newConfigCacheKey(pc, data, region)
+	if sess, ok := configCache.getSession(k); ok {
+		return sess, nil
+	}
+	cfg, err := configV1ForProviderConfig(ctx, pc, data, region)
+	if err != nil {
+		return nil, err
+	}
+	o := retryOptions(pc)
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, err
+	}
+	withRetryOptionsV1(sess, o)
+	withRateLimitsV1(sess, pc.GetName(), o)
+	withMetricsV1(sess)
+	configCache.setSession(k, sess)
+	return sess, nil
+}
+
+func configV1ForProviderConfig(ctx context.Context, pc *v1beta1.ProviderConfig, data []byte, region string) (*awsv1.Config, error) {
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
+	case v1beta1.CredentialsSourceWebIdentity:
+		cfg, err := UseWebIdentityV1(ctx, region, pc)
+		return cfg, errors.Wrap(err, "cannot use web identity")
 	case xpv1.CredentialsSourceInjectedIdentity:
-		if pc.Spec.AssumeRoleARN != nil {
-			cfg, err := UsePodServiceAccountV1AssumeRole(ctx, []byte{}, pc, DefaultSection, region)
-			if err != nil {
-				return nil, errors.Wrap(err, "cannot use pod service account to assume role")
-			}
-			return session.NewSession(cfg)
-		}
-		cfg, err := UsePodServiceAccountV1(ctx, []byte{}, pc, DefaultSection, region)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot use pod service account")
+		if len(assumeRoleChain(pc)) > 0 {
+			cfg, err := UsePodServiceAccountV1AssumeRole(ctx, data, pc, DefaultSection, region)
+			return cfg, errors.Wrap(err, "cannot use pod service account to assume role")
 		}
-		return session.NewSession(cfg)
+		cfg, err := UsePodServiceAccountV1(ctx, data, pc, DefaultSection, region)
+		return cfg, errors.Wrap(err, "cannot use pod service account")
 	default:
-		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot get credentials")
-		}
-
-		if pc.Spec.AssumeRoleARN != nil {
-			cfg, err := UseProviderSecretV1AssumeRole(ctx, data, pc, DefaultSection, region)
-			if err != nil {
-				return nil, errors.Wrap(err, "cannot use secret")
-			}
-			return session.NewSession(cfg)
-		}
-		cfg, err := UseProviderSecretV1(ctx, data, pc, DefaultSection, region)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot use secret")
+		if len(assumeRoleChain(pc)) > 0 {
+			cfg, err := UseProviderSecretV1AssumeRole(ctx, data, pc, credentialsProfile(pc), region)
+			return cfg, errors.Wrap(err, "cannot use secret")
 		}
-		return session.NewSession(cfg)
+		cfg, err := UseProviderSecretV1(ctx, data, pc, credentialsProfile(pc), region)
+		return cfg, errors.Wrap(err, "cannot use secret")
 	}
 }
 
 // UseProviderSecretV1AssumeRole - AWS v1 configuration which can be used to issue requests against AWS API
 // assume Cross account IAM roles
 func UseProviderSecretV1AssumeRole(ctx context.Context, data []byte, pc *v1beta1.ProviderConfig, profile, region string) (*awsv1.Config, error) {
-	creds, err := CredentialsIDSecret(data, profile)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot parse credentials secret")
-	}
-
-	config, err := config.LoadDefaultConfig(ctx, config.WithRegion(region), config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
-		Value: creds,
-	}))
+	config, err := UseProviderSecret(ctx, data, profile, region)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load credentials")
 	}
+	config.Credentials = assumeRoles(*config, pc)
 
-	stsSvc := sts.NewFromConfig(config)
-	stsAssume := stscreds.NewAssumeRoleProvider(stsSvc, StringValue(pc.Spec.AssumeRoleARN))
-	config.Credentials = aws.NewCredentialsCache(stsAssume)
-
-	v2creds, err := config.Credentials.Retrieve(ctx)
+	v1creds, err := newV1Credentials(ctx, config.Credentials)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve credentials")
 	}
 
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
-
 	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
 
@@ -433,7 +739,23 @@ func UseProviderSecretV1AssumeRole(ctx context.Context, data []byte, pc *v1beta1
 // [default]
 // aws_access_key_id = <YOUR_ACCESS_KEY_ID>
 // aws_secret_access_key = <YOUR_SECRET_ACCESS_KEY>
-func UseProviderSecretV1(_ context.Context, data []byte, pc *v1beta1.ProviderConfig, profile, region string) (*awsv1.Config, error) {
+func UseProviderSecretV1(ctx context.Context, data []byte, pc *v1beta1.ProviderConfig, profile, region string) (*awsv1.Config, error) {
+	shared, err := usesSharedConfig(data, profile)
+	if err != nil {
+		return nil, err
+	}
+	if shared {
+		cfg, err := UseSharedConfig(ctx, data, profile, region)
+		if err != nil {
+			return nil, err
+		}
+		v1creds, err := newV1Credentials(ctx, cfg.Credentials)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve credentials")
+		}
+		return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
+	}
+
 	cfg, err := ini.InsensitiveLoad(data)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot parse credentials secret")
@@ -467,28 +789,18 @@ func UsePodServiceAccountV1AssumeRole(ctx context.Context, _ []byte, pc *v1beta1
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
-	stsclient := sts.NewFromConfig(cfg)
 	cnf, err := config.LoadDefaultConfig(
 		ctx,
 		config.WithRegion(region),
-		config.WithCredentialsProvider(aws.NewCredentialsCache(
-			stscreds.NewAssumeRoleProvider(
-				stsclient,
-				StringValue(pc.Spec.AssumeRoleARN),
-			)),
-		),
+		config.WithCredentialsProvider(assumeRoles(cfg, pc)),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
 	}
-	v2creds, err := cnf.Credentials.Retrieve(ctx)
+	v1creds, err := newV1Credentials(ctx, cnf.Credentials)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve credentials")
 	}
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
 	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
 
@@ -502,17 +814,76 @@ func UsePodServiceAccountV1(ctx context.Context, _ []byte, pc *v1beta1.ProviderC
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
-	v2creds, err := cfg.Credentials.Retrieve(ctx)
+	v1creds, err := newV1Credentials(ctx, cfg.Credentials)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve credentials")
 	}
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
 	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
 
+// UseWebIdentityV1 assumes the IAM role configured in the ProviderConfig with
+// the web identity token read from the configured file and produces a
+// *awsv1.Config.
+func UseWebIdentityV1(ctx context.Context, region string, pc *v1beta1.ProviderConfig) (*awsv1.Config, error) {
+	cfg, err := UseWebIdentity(ctx, region, pc)
+	if err != nil {
+		return nil, err
+	}
+	v1creds, err := newV1Credentials(ctx, cfg.Credentials)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve credentials")
+	}
+	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
+}
+
+// v1CredentialsProvider adapts an AWS SDK v2 credentials provider so that it
+// can be used by AWS SDK v1 clients. Unlike a snapshot of the credentials, it
+// lets the v1 clients pick up refreshed credentials once an assumed-role
+// session expires.
+type v1CredentialsProvider struct {
+	provider  aws.CredentialsProvider
+	expiry    credentialsv1.Expiry
+	canExpire bool
+}
+
+func (p *v1CredentialsProvider) Retrieve() (credentialsv1.Value, error) {
+	return p.RetrieveWithContext(context.Background())
+}
+
+func (p *v1CredentialsProvider) RetrieveWithContext(ctx credentialsv1.Context) (credentialsv1.Value, error) {
+	c, err := p.provider.Retrieve(ctx)
+	if err != nil {
+		return credentialsv1.Value{}, err
+	}
+	p.canExpire = c.CanExpire
+	p.expiry.SetExpiration(c.Expires, 0)
+	return credentialsv1.Value{
+		AccessKeyID:     c.AccessKeyID,
+		SecretAccessKey: c.SecretAccessKey,
+		SessionToken:    c.SessionToken,
+		ProviderName:    c.Source,
+	}, nil
+}
+
+func (p *v1CredentialsProvider) IsExpired() bool {
+	return p.canExpire && p.expiry.IsExpired()
+}
+
+func (p *v1CredentialsProvider) ExpiresAt() time.Time {
+	return p.expiry.ExpiresAt()
+}
+
+// newV1Credentials returns AWS SDK v1 credentials backed by the supplied AWS
+// SDK v2 credentials provider. The credentials are retrieved once so that
+// errors surface early.
+func newV1Credentials(ctx context.Context, p aws.CredentialsProvider) (*credentialsv1.Credentials, error) {
+	creds := credentialsv1.NewCredentials(&v1CredentialsProvider{provider: p})
+	if _, err := creds.GetWithContext(ctx); err != nil {
+		return nil, err
+	}
+	return creds, nil
+}
+
 // SetResolverV1 parses annotations from the managed resource
 // and returns a V1 configuration accordingly.
 func SetResolverV1(pc *v1beta1.ProviderConfig, cfg *awsv1.Config) *awsv1.Config { // nolint:gocyclo
@@ -923,36 +1294,6 @@ func DiffLabels(local, remote map[string]string) (addOrModify map[string]string,
 	return
 }
 
-// IsPolicyUpToDate Marshall policies to json for a compare to get around string ordering
-func IsPolicyUpToDate(local, remote *string) bool {
-	var localUnmarshalled interface{}
-	var remoteUnmarshalled interface{}
-
-	var err error
-	err = json.Unmarshal([]byte(StringValue(local)), &localUnmarshalled)
-	if err != nil {
-		return false
-	}
-	err = json.Unmarshal([]byte(StringValue(remote)), &remoteUnmarshalled)
-	if err != nil {
-		return false
-	}
-
-	sortSlicesOpt := cmpopts.SortSlices(func(x, y interface{}) bool {
-		if a, ok := x.(string); ok {
-			if b, ok := y.(string); ok {
-				return a < b
-			}
-		}
-		// Note: Unknown types in slices will not cause a panic, but
-		// may not be sorted correctly. Depending on how AWS handles
-		// these, it may cause constant updates - but better this than
-		// panicing.
-		return false
-	})
-	return cmp.Equal(localUnmarshalled, remoteUnmarshalled, cmpopts.EquateEmpty(), sortSlicesOpt)
-}
-
 // Wrap will remove the request-specific information from the error and only then
 // wrap it.
 func Wrap(err error, msg string) error {
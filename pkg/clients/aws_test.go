@@ -19,11 +19,16 @@ package aws
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
 	"github.com/aws/smithy-go"
 	"github.com/aws/smithy-go/document"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
@@ -31,10 +36,12 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
@@ -92,6 +99,183 @@ func TestUseProviderSecret(t *testing.T) {
 	g.Expect(config).NotTo(BeNil())
 }
 
+func TestUseWebIdentity(t *testing.T) {
+	type want struct {
+		err         bool
+		credentials bool
+	}
+
+	cases := map[string]struct {
+		pc   *v1beta1.ProviderConfig
+		want want
+	}{
+		"NoWebIdentityConfig": {
+			pc: &v1beta1.ProviderConfig{
+				Spec: v1beta1.ProviderConfigSpec{
+					Credentials: v1beta1.ProviderCredentials{Source: v1beta1.CredentialsSourceWebIdentity},
+				},
+			},
+			want: want{err: true},
+		},
+		"WebIdentityConfig": {
+			pc: &v1beta1.ProviderConfig{
+				Spec: v1beta1.ProviderConfigSpec{
+					Credentials: v1beta1.ProviderCredentials{
+						Source: v1beta1.CredentialsSourceWebIdentity,
+						WebIdentity: &v1beta1.WebIdentityConfig{
+							RoleARN:   "arn:aws:iam::123456789012:role/crossplane",
+							TokenFile: "/var/run/secrets/token",
+						},
+					},
+				},
+			},
+			want: want{credentials: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := UseWebIdentity(context.TODO(), "us-east-1", tc.pc)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("UseWebIdentity(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.credentials, cfg != nil && cfg.Credentials != nil); diff != "" {
+				t.Errorf("UseWebIdentity(...): -want credentials, +got credentials:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAssumeRoleChain(t *testing.T) {
+	cases := map[string]struct {
+		spec v1beta1.ProviderConfigSpec
+		want []v1beta1.AssumeRoleOptions
+	}{
+		"None": {
+			spec: v1beta1.ProviderConfigSpec{},
+			want: []v1beta1.AssumeRoleOptions{},
+		},
+		"AssumeRoleARN": {
+			spec: v1beta1.ProviderConfigSpec{AssumeRoleARN: aws.String("hub")},
+			want: []v1beta1.AssumeRoleOptions{{RoleARN: "hub"}},
+		},
+		"AssumeRoleARNFirst": {
+			spec: v1beta1.ProviderConfigSpec{
+				AssumeRoleARN:   aws.String("hub"),
+				AssumeRoleChain: []v1beta1.AssumeRoleOptions{{RoleARN: "spoke", ExternalID: aws.String("id")}},
+			},
+			want: []v1beta1.AssumeRoleOptions{{RoleARN: "hub"}, {RoleARN: "spoke", ExternalID: aws.String("id")}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := assumeRoleChain(&v1beta1.ProviderConfig{Spec: tc.spec})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("assumeRoleChain(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithAssumeRoleOptions(t *testing.T) {
+	o := v1beta1.AssumeRoleOptions{
+		RoleARN:           "spoke",
+		ExternalID:        aws.String("id"),
+		RoleSessionName:   aws.String("crossplane"),
+		Duration:          &v1.Duration{Duration: time.Hour},
+		Tags:              []v1beta1.Tag{{Key: "team", Value: "platform"}},
+		TransitiveTagKeys: []string{"team"},
+	}
+	want := stscreds.AssumeRoleOptions{
+		ExternalID:        aws.String("id"),
+		RoleSessionName:   "crossplane",
+		Duration:          time.Hour,
+		Tags:              []ststypes.Tag{{Key: aws.String("team"), Value: aws.String("platform")}},
+		TransitiveTagKeys: []string{"team"},
+	}
+	got := stscreds.AssumeRoleOptions{}
+	withAssumeRoleOptions(o)(&got)
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(ststypes.Tag{})); diff != "" {
+		t.Errorf("withAssumeRoleOptions(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestUseProviderSecretProfile(t *testing.T) {
+	process := func(id string) string {
+		p := filepath.Join(t.TempDir(), "credential-process")
+		script := fmt.Sprintf("#!/bin/sh\necho '{\"Version\": 1, \"AccessKeyId\": \"%s\", \"SecretAccessKey\": \"secret\"}'\n", id)
+		if err := os.WriteFile(p, []byte(script), 0700); err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+	data := []byte(fmt.Sprintf(`[default]
+aws_access_key_id = defaultID
+aws_secret_access_key = defaultSecret
+
+[static]
+aws_access_key_id = staticID
+aws_secret_access_key = staticSecret
+
+[process]
+credential_process = %s
+
+[profile config]
+credential_process = %s
+`, process("processID"), process("configID")))
+
+	type want struct {
+		id  string
+		err bool
+	}
+
+	cases := map[string]struct {
+		profile string
+		want    want
+	}{
+		"Default": {
+			profile: DefaultSection,
+			want:    want{id: "defaultID"},
+		},
+		"StaticProfile": {
+			profile: "static",
+			want:    want{id: "staticID"},
+		},
+		"CredentialProcess": {
+			profile: "process",
+			want:    want{id: "processID"},
+		},
+		"ConfigFileProfile": {
+			profile: "config",
+			want:    want{id: "configID"},
+		},
+		"MissingProfile": {
+			profile: "missing",
+			want:    want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := UseProviderSecret(context.TODO(), data, tc.profile, "us-east-1")
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Fatalf("UseProviderSecret(...): -want error, +got error:\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			creds, err := cfg.Credentials.Retrieve(context.TODO())
+			if err != nil {
+				t.Fatalf("Retrieve(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.id, creds.AccessKeyID); diff != "" {
+				t.Errorf("UseProviderSecret(...): -want access key ID, +got access key ID:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDiffTags(t *testing.T) {
 	type args struct {
 		local  map[string]string
@@ -474,73 +658,6 @@ func TestDiffLabels(t *testing.T) {
 	}
 }
 
-const (
-	policy = `{"Statement":[{"Action":"ecr:ListImages","Effect":"Allow","Principal":"*"}],"Version":"2012-10-17"}`
-
-	cpxPolicy = `{"Statement":[{"Action":"ecr:ListImages","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111122223333:userARN","111122223334","arn:aws:iam::111122223333:roleARN"]}}],"Version":"2012-10-17"}`
-	// Note: different sort order of principals than input above
-	cpxRemPolicy = `{"Statement":[{"Action":"ecr:ListImages","Effect":"Allow","Principal":{"AWS":["111122223334","arn:aws:iam::111122223333:userARN","arn:aws:iam::111122223333:roleARN"]}}],"Version":"2012-10-17"}`
-)
-
-func TestIsPolicyUpToDate(t *testing.T) {
-	type args struct {
-		local  string
-		remote string
-	}
-
-	cases := map[string]struct {
-		args args
-		want bool
-	}{
-		"SameFields": {
-			args: args{
-				local:  "{\"testone\": \"one\", \"testtwo\": \"two\"}",
-				remote: "{\"testtwo\": \"two\", \"testone\": \"one\"}",
-			},
-			want: true,
-		},
-		"SameFieldsRealPolicy": {
-			args: args{
-				local:  policy,
-				remote: `{"Statement":[{"Effect":"Allow","Action":"ecr:ListImages","Principal":"*"}],"Version":"2012-10-17"}`,
-			},
-			want: true,
-		},
-		"DifferentFields": {
-			args: args{
-				local:  "{\"testone\": \"one\", \"testtwo\": \"two\"}",
-				remote: "{\"testthree\": \"three\", \"testone\": \"one\"}",
-			},
-			want: false,
-		},
-		"SameFieldsPrincipalPolicy": {
-			args: args{
-				local:  cpxPolicy,
-				remote: cpxRemPolicy,
-			},
-			want: true,
-		},
-		"SameFieldsNumericPrincipals": {
-			args: args{
-				// This is to test that our slice sorting does not
-				// panic with unexpected value types.
-				local:  `{"Statement":[{"Effect":"Allow","Action":"ecr:ListImages","Principal":[2,1,"foo","bar"]}],"Version":"2012-10-17"}`,
-				remote: `{"Statement":[{"Effect":"Allow","Action":"ecr:ListImages","Principal":[2,1,"bar","foo"]}],"Version":"2012-10-17"}`,
-			},
-			want: true,
-		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			got := IsPolicyUpToDate(&tc.args.local, &tc.args.remote)
-			if diff := cmp.Diff(tc.want, got); diff != "" {
-				t.Errorf("r: -want, +got:\n%s", diff)
-			}
-		})
-	}
-}
-
 func TestWrap(t *testing.T) {
 	rootErr := &smithy.GenericAPIError{
 		Code:    "InvalidVpcID.NotFound",
@@ -883,3 +1000,308 @@ func TestLateInitInt64PtrSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAccountID(t *testing.T) {
+	pc := &v1beta1.ProviderConfig{Status: v1beta1.ProviderConfigStatus{AccountID: aws.String("123456789012")}}
+	id, err := GetAccountID(context.Background(), nil, pc, "us-east-1")
+	if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+		t.Errorf("GetAccountID(...): -want error, +got error:\n%s", diff)
+	}
+	if diff := cmp.Diff("123456789012", id); diff != "" {
+		t.Errorf("GetAccountID(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetDefaultTags(t *testing.T) {
+	pcWithTags := func(obj client.Object) error {
+		obj.(*v1beta1.ProviderConfig).Spec.DefaultTags = map[string]string{"team": "platform"}
+		return nil
+	}
+
+	type args struct {
+		kube client.Client
+		ref  *xpv1.Reference
+	}
+	type want struct {
+		tags map[string]string
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoProviderConfigReference": {
+			reason: "No default tags should be returned if no ProviderConfig is referenced.",
+			args:   args{},
+			want: want{
+				tags: map[string]string{},
+			},
+		},
+		"GetError": {
+			reason: "Any error encountered while getting the ProviderConfig should be returned.",
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New(errBoom))},
+				ref:  &xpv1.Reference{Name: "pc"},
+			},
+			want: want{
+				err: errors.Wrap(errors.New(errBoom), "cannot get referenced ProviderConfig"),
+			},
+		},
+		"DefaultTags": {
+			reason: "The default tags of the referenced ProviderConfig should be returned.",
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, pcWithTags)},
+				ref:  &xpv1.Reference{Name: "pc"},
+			},
+			want: want{
+				tags: map[string]string{"team": "platform"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: tc.args.ref}}
+			tags, err := GetDefaultTags(context.Background(), tc.args.kube, mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGetDefaultTags(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.tags, tags); diff != "" {
+				t.Errorf("\n%s\nGetDefaultTags(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestMergeDefaultTags(t *testing.T) {
+	pcWithTags := func(obj client.Object) error {
+		obj.(*v1beta1.ProviderConfig).Spec.DefaultTags = map[string]string{"team": "platform", "env": "dev"}
+		return nil
+	}
+
+	type args struct {
+		kube        client.Client
+		annotations map[string]string
+		tags        map[string]string
+	}
+	type want struct {
+		tags        map[string]string
+		annotations map[string]string
+		err         error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"GetError": {
+			reason: "Any error encountered while getting the default tags should be returned.",
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New(errBoom))},
+			},
+			want: want{
+				err: errors.Wrap(errors.New(errBoom), "cannot get referenced ProviderConfig"),
+			},
+		},
+		"Merged": {
+			reason: "Supplied tags should override default tags and external tags should override both. Only the default tags that were merged should be recorded.",
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, pcWithTags)},
+				tags: map[string]string{"env": "prod", resource.ExternalResourceTagKeyName: "other"},
+			},
+			want: want{
+				tags: map[string]string{
+					"team":                                  "platform",
+					"env":                                   "prod",
+					resource.ExternalResourceTagKeyKind:     "",
+					resource.ExternalResourceTagKeyName:     "cool",
+					resource.ExternalResourceTagKeyProvider: "pc",
+				},
+				annotations: map[string]string{AnnotationKeyDefaultTags: `{"team":"platform"}`},
+			},
+		},
+		"ChangedAndRemovedDefaults": {
+			reason: "Tags that stem from recorded default tags should follow the current default tags of the ProviderConfig.",
+			args: args{
+				kube:        &test.MockClient{MockGet: test.NewMockGetFn(nil, pcWithTags)},
+				annotations: map[string]string{AnnotationKeyDefaultTags: `{"team":"infra","cost":"42"}`},
+				tags:        map[string]string{"team": "infra", "cost": "42", "owner": "me"},
+			},
+			want: want{
+				tags: map[string]string{
+					"team":                                  "platform",
+					"env":                                   "dev",
+					"owner":                                 "me",
+					resource.ExternalResourceTagKeyKind:     "",
+					resource.ExternalResourceTagKeyName:     "cool",
+					resource.ExternalResourceTagKeyProvider: "pc",
+				},
+				annotations: map[string]string{AnnotationKeyDefaultTags: `{"env":"dev","team":"platform"}`},
+			},
+		},
+		"UnrecordedDefaults": {
+			reason: "Supplied tags that equal a default tag should be recorded as default tags.",
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, pcWithTags)},
+				tags: map[string]string{"team": "platform", "env": "dev"},
+			},
+			want: want{
+				tags: map[string]string{
+					"team":                                  "platform",
+					"env":                                   "dev",
+					resource.ExternalResourceTagKeyKind:     "",
+					resource.ExternalResourceTagKeyName:     "cool",
+					resource.ExternalResourceTagKeyProvider: "pc",
+				},
+				annotations: map[string]string{AnnotationKeyDefaultTags: `{"env":"dev","team":"platform"}`},
+			},
+		},
+		"NoDefaults": {
+			reason: "The annotation should be removed if no default tags were merged.",
+			args: args{
+				kube:        &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				annotations: map[string]string{AnnotationKeyDefaultTags: `{"cost":"42"}`},
+				tags:        map[string]string{"cost": "42"},
+			},
+			want: want{
+				tags: map[string]string{
+					resource.ExternalResourceTagKeyKind:     "",
+					resource.ExternalResourceTagKeyName:     "cool",
+					resource.ExternalResourceTagKeyProvider: "pc",
+				},
+				annotations: map[string]string{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{
+				ObjectMeta:               v1.ObjectMeta{Name: "cool", Annotations: tc.args.annotations},
+				ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "pc"}},
+			}
+			tags, err := MergeDefaultTags(context.Background(), tc.args.kube, mg, tc.args.tags)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nMergeDefaultTags(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.tags, tags); diff != "" {
+				t.Errorf("\n%s\nMergeDefaultTags(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.annotations, mg.GetAnnotations()); diff != "" {
+				t.Errorf("\n%s\nMergeDefaultTags(...): -want annotations, +got annotations:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestProviderConfigCredentials(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		source xpv1.CredentialsSource
+		data   string
+		err    bool
+	}{
+		"StaticKeys": {
+			reason: "Static access keys of a Secret should be returned.",
+			source: xpv1.CredentialsSourceSecret,
+			data:   fmt.Sprintf(awsCredentialsFileFormat, DefaultSection, "id", "secret"),
+		},
+		"RoleFromSourceProfile": {
+			reason: "Shared config settings that do not run commands should be allowed in a Secret.",
+			source: xpv1.CredentialsSourceSecret,
+			data:   "[profile admin]\nrole_arn = arn:aws:iam::123456789012:role/admin\nsource_profile = default\n",
+		},
+		"CredentialProcess": {
+			reason: "A Secret that runs a credential_process should be rejected.",
+			source: xpv1.CredentialsSourceSecret,
+			data:   "[default]\ncredential_process = /bin/sh -c id\n",
+			err:    true,
+		},
+		"CredentialProcessOfConfigProfile": {
+			reason: "A credential_process of any profile of a Secret should be rejected.",
+			source: xpv1.CredentialsSourceSecret,
+			data:   "[default]\naws_access_key_id = id\naws_secret_access_key = secret\n\n[profile other]\ncredential_process = /bin/sh -c id\n",
+			err:    true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"credentials": []byte(tc.data)}
+					return nil
+				},
+			}
+			pc := &v1beta1.ProviderConfig{
+				Spec: v1beta1.ProviderConfigSpec{
+					Credentials: v1beta1.ProviderCredentials{
+						Source: tc.source,
+						CommonCredentialSelectors: xpv1.CommonCredentialSelectors{
+							SecretRef: &xpv1.SecretKeySelector{
+								SecretReference: xpv1.SecretReference{Name: "aws", Namespace: "crossplane-system"},
+								Key:             "credentials",
+							},
+						},
+					},
+				},
+			}
+			data, err := providerConfigCredentials(context.Background(), c, pc)
+			if diff := cmp.Diff(tc.err, err != nil); diff != "" {
+				t.Fatalf("\n%s\nproviderConfigCredentials(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err == nil {
+				if diff := cmp.Diff(tc.data, string(data)); diff != "" {
+					t.Errorf("\n%s\nproviderConfigCredentials(...): -want, +got:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestGetConfigForProviderConfigDefaultRegion(t *testing.T) {
+	cases := map[string]struct {
+		reason        string
+		defaultRegion *string
+		region        string
+		want          string
+	}{
+		"NoDefaultRegion": {
+			reason: "The supplied region should be used if the ProviderConfig has no default region.",
+			region: "us-east-1",
+			want:   "us-east-1",
+		},
+		"DefaultRegion": {
+			reason:        "The default region of the ProviderConfig should be used if no region is supplied.",
+			defaultRegion: aws.String("eu-west-1"),
+			want:          "eu-west-1",
+		},
+		"RegionOverridesDefault": {
+			reason:        "The supplied region should take precedence over the default region of the ProviderConfig.",
+			defaultRegion: aws.String("eu-west-1"),
+			region:        "us-east-1",
+			want:          "us-east-1",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pc := &v1beta1.ProviderConfig{
+				Spec: v1beta1.ProviderConfigSpec{
+					Credentials:   v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceNone},
+					DefaultRegion: tc.defaultRegion,
+				},
+			}
+			cfg, err := GetConfigForProviderConfig(context.Background(), &test.MockClient{}, pc, tc.region)
+			if err != nil {
+				t.Fatalf("GetConfigForProviderConfig(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, cfg.Region); diff != "" {
+				t.Errorf("\n%s\nGetConfigForProviderConfig(...): -want region, +got region:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
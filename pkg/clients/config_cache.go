@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// DefaultConfigCacheTTL is the maximum duration an AWS config built for a
+// ProviderConfig is reused before it is built from scratch again. Credentials
+// of the cached configs refresh themselves, including assumed-role sessions,
+// so the TTL only bounds how long ambient credential sources such as the
+// pod environment are trusted without being loaded again.
+const DefaultConfigCacheTTL = 1 * time.Hour
+
+// configCacheKey identifies a config built for a specific generation of a
+// ProviderConfig, the credentials it resolved to and a region. The
+// generation only changes with the spec, so status updates of the
+// ProviderConfig do not invalidate its configs.
+type configCacheKey struct {
+	name        string
+	generation  int64
+	credentials string
+	region      string
+}
+
+func newConfigCacheKey(pc *v1beta1.ProviderConfig, credentials []byte, region string) configCacheKey {
+	h := sha256.Sum256(credentials)
+	return configCacheKey{
+		name:        pc.GetName(),
+		generation:  pc.GetGeneration(),
+		credentials: hex.EncodeToString(h[:]),
+		region:      region,
+	}
+}
+
+// cacheable returns whether configs for the supplied key can be cached. Keys
+// of ProviderConfigs that do not have a generation, i.e. the ones that are
+// not read from the API server, cannot tell revisions apart.
+func (k configCacheKey) cacheable() bool {
+	return k.generation != 0
+}
+
+type configCacheEntry struct {
+	cfg     *aws.Config
+	sess    *session.Session
+	expires time.Time
+}
+
+// A ConfigCache stores AWS SDK v1 sessions and v2 configs built for
+// ProviderConfigs so that they can be shared by all managed resources that
+// reference the same ProviderConfig. Entries are invalidated when the spec
+// of the ProviderConfig or the credentials it references change, or when the
+// ProviderConfig is deleted, and expire after a TTL. It is safe for
+// concurrent use.
+type ConfigCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu sync.RWMutex
+	v2 map[configCacheKey]configCacheEntry
+	v1 map[configCacheKey]configCacheEntry
+}
+
+// NewConfigCache returns a new ConfigCache whose entries expire after the
+// supplied TTL.
+func NewConfigCache(ttl time.Duration) *ConfigCache {
+	return &ConfigCache{
+		ttl: ttl,
+		now: time.Now,
+		v2:  map[configCacheKey]configCacheEntry{},
+		v1:  map[configCacheKey]configCacheEntry{},
+	}
+}
+
+// configCache is shared by all controllers of this provider.
+var configCache = NewConfigCache(DefaultConfigCacheTTL)
+
+// SetConfigCacheTTL configures how long AWS configs built for ProviderConfigs
+// are reused. A zero TTL disables caching.
+func SetConfigCacheTTL(ttl time.Duration) {
+	configCache.mu.Lock()
+	defer configCache.mu.Unlock()
+	configCache.ttl = ttl
+	configCache.v2 = map[configCacheKey]configCacheEntry{}
+	configCache.v1 = map[configCacheKey]configCacheEntry{}
+}
+
+func (c *ConfigCache) getConfig(k configCacheKey) (*aws.Config, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e, ok := c.v2[k]
+	if !ok || !c.now().Before(e.expires) {
+		return nil, false
+	}
+	// Callers are free to modify the config they receive, so every one of
+	// them gets its own shallow copy.
+	cfg := e.cfg.Copy()
+	return &cfg, true
+}
+
+func (c *ConfigCache) setConfig(k configCacheKey, cfg *aws.Config) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ttl <= 0 || !k.cacheable() {
+		return
+	}
+	c.evict(c.v2, k)
+	cp := cfg.Copy()
+	c.v2[k] = configCacheEntry{cfg: &cp, expires: c.now().Add(c.ttl)}
+}
+
+func (c *ConfigCache) getSession(k configCacheKey) (*session.Session, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e, ok := c.v1[k]
+	if !ok || !c.now().Before(e.expires) {
+		return nil, false
+	}
+	return e.sess.Copy(), true
+}
+
+func (c *ConfigCache) setSession(k configCacheKey, sess *session.Session) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ttl <= 0 || !k.cacheable() {
+		return
+	}
+	c.evict(c.v1, k)
+	c.v1[k] = configCacheEntry{sess: sess.Copy(), expires: c.now().Add(c.ttl)}
+}
+
+// evict removes the entries that are superseded by the supplied key, i.e.
+// the ones built for an older generation of the same ProviderConfig or its
+// credentials, as well as the expired ones.
+func (c *ConfigCache) evict(entries map[configCacheKey]configCacheEntry, k configCacheKey) {
+	now := c.now()
+	for ek, e := range entries {
+		superseded := ek.name == k.name && (ek.generation != k.generation || ek.credentials != k.credentials)
+		if superseded || !now.Before(e.expires) {
+			delete(entries, ek)
+		}
+	}
+}
+
+// Invalidate drops all cached configs of the ProviderConfig with the supplied
+// name.
+func (c *ConfigCache) Invalidate(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k := range c.v2 {
+		if k.name == name {
+			delete(c.v2, k)
+		}
+	}
+	for k := range c.v1 {
+		if k.name == name {
+			delete(c.v1, k)
+		}
+	}
+}
+
+// InvalidateConfigCache drops the cached configs of the ProviderConfig with
+// the supplied name, e.g. because it was deleted. A ProviderConfig that is
+// created again with the same name starts over at generation 1, so its
+// configs would otherwise be mistaken for the ones of the deleted one.
+func InvalidateConfigCache(name string) {
+	configCache.Invalidate(name)
+}
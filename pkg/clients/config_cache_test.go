@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func TestConfigCache(t *testing.T) {
+	pc := func(generation int64, rv string) *v1beta1.ProviderConfig {
+		return &v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "pc", Generation: generation, ResourceVersion: rv}}
+	}
+	now := time.Now()
+
+	type step struct {
+		key   configCacheKey
+		set   bool
+		at    time.Time
+		found bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		steps  []step
+	}{
+		"Hit": {
+			reason: "A config should be returned for the same revision, credentials and region.",
+			steps: []step{
+				{key: newConfigCacheKey(pc(1, "1"), []byte("creds"), "us-east-1"), set: true, at: now},
+				{key: newConfigCacheKey(pc(1, "1"), []byte("creds"), "us-east-1"), at: now, found: true},
+			},
+		},
+		"DifferentRegion": {
+			reason: "Configs of different regions should be cached separately.",
+			steps: []step{
+				{key: newConfigCacheKey(pc(1, "1"), []byte("creds"), "us-east-1"), set: true, at: now},
+				{key: newConfigCacheKey(pc(1, "1"), []byte("creds"), "eu-west-1"), at: now},
+			},
+		},
+		"ProviderConfigChanged": {
+			reason: "A config should not be returned once the ProviderConfig changes.",
+			steps: []step{
+				{key: newConfigCacheKey(pc(1, "1"), []byte("creds"), "us-east-1"), set: true, at: now},
+				{key: newConfigCacheKey(pc(2, "2"), []byte("creds"), "us-east-1"), at: now},
+			},
+		},
+		"StatusChanged": {
+			reason: "A config should still be returned after only the status of the ProviderConfig changes.",
+			steps: []step{
+				{key: newConfigCacheKey(pc(1, "1"), []byte("creds"), "us-east-1"), set: true, at: now},
+				{key: newConfigCacheKey(pc(1, "2"), []byte("creds"), "us-east-1"), at: now, found: true},
+			},
+		},
+		"CredentialsChanged": {
+			reason: "A config should not be returned once the credentials change.",
+			steps: []step{
+				{key: newConfigCacheKey(pc(1, "1"), []byte("creds"), "us-east-1"), set: true, at: now},
+				{key: newConfigCacheKey(pc(1, "1"), []byte("rotated"), "us-east-1"), at: now},
+			},
+		},
+		"Superseded": {
+			reason: "Configs of an older revision should be evicted when a newer one is cached.",
+			steps: []step{
+				{key: newConfigCacheKey(pc(1, "1"), []byte("creds"), "us-east-1"), set: true, at: now},
+				{key: newConfigCacheKey(pc(2, "2"), []byte("creds"), "us-east-1"), set: true, at: now},
+				{key: newConfigCacheKey(pc(1, "1"), []byte("creds"), "us-east-1"), at: now},
+			},
+		},
+		"NoGeneration": {
+			reason: "A config of a ProviderConfig without a generation should not be cached.",
+			steps: []step{
+				{key: newConfigCacheKey(pc(0, ""), []byte("creds"), "us-east-1"), set: true, at: now},
+				{key: newConfigCacheKey(pc(0, ""), []byte("creds"), "us-east-1"), at: now},
+			},
+		},
+		"Expired": {
+			reason: "A config should not be returned once its TTL passes.",
+			steps: []step{
+				{key: newConfigCacheKey(pc(1, "1"), []byte("creds"), "us-east-1"), set: true, at: now},
+				{key: newConfigCacheKey(pc(1, "1"), []byte("creds"), "us-east-1"), at: now.Add(2 * time.Hour)},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewConfigCache(time.Hour)
+			for i, s := range tc.steps {
+				at := s.at
+				c.now = func() time.Time { return at }
+				if s.set {
+					c.setConfig(s.key, &aws.Config{Region: s.key.region})
+					continue
+				}
+				cfg, found := c.getConfig(s.key)
+				if diff := cmp.Diff(s.found, found); diff != "" {
+					t.Errorf("step %d: %s\ngetConfig(...): -want found, +got found:\n%s", i, tc.reason, diff)
+				}
+				if found && cfg.Region != s.key.region {
+					t.Errorf("step %d: %s\ngetConfig(...): want region %s, got %s", i, tc.reason, s.key.region, cfg.Region)
+				}
+			}
+		})
+	}
+}
+
+func TestConfigCacheInvalidate(t *testing.T) {
+	c := NewConfigCache(time.Hour)
+	k := newConfigCacheKey(&v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "pc", Generation: 1}}, nil, "us-east-1")
+	c.setConfig(k, &aws.Config{})
+	c.Invalidate("pc")
+	if _, found := c.getConfig(k); found {
+		t.Errorf("Invalidate(...): config should not be found after invalidation")
+	}
+}
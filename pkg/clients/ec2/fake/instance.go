@@ -34,6 +34,8 @@ type MockInstanceClient struct {
 	MockDescribeInstances         func(context.Context, *ec2.DescribeInstancesInput, []func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
 	MockDescribeInstanceAttribute func(context.Context, *ec2.DescribeInstanceAttributeInput, []func(*ec2.Options)) (*ec2.DescribeInstanceAttributeOutput, error)
 	MockModifyInstanceAttribute   func(context.Context, *ec2.ModifyInstanceAttributeInput, []func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error)
+	MockStartInstances            func(context.Context, *ec2.StartInstancesInput, []func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
+	MockStopInstances             func(context.Context, *ec2.StopInstancesInput, []func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
 	MockCreateTags                func(context.Context, *ec2.CreateTagsInput, []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
 }
 
@@ -62,6 +64,16 @@ func (m *MockInstanceClient) ModifyInstanceAttribute(ctx context.Context, input
 	return m.MockModifyInstanceAttribute(ctx, input, opts)
 }
 
+// StartInstances mocks StartInstances method
+func (m *MockInstanceClient) StartInstances(ctx context.Context, input *ec2.StartInstancesInput, opts ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error) {
+	return m.MockStartInstances(ctx, input, opts)
+}
+
+// StopInstances mocks StopInstances method
+func (m *MockInstanceClient) StopInstances(ctx context.Context, input *ec2.StopInstancesInput, opts ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error) {
+	return m.MockStopInstances(ctx, input, opts)
+}
+
 // CreateTags mocks CreateTags method
 func (m *MockInstanceClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
 	return m.MockCreateTags(ctx, input, opts)
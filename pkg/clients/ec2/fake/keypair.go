@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.KeyPairClient = (*MockKeyPairClient)(nil)
+
+// MockKeyPairClient is a type that implements all the methods for KeyPairClient interface
+type MockKeyPairClient struct {
+	MockCreate     func(ctx context.Context, input *ec2.CreateKeyPairInput, opts []func(*ec2.Options)) (*ec2.CreateKeyPairOutput, error)
+	MockImport     func(ctx context.Context, input *ec2.ImportKeyPairInput, opts []func(*ec2.Options)) (*ec2.ImportKeyPairOutput, error)
+	MockDescribe   func(ctx context.Context, input *ec2.DescribeKeyPairsInput, opts []func(*ec2.Options)) (*ec2.DescribeKeyPairsOutput, error)
+	MockDelete     func(ctx context.Context, input *ec2.DeleteKeyPairInput, opts []func(*ec2.Options)) (*ec2.DeleteKeyPairOutput, error)
+	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateKeyPair mocks CreateKeyPair method
+func (m *MockKeyPairClient) CreateKeyPair(ctx context.Context, input *ec2.CreateKeyPairInput, opts ...func(*ec2.Options)) (*ec2.CreateKeyPairOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// ImportKeyPair mocks ImportKeyPair method
+func (m *MockKeyPairClient) ImportKeyPair(ctx context.Context, input *ec2.ImportKeyPairInput, opts ...func(*ec2.Options)) (*ec2.ImportKeyPairOutput, error) {
+	return m.MockImport(ctx, input, opts)
+}
+
+// DescribeKeyPairs mocks DescribeKeyPairs method
+func (m *MockKeyPairClient) DescribeKeyPairs(ctx context.Context, input *ec2.DescribeKeyPairsInput, opts ...func(*ec2.Options)) (*ec2.DescribeKeyPairsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// DeleteKeyPair mocks DeleteKeyPair method
+func (m *MockKeyPairClient) DeleteKeyPair(ctx context.Context, input *ec2.DeleteKeyPairInput, opts ...func(*ec2.Options)) (*ec2.DeleteKeyPairOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockKeyPairClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockKeyPairClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
@@ -15,6 +15,7 @@ package ec2
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"sort"
@@ -33,6 +34,8 @@ import (
 const (
 	// InstanceNotFound is the code that is returned by ec2 when the given InstanceID is not valid
 	InstanceNotFound = "InvalidInstanceID.NotFound"
+
+	errDecodeUserData = "cannot decode the base64 encoded user data"
 )
 
 // InstanceClient is the external client used for Instance Custom Resource
@@ -42,6 +45,8 @@ type InstanceClient interface {
 	DescribeInstances(context.Context, *ec2.DescribeInstancesInput, ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error)
 	DescribeInstanceAttribute(context.Context, *ec2.DescribeInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.DescribeInstanceAttributeOutput, error)
 	ModifyInstanceAttribute(context.Context, *ec2.ModifyInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error)
+	StartInstances(context.Context, *ec2.StartInstancesInput, ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
+	StopInstances(context.Context, *ec2.StopInstancesInput, ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
 	CreateTags(context.Context, *ec2.CreateTagsInput, ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
 }
 
@@ -67,6 +72,10 @@ func IsInstanceUpToDate(spec manualv1alpha1.InstanceParameters, instance types.I
 	if spec.InstanceInitiatedShutdownBehavior != attributeValue(attributes.InstanceInitiatedShutdownBehavior) {
 		return false
 	}
+	// InstanceType
+	if spec.InstanceType != "" && spec.InstanceType != attributeValue(attributes.InstanceType) {
+		return false
+	}
 	// KernalID
 	if awsclients.StringValue(spec.KernelID) != awsclients.StringValue(instance.KernelId) {
 		return false
@@ -82,6 +91,72 @@ func IsInstanceUpToDate(spec manualv1alpha1.InstanceParameters, instance types.I
 	return manualv1alpha1.CompareGroupIDs(spec.SecurityGroupIDs, instance.SecurityGroups)
 }
 
+// GenerateStoppedModifyInstanceAttributeInputs returns the inputs to modify
+// the attributes of the instance that differ from spec and that can only be
+// modified while the instance is stopped.
+func GenerateStoppedModifyInstanceAttributeInputs(id string, spec manualv1alpha1.InstanceParameters, attributes ec2.DescribeInstanceAttributeOutput) ([]*ec2.ModifyInstanceAttributeInput, error) {
+	var inputs []*ec2.ModifyInstanceAttributeInput
+	if spec.InstanceType != "" && spec.InstanceType != attributeValue(attributes.InstanceType) {
+		inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+			InstanceId:   awsclients.String(id),
+			InstanceType: &types.AttributeValue{Value: awsclients.String(spec.InstanceType)},
+		})
+	}
+	if spec.KernelID != nil && awsclients.StringValue(spec.KernelID) != attributeValue(attributes.KernelId) {
+		inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: awsclients.String(id),
+			Kernel:     &types.AttributeValue{Value: spec.KernelID},
+		})
+	}
+	if spec.RAMDiskID != nil && awsclients.StringValue(spec.RAMDiskID) != attributeValue(attributes.RamdiskId) {
+		inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: awsclients.String(id),
+			Ramdisk:    &types.AttributeValue{Value: spec.RAMDiskID},
+		})
+	}
+	if spec.UserData != nil && awsclients.StringValue(spec.UserData) != attributeValue(attributes.UserData) {
+		// UserData is base64 encoded in spec, and is encoded again by the SDK.
+		userData, err := base64.StdEncoding.DecodeString(awsclients.StringValue(spec.UserData))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", errDecodeUserData, err)
+		}
+		inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: awsclients.String(id),
+			UserData:   &types.BlobAttributeValue{Value: userData},
+		})
+	}
+	return inputs, nil
+}
+
+// PowerStateTarget returns the state an instance should be brought to for the
+// supplied desired power state, or an empty state if its power state is not
+// managed. An instance whose power state is not managed is started again when
+// restart is true, i.e. when it has been stopped to be modified.
+func PowerStateTarget(powerState string, restart bool) types.InstanceStateName {
+	switch powerState {
+	case manualv1alpha1.PowerStateRunning:
+		return types.InstanceStateNameRunning
+	case manualv1alpha1.PowerStateStopped, manualv1alpha1.PowerStateHibernated:
+		return types.InstanceStateNameStopped
+	}
+	if restart {
+		return types.InstanceStateNameRunning
+	}
+	return ""
+}
+
+// IsPowerStateUpToDate returns true if an instance in the supplied state is
+// in, or is transitioning to, the target state.
+func IsPowerStateUpToDate(target, state types.InstanceStateName) bool {
+	switch target {
+	case types.InstanceStateNameRunning:
+		return state == types.InstanceStateNameRunning || state == types.InstanceStateNamePending
+	case types.InstanceStateNameStopped:
+		return state == types.InstanceStateNameStopped || state == types.InstanceStateNameStopping
+	}
+	return true
+}
+
 // GenerateInstanceObservation is used to produce manualv1alpha1.InstanceObservation from
 // a []ec2.Instance.
 func GenerateInstanceObservation(i types.Instance) manualv1alpha1.InstanceObservation {
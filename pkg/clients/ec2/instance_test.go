@@ -14,6 +14,8 @@ limitations under the License.
 package ec2
 
 import (
+	"encoding/base64"
+	"fmt"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -865,3 +867,167 @@ func TestGenerateEC2RunInstancesInput(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateStoppedModifyInstanceAttributeInputs(t *testing.T) {
+	type args struct {
+		spec       manualv1alpha1.InstanceParameters
+		attributes ec2.DescribeInstanceAttributeOutput
+	}
+	type want struct {
+		inputs []*ec2.ModifyInstanceAttributeInput
+		err    error
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				spec: manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+					UserData:     aws.String("ZWNobyBoZWxsbw=="),
+				},
+				attributes: ec2.DescribeInstanceAttributeOutput{
+					InstanceType: &types.AttributeValue{Value: aws.String(string(types.InstanceTypeM5Large))},
+					UserData:     &types.AttributeValue{Value: aws.String("ZWNobyBoZWxsbw==")},
+				},
+			},
+			want: want{},
+		},
+		"Modified": {
+			args: args{
+				spec: manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+					KernelID:     aws.String("aki-1"),
+					UserData:     aws.String("ZWNobyBoZWxsbw=="),
+				},
+				attributes: ec2.DescribeInstanceAttributeOutput{
+					InstanceType: &types.AttributeValue{Value: aws.String(string(types.InstanceTypeM1Small))},
+				},
+			},
+			want: want{
+				inputs: []*ec2.ModifyInstanceAttributeInput{
+					{
+						InstanceId:   aws.String(instanceID),
+						InstanceType: &types.AttributeValue{Value: aws.String(string(types.InstanceTypeM5Large))},
+					},
+					{
+						InstanceId: aws.String(instanceID),
+						Kernel:     &types.AttributeValue{Value: aws.String("aki-1")},
+					},
+					{
+						InstanceId: aws.String(instanceID),
+						UserData:   &types.BlobAttributeValue{Value: []byte("echo hello")},
+					},
+				},
+			},
+		},
+		"InvalidUserData": {
+			args: args{
+				spec: manualv1alpha1.InstanceParameters{
+					UserData: aws.String("echo hello"),
+				},
+			},
+			want: want{
+				err: fmt.Errorf("%s: %w", errDecodeUserData, base64.CorruptInputError(4)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			inputs, err := GenerateStoppedModifyInstanceAttributeInputs(instanceID, tc.args.spec, tc.args.attributes)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.inputs, inputs, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPowerStateTarget(t *testing.T) {
+	type args struct {
+		powerState string
+		restart    bool
+	}
+	cases := map[string]struct {
+		args args
+		want types.InstanceStateName
+	}{
+		"Unmanaged": {
+			args: args{},
+			want: "",
+		},
+		"UnmanagedRestart": {
+			args: args{restart: true},
+			want: types.InstanceStateNameRunning,
+		},
+		"Running": {
+			args: args{powerState: manualv1alpha1.PowerStateRunning},
+			want: types.InstanceStateNameRunning,
+		},
+		"StoppedRestart": {
+			args: args{powerState: manualv1alpha1.PowerStateStopped, restart: true},
+			want: types.InstanceStateNameStopped,
+		},
+		"Hibernated": {
+			args: args{powerState: manualv1alpha1.PowerStateHibernated},
+			want: types.InstanceStateNameStopped,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			target := PowerStateTarget(tc.args.powerState, tc.args.restart)
+
+			if diff := cmp.Diff(tc.want, target); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPowerStateUpToDate(t *testing.T) {
+	type args struct {
+		target types.InstanceStateName
+		state  types.InstanceStateName
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"Unmanaged": {
+			args: args{state: types.InstanceStateNameStopped},
+			want: true,
+		},
+		"Starting": {
+			args: args{target: types.InstanceStateNameRunning, state: types.InstanceStateNamePending},
+			want: true,
+		},
+		"NeedsStart": {
+			args: args{target: types.InstanceStateNameRunning, state: types.InstanceStateNameStopped},
+			want: false,
+		},
+		"Stopping": {
+			args: args{target: types.InstanceStateNameStopped, state: types.InstanceStateNameStopping},
+			want: true,
+		},
+		"NeedsStop": {
+			args: args{target: types.InstanceStateNameStopped, state: types.InstanceStateNameRunning},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upToDate := IsPowerStateUpToDate(tc.args.target, tc.args.state)
+
+			if diff := cmp.Diff(tc.want, upToDate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
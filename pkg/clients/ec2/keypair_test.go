@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func TestIsKeyPairUpToDate(t *testing.T) {
+	type args struct {
+		p manualv1alpha1.KeyPairParameters
+		k ec2types.KeyPairInfo
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoTags": {
+			args: args{
+				k: ec2types.KeyPairInfo{KeyName: aws.String("key")},
+			},
+			want: true,
+		},
+		"SameTags": {
+			args: args{
+				p: manualv1alpha1.KeyPairParameters{Tags: []manualv1alpha1.Tag{{Key: "k", Value: "v"}}},
+				k: ec2types.KeyPairInfo{Tags: []ec2types.Tag{{Key: aws.String("k"), Value: aws.String("v")}}},
+			},
+			want: true,
+		},
+		"TagAdded": {
+			args: args{
+				p: manualv1alpha1.KeyPairParameters{Tags: []manualv1alpha1.Tag{{Key: "k", Value: "v"}}},
+			},
+			want: false,
+		},
+		"TagValueChanged": {
+			args: args{
+				p: manualv1alpha1.KeyPairParameters{Tags: []manualv1alpha1.Tag{{Key: "k", Value: "new"}}},
+				k: ec2types.KeyPairInfo{Tags: []ec2types.Tag{{Key: aws.String("k"), Value: aws.String("old")}}},
+			},
+			want: false,
+		},
+		"TagRemoved": {
+			args: args{
+				k: ec2types.KeyPairInfo{Tags: []ec2types.Tag{{Key: aws.String("k"), Value: aws.String("v")}}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsKeyPairUpToDate(tc.args.p, tc.args.k)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateKeyPairTagSpecifications(t *testing.T) {
+	cases := map[string]struct {
+		p    manualv1alpha1.KeyPairParameters
+		want []ec2types.TagSpecification
+	}{
+		"NoTags": {},
+		"Tags": {
+			p: manualv1alpha1.KeyPairParameters{Tags: []manualv1alpha1.Tag{{Key: "k", Value: "v"}}},
+			want: []ec2types.TagSpecification{{
+				ResourceType: ec2types.ResourceTypeKeyPair,
+				Tags:         []ec2types.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateKeyPairTagSpecifications(tc.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// ManagedPrefixListNotFound is the code that is returned by ec2 when the
+	// given prefix list does not exist
+	ManagedPrefixListNotFound = "InvalidPrefixListID.NotFound"
+
+	errTooManyEntries      = "number of entries exceeds maxEntries"
+	errDuplicatePrefixCIDR = "duplicate prefix list entry"
+)
+
+// ManagedPrefixListClient is the external client used for ManagedPrefixList
+// Custom Resource
+type ManagedPrefixListClient interface {
+	CreateManagedPrefixList(ctx context.Context, input *ec2.CreateManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.CreateManagedPrefixListOutput, error)
+	DescribeManagedPrefixLists(ctx context.Context, input *ec2.DescribeManagedPrefixListsInput, opts ...func(*ec2.Options)) (*ec2.DescribeManagedPrefixListsOutput, error)
+	GetManagedPrefixListEntries(ctx context.Context, input *ec2.GetManagedPrefixListEntriesInput, opts ...func(*ec2.Options)) (*ec2.GetManagedPrefixListEntriesOutput, error)
+	ModifyManagedPrefixList(ctx context.Context, input *ec2.ModifyManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.ModifyManagedPrefixListOutput, error)
+	DeleteManagedPrefixList(ctx context.Context, input *ec2.DeleteManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.DeleteManagedPrefixListOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewManagedPrefixListClient returns a new client using AWS credentials as JSON encoded data.
+func NewManagedPrefixListClient(cfg awsgo.Config) ManagedPrefixListClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsManagedPrefixListNotFoundErr returns true if the error is because the
+// prefix list doesn't exist
+func IsManagedPrefixListNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == ManagedPrefixListNotFound
+}
+
+// IsManagedPrefixListInProgress returns true if an operation on the prefix
+// list is still being applied, in which case it cannot be modified.
+func IsManagedPrefixListInProgress(pl ec2types.ManagedPrefixList) bool {
+	switch pl.State { //nolint:exhaustive
+	case ec2types.PrefixListStateCreateInProgress,
+		ec2types.PrefixListStateModifyInProgress,
+		ec2types.PrefixListStateRestoreInProgress,
+		ec2types.PrefixListStateDeleteInProgress:
+		return true
+	}
+	return false
+}
+
+// GenerateManagedPrefixListObservation is used to produce
+// manualv1alpha1.ManagedPrefixListObservation from ec2types.ManagedPrefixList.
+func GenerateManagedPrefixListObservation(pl ec2types.ManagedPrefixList) manualv1alpha1.ManagedPrefixListObservation {
+	return manualv1alpha1.ManagedPrefixListObservation{
+		PrefixListID:  awsgo.ToString(pl.PrefixListId),
+		PrefixListARN: awsgo.ToString(pl.PrefixListArn),
+		OwnerID:       awsgo.ToString(pl.OwnerId),
+		State:         string(pl.State),
+		StateMessage:  awsgo.ToString(pl.StateMessage),
+		Version:       awsgo.ToInt64(pl.Version),
+	}
+}
+
+// ValidateManagedPrefixListEntries returns an error if the desired entries
+// cannot be stored in the prefix list, either because there are more of them
+// than the maximum number of entries or because a CIDR block is listed twice.
+func ValidateManagedPrefixListEntries(p manualv1alpha1.ManagedPrefixListParameters) error {
+	if int32(len(p.Entries)) > p.MaxEntries {
+		return fmt.Errorf("%s: %d > %d", errTooManyEntries, len(p.Entries), p.MaxEntries)
+	}
+	seen := make(map[string]bool, len(p.Entries))
+	for _, e := range p.Entries {
+		cidr := canonicalCIDR(e.CIDR)
+		if seen[cidr] {
+			return fmt.Errorf("%s: %s", errDuplicatePrefixCIDR, e.CIDR)
+		}
+		seen[cidr] = true
+	}
+	return nil
+}
+
+// GenerateCreateManagedPrefixListInput returns the input that creates a
+// prefix list with the given parameters.
+func GenerateCreateManagedPrefixListInput(p manualv1alpha1.ManagedPrefixListParameters) *ec2.CreateManagedPrefixListInput {
+	input := &ec2.CreateManagedPrefixListInput{
+		AddressFamily:  awsgo.String(p.AddressFamily),
+		MaxEntries:     awsgo.Int32(p.MaxEntries),
+		PrefixListName: awsgo.String(p.PrefixListName),
+	}
+	for _, e := range p.Entries {
+		input.Entries = append(input.Entries, ec2types.AddPrefixListEntry{
+			Cidr:        awsgo.String(e.CIDR),
+			Description: e.Description,
+		})
+	}
+	if len(p.Tags) > 0 {
+		input.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypePrefixList,
+			Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return input
+}
+
+// PrefixListEntriesDiff holds the changes needed to turn the observed entries
+// of a prefix list into the desired ones.
+type PrefixListEntriesDiff struct {
+	Add    []ec2types.AddPrefixListEntry
+	Remove []ec2types.RemovePrefixListEntry
+}
+
+// IsEmpty returns true if the diff does not contain any change.
+func (d PrefixListEntriesDiff) IsEmpty() bool {
+	return len(d.Add) == 0 && len(d.Remove) == 0
+}
+
+// DiffManagedPrefixListEntries compares the desired entries with the observed
+// ones by their CIDR block. AWS cannot add and remove the same CIDR block in
+// one modification, so an entry whose description changed is only removed;
+// the next diff adds it back with the new description. The result is sorted
+// by CIDR block.
+func DiffManagedPrefixListEntries(desired []manualv1alpha1.PrefixListEntry, observed []ec2types.PrefixListEntry) PrefixListEntriesDiff {
+	current := make(map[string]ec2types.PrefixListEntry, len(observed))
+	for _, e := range observed {
+		current[canonicalCIDR(awsgo.ToString(e.Cidr))] = e
+	}
+
+	diff := PrefixListEntriesDiff{}
+	wanted := make(map[string]bool, len(desired))
+	for _, e := range desired {
+		cidr := canonicalCIDR(e.CIDR)
+		wanted[cidr] = true
+		o, ok := current[cidr]
+		switch {
+		case !ok:
+			diff.Add = append(diff.Add, ec2types.AddPrefixListEntry{
+				Cidr:        awsgo.String(e.CIDR),
+				Description: e.Description,
+			})
+		case awsgo.ToString(o.Description) != awsgo.ToString(e.Description):
+			diff.Remove = append(diff.Remove, ec2types.RemovePrefixListEntry{Cidr: o.Cidr})
+		}
+	}
+	for cidr, o := range current {
+		if !wanted[cidr] {
+			diff.Remove = append(diff.Remove, ec2types.RemovePrefixListEntry{Cidr: o.Cidr})
+		}
+	}
+
+	sort.Slice(diff.Add, func(i, j int) bool {
+		return awsgo.ToString(diff.Add[i].Cidr) < awsgo.ToString(diff.Add[j].Cidr)
+	})
+	sort.Slice(diff.Remove, func(i, j int) bool {
+		return awsgo.ToString(diff.Remove[i].Cidr) < awsgo.ToString(diff.Remove[j].Cidr)
+	})
+	return diff
+}
+
+// IsManagedPrefixListUpToDate checks whether the name, size, entries and tags
+// of the prefix list match the desired state.
+func IsManagedPrefixListUpToDate(p manualv1alpha1.ManagedPrefixListParameters, pl ec2types.ManagedPrefixList, entries []ec2types.PrefixListEntry) bool {
+	if p.PrefixListName != awsgo.ToString(pl.PrefixListName) || p.MaxEntries != awsgo.ToInt32(pl.MaxEntries) {
+		return false
+	}
+	if !DiffManagedPrefixListEntries(p.Entries, entries).IsEmpty() {
+		return false
+	}
+	add, remove := awsclients.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(p.Tags), pl.Tags)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// canonicalCIDR returns the network of the given CIDR block the way AWS
+// stores it, e.g. 10.0.0.1/8 becomes 10.0.0.0/8. Invalid blocks are returned
+// unchanged so that AWS rejects them.
+func canonicalCIDR(cidr string) string {
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return cidr
+	}
+	return ipnet.String()
+}
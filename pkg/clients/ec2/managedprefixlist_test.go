@@ -0,0 +1,226 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func prefixListEntry(cidr, description string) manualv1alpha1.PrefixListEntry {
+	return manualv1alpha1.PrefixListEntry{CIDR: cidr, Description: aws.String(description)}
+}
+
+func observedPrefixListEntry(cidr, description string) ec2types.PrefixListEntry {
+	return ec2types.PrefixListEntry{Cidr: aws.String(cidr), Description: aws.String(description)}
+}
+
+func TestValidateManagedPrefixListEntries(t *testing.T) {
+	cases := map[string]struct {
+		p    manualv1alpha1.ManagedPrefixListParameters
+		want error
+	}{
+		"Valid": {
+			p: manualv1alpha1.ManagedPrefixListParameters{
+				MaxEntries: 2,
+				Entries:    []manualv1alpha1.PrefixListEntry{prefixListEntry("10.0.0.0/8", "a"), prefixListEntry("192.168.0.0/16", "b")},
+			},
+		},
+		"TooManyEntries": {
+			p: manualv1alpha1.ManagedPrefixListParameters{
+				MaxEntries: 1,
+				Entries:    []manualv1alpha1.PrefixListEntry{prefixListEntry("10.0.0.0/8", "a"), prefixListEntry("192.168.0.0/16", "b")},
+			},
+			want: fmt.Errorf("%s: 2 > 1", errTooManyEntries),
+		},
+		"DuplicateCIDR": {
+			p: manualv1alpha1.ManagedPrefixListParameters{
+				MaxEntries: 2,
+				Entries:    []manualv1alpha1.PrefixListEntry{prefixListEntry("10.0.0.0/8", "a"), prefixListEntry("10.1.0.0/8", "b")},
+			},
+			want: fmt.Errorf("%s: 10.1.0.0/8", errDuplicatePrefixCIDR),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateManagedPrefixListEntries(tc.p)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffManagedPrefixListEntries(t *testing.T) {
+	type args struct {
+		desired  []manualv1alpha1.PrefixListEntry
+		observed []ec2types.PrefixListEntry
+	}
+
+	cases := map[string]struct {
+		args args
+		want PrefixListEntriesDiff
+	}{
+		"UpToDate": {
+			args: args{
+				desired:  []manualv1alpha1.PrefixListEntry{prefixListEntry("10.0.0.0/8", "a")},
+				observed: []ec2types.PrefixListEntry{observedPrefixListEntry("10.0.0.0/8", "a")},
+			},
+		},
+		"NonCanonicalCIDR": {
+			args: args{
+				desired:  []manualv1alpha1.PrefixListEntry{prefixListEntry("10.0.0.1/8", "a")},
+				observed: []ec2types.PrefixListEntry{observedPrefixListEntry("10.0.0.0/8", "a")},
+			},
+		},
+		"AddAndRemove": {
+			args: args{
+				desired: []manualv1alpha1.PrefixListEntry{
+					prefixListEntry("192.168.0.0/16", "b"),
+					prefixListEntry("172.16.0.0/12", "c"),
+				},
+				observed: []ec2types.PrefixListEntry{
+					observedPrefixListEntry("10.0.0.0/8", "a"),
+					observedPrefixListEntry("192.168.0.0/16", "b"),
+				},
+			},
+			want: PrefixListEntriesDiff{
+				Add:    []ec2types.AddPrefixListEntry{{Cidr: aws.String("172.16.0.0/12"), Description: aws.String("c")}},
+				Remove: []ec2types.RemovePrefixListEntry{{Cidr: aws.String("10.0.0.0/8")}},
+			},
+		},
+		"DescriptionChanged": {
+			args: args{
+				desired:  []manualv1alpha1.PrefixListEntry{prefixListEntry("10.0.0.0/8", "new")},
+				observed: []ec2types.PrefixListEntry{observedPrefixListEntry("10.0.0.0/8", "old")},
+			},
+			want: PrefixListEntriesDiff{
+				Remove: []ec2types.RemovePrefixListEntry{{Cidr: aws.String("10.0.0.0/8")}},
+			},
+		},
+		"RemoveAll": {
+			args: args{
+				observed: []ec2types.PrefixListEntry{
+					observedPrefixListEntry("192.168.0.0/16", "b"),
+					observedPrefixListEntry("10.0.0.0/8", "a"),
+				},
+			},
+			want: PrefixListEntriesDiff{
+				Remove: []ec2types.RemovePrefixListEntry{{Cidr: aws.String("10.0.0.0/8")}, {Cidr: aws.String("192.168.0.0/16")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DiffManagedPrefixListEntries(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsManagedPrefixListUpToDate(t *testing.T) {
+	type args struct {
+		p       manualv1alpha1.ManagedPrefixListParameters
+		pl      ec2types.ManagedPrefixList
+		entries []ec2types.PrefixListEntry
+	}
+
+	params := manualv1alpha1.ManagedPrefixListParameters{
+		PrefixListName: "corporate",
+		MaxEntries:     5,
+		Entries:        []manualv1alpha1.PrefixListEntry{prefixListEntry("10.0.0.0/8", "a")},
+		Tags:           []manualv1alpha1.Tag{{Key: "k", Value: "v"}},
+	}
+	observed := ec2types.ManagedPrefixList{
+		PrefixListName: aws.String("corporate"),
+		MaxEntries:     aws.Int32(5),
+		Tags:           []ec2types.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+	}
+	entries := []ec2types.PrefixListEntry{observedPrefixListEntry("10.0.0.0/8", "a")}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{p: params, pl: observed, entries: entries},
+			want: true,
+		},
+		"NameChanged": {
+			args: args{
+				p: params,
+				pl: ec2types.ManagedPrefixList{
+					PrefixListName: aws.String("old"),
+					MaxEntries:     observed.MaxEntries,
+					Tags:           observed.Tags,
+				},
+				entries: entries,
+			},
+			want: false,
+		},
+		"MaxEntriesChanged": {
+			args: args{
+				p: params,
+				pl: ec2types.ManagedPrefixList{
+					PrefixListName: observed.PrefixListName,
+					MaxEntries:     aws.Int32(10),
+					Tags:           observed.Tags,
+				},
+				entries: entries,
+			},
+			want: false,
+		},
+		"EntriesChanged": {
+			args: args{p: params, pl: observed},
+			want: false,
+		},
+		"TagsChanged": {
+			args: args{
+				p: params,
+				pl: ec2types.ManagedPrefixList{
+					PrefixListName: observed.PrefixListName,
+					MaxEntries:     observed.MaxEntries,
+				},
+				entries: entries,
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsManagedPrefixListUpToDate(tc.args.p, tc.args.pl, tc.args.entries)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// NetworkACLIDNotFound is the code that is returned by ec2 when the given
+	// network ACL ID is not valid
+	NetworkACLIDNotFound = "InvalidNetworkAclID.NotFound"
+
+	// NetworkACLEntryNotFound is the code that is returned by ec2 when the
+	// given network ACL entry does not exist
+	NetworkACLEntryNotFound = "InvalidNetworkAclEntry.NotFound"
+
+	// DefaultNetworkACLRuleNumber is the rule number of the rule that every
+	// network ACL ends with. It denies all traffic and cannot be modified.
+	DefaultNetworkACLRuleNumber int32 = 32767
+
+	errDuplicateRuleNumber = "duplicate network ACL rule number"
+)
+
+// NetworkACLClient is the external client used for NetworkACL Custom Resource
+type NetworkACLClient interface {
+	CreateNetworkAcl(ctx context.Context, input *ec2.CreateNetworkAclInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclOutput, error)
+	DeleteNetworkAcl(ctx context.Context, input *ec2.DeleteNetworkAclInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclOutput, error)
+	DescribeNetworkAcls(ctx context.Context, input *ec2.DescribeNetworkAclsInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error)
+	CreateNetworkAclEntry(ctx context.Context, input *ec2.CreateNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclEntryOutput, error)
+	ReplaceNetworkAclEntry(ctx context.Context, input *ec2.ReplaceNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclEntryOutput, error)
+	DeleteNetworkAclEntry(ctx context.Context, input *ec2.DeleteNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclEntryOutput, error)
+	ReplaceNetworkAclAssociation(ctx context.Context, input *ec2.ReplaceNetworkAclAssociationInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclAssociationOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewNetworkACLClient returns a new client using AWS credentials as JSON encoded data.
+func NewNetworkACLClient(cfg awsgo.Config) NetworkACLClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// NetworkACLEntryClient is the external client used for NetworkACLEntry
+// Custom Resource
+type NetworkACLEntryClient interface {
+	DescribeNetworkAcls(ctx context.Context, input *ec2.DescribeNetworkAclsInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error)
+	CreateNetworkAclEntry(ctx context.Context, input *ec2.CreateNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclEntryOutput, error)
+	ReplaceNetworkAclEntry(ctx context.Context, input *ec2.ReplaceNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclEntryOutput, error)
+	DeleteNetworkAclEntry(ctx context.Context, input *ec2.DeleteNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclEntryOutput, error)
+}
+
+// NewNetworkACLEntryClient returns a new client using AWS credentials as JSON encoded data.
+func NewNetworkACLEntryClient(cfg awsgo.Config) NetworkACLEntryClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsNetworkACLNotFoundErr returns true if the error is because the network
+// ACL doesn't exist
+func IsNetworkACLNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == NetworkACLIDNotFound
+}
+
+// IsNetworkACLEntryNotFoundErr returns true if the error is because the
+// network ACL entry doesn't exist
+func IsNetworkACLEntryNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == NetworkACLEntryNotFound
+}
+
+// networkACLProtocolNumbers maps the protocol names accepted in a
+// NetworkACLRule to the numbers ec2 reports them with.
+var networkACLProtocolNumbers = map[string]string{
+	"all":    "-1",
+	"tcp":    "6",
+	"udp":    "17",
+	"icmp":   "1",
+	"icmpv6": "58",
+}
+
+// NetworkACLProtocol returns the protocol number ec2 uses for the given
+// protocol name or number.
+func NetworkACLProtocol(protocol string) string {
+	if n, ok := networkACLProtocolNumbers[strings.ToLower(protocol)]; ok {
+		return n
+	}
+	return protocol
+}
+
+// GenerateNetworkACLObservation is used to produce
+// manualv1alpha1.NetworkACLObservation from ec2types.NetworkAcl.
+func GenerateNetworkACLObservation(acl ec2types.NetworkAcl) manualv1alpha1.NetworkACLObservation {
+	o := manualv1alpha1.NetworkACLObservation{
+		NetworkACLID: awsgo.ToString(acl.NetworkAclId),
+		OwnerID:      awsgo.ToString(acl.OwnerId),
+		IsDefault:    awsgo.ToBool(acl.IsDefault),
+	}
+	if len(acl.Associations) > 0 {
+		o.Associations = make([]manualv1alpha1.NetworkACLAssociation, len(acl.Associations))
+		for i, a := range acl.Associations {
+			o.Associations[i] = manualv1alpha1.NetworkACLAssociation{
+				AssociationID: awsgo.ToString(a.NetworkAclAssociationId),
+				SubnetID:      awsgo.ToString(a.SubnetId),
+			}
+		}
+	}
+	return o
+}
+
+// LateInitializeNetworkACL fills the empty fields in
+// *manualv1alpha1.NetworkACLParameters with the values seen in
+// ec2types.NetworkAcl. Entries are never late-initialized since leaving them
+// unset means that they are not managed.
+func LateInitializeNetworkACL(in *manualv1alpha1.NetworkACLParameters, acl *ec2types.NetworkAcl) {
+	if acl == nil {
+		return
+	}
+	in.VPCID = awsclients.LateInitializeStringPtr(in.VPCID, acl.VpcId)
+	if len(in.SubnetIDs) == 0 && len(acl.Associations) != 0 {
+		in.SubnetIDs = make([]string, len(acl.Associations))
+		for i, a := range acl.Associations {
+			in.SubnetIDs[i] = awsgo.ToString(a.SubnetId)
+		}
+	}
+	if len(in.Tags) == 0 && len(acl.Tags) != 0 {
+		in.Tags = manualv1alpha1.BuildFromEC2Tags(acl.Tags)
+	}
+}
+
+// GenerateCreateNetworkACLEntryInput returns the input that creates the given
+// rule in the given network ACL.
+func GenerateCreateNetworkACLEntryInput(aclID string, egress bool, r manualv1alpha1.NetworkACLRule) *ec2.CreateNetworkAclEntryInput {
+	return &ec2.CreateNetworkAclEntryInput{
+		NetworkAclId:  awsgo.String(aclID),
+		Egress:        awsgo.Bool(egress),
+		RuleNumber:    awsgo.Int32(r.RuleNumber),
+		Protocol:      awsgo.String(NetworkACLProtocol(r.Protocol)),
+		RuleAction:    ec2types.RuleAction(r.RuleAction),
+		CidrBlock:     r.CIDRBlock,
+		Ipv6CidrBlock: r.IPv6CIDRBlock,
+		PortRange:     generatePortRange(r),
+		IcmpTypeCode:  generateIcmpTypeCode(r),
+	}
+}
+
+// GenerateReplaceNetworkACLEntryInput returns the input that replaces the
+// entry with the rule number of the given rule in the given network ACL.
+func GenerateReplaceNetworkACLEntryInput(aclID string, egress bool, r manualv1alpha1.NetworkACLRule) *ec2.ReplaceNetworkAclEntryInput {
+	return &ec2.ReplaceNetworkAclEntryInput{
+		NetworkAclId:  awsgo.String(aclID),
+		Egress:        awsgo.Bool(egress),
+		RuleNumber:    awsgo.Int32(r.RuleNumber),
+		Protocol:      awsgo.String(NetworkACLProtocol(r.Protocol)),
+		RuleAction:    ec2types.RuleAction(r.RuleAction),
+		CidrBlock:     r.CIDRBlock,
+		Ipv6CidrBlock: r.IPv6CIDRBlock,
+		PortRange:     generatePortRange(r),
+		IcmpTypeCode:  generateIcmpTypeCode(r),
+	}
+}
+
+func generatePortRange(r manualv1alpha1.NetworkACLRule) *ec2types.PortRange {
+	if r.FromPort == nil && r.ToPort == nil {
+		return nil
+	}
+	return &ec2types.PortRange{From: r.FromPort, To: r.ToPort}
+}
+
+func generateIcmpTypeCode(r manualv1alpha1.NetworkACLRule) *ec2types.IcmpTypeCode {
+	if r.ICMPType == nil && r.ICMPCode == nil {
+		return nil
+	}
+	return &ec2types.IcmpTypeCode{Type: r.ICMPType, Code: r.ICMPCode}
+}
+
+// FindNetworkACLEntry returns the entry of the given direction and rule
+// number, or nil if there is none.
+func FindNetworkACLEntry(entries []ec2types.NetworkAclEntry, egress bool, ruleNumber int32) *ec2types.NetworkAclEntry {
+	for i := range entries {
+		if awsgo.ToBool(entries[i].Egress) == egress && awsgo.ToInt32(entries[i].RuleNumber) == ruleNumber {
+			return &entries[i]
+		}
+	}
+	return nil
+}
+
+// IsNetworkACLRuleUpToDate checks whether the observed entry matches the
+// desired rule. Ports are only compared for tcp and udp, and the ICMP type
+// and code only for icmp and icmpv6, since ec2 ignores them otherwise.
+func IsNetworkACLRuleUpToDate(r manualv1alpha1.NetworkACLRule, e ec2types.NetworkAclEntry) bool {
+	protocol := NetworkACLProtocol(r.Protocol)
+	if protocol != awsgo.ToString(e.Protocol) ||
+		r.RuleAction != string(e.RuleAction) ||
+		!cidrBlockPtrsEqual(r.CIDRBlock, e.CidrBlock) ||
+		!cidrBlockPtrsEqual(r.IPv6CIDRBlock, e.Ipv6CidrBlock) {
+		return false
+	}
+	switch protocol {
+	case networkACLProtocolNumbers["tcp"], networkACLProtocolNumbers["udp"]:
+		pr := ec2types.PortRange{}
+		if e.PortRange != nil {
+			pr = *e.PortRange
+		}
+		return getInt32Key(r.FromPort) == getInt32Key(pr.From) && getInt32Key(r.ToPort) == getInt32Key(pr.To)
+	case networkACLProtocolNumbers["icmp"], networkACLProtocolNumbers["icmpv6"]:
+		tc := ec2types.IcmpTypeCode{}
+		if e.IcmpTypeCode != nil {
+			tc = *e.IcmpTypeCode
+		}
+		return getInt32Key(r.ICMPType) == getInt32Key(tc.Type) && getInt32Key(r.ICMPCode) == getInt32Key(tc.Code)
+	}
+	return true
+}
+
+func cidrBlockPtrsEqual(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b || awsclients.CIDRBlocksEqual(*a, *b)
+}
+
+// NetworkACLEntriesDiff holds the changes that are needed to converge the
+// entries of one direction of a network ACL. All lists are ordered by rule
+// number.
+type NetworkACLEntriesDiff struct {
+	// Create are the rules whose rule numbers are not in use yet.
+	Create []manualv1alpha1.NetworkACLRule
+
+	// Replace are the rules whose rule numbers are in use by an entry that
+	// does not match.
+	Replace []manualv1alpha1.NetworkACLRule
+
+	// Delete are the rule numbers of entries that are not desired.
+	Delete []int32
+}
+
+// IsEmpty returns true if the diff contains no changes.
+func (d NetworkACLEntriesDiff) IsEmpty() bool {
+	return len(d.Create) == 0 && len(d.Replace) == 0 && len(d.Delete) == 0
+}
+
+// DiffNetworkACLEntries matches the desired rules of one direction to the
+// observed entries by rule number, since that is what identifies an entry,
+// and returns the changes that are needed. The default rule is never part of
+// the diff. An error is returned if a rule number is used more than once.
+func DiffNetworkACLEntries(desired []manualv1alpha1.NetworkACLRule, observed []ec2types.NetworkAclEntry, egress bool) (NetworkACLEntriesDiff, error) {
+	diff := NetworkACLEntriesDiff{}
+	want := make(map[int32]bool, len(desired))
+	for _, r := range desired {
+		if want[r.RuleNumber] {
+			return NetworkACLEntriesDiff{}, fmt.Errorf("%s: %d", errDuplicateRuleNumber, r.RuleNumber)
+		}
+		want[r.RuleNumber] = true
+
+		e := FindNetworkACLEntry(observed, egress, r.RuleNumber)
+		switch {
+		case e == nil:
+			diff.Create = append(diff.Create, r)
+		case !IsNetworkACLRuleUpToDate(r, *e):
+			diff.Replace = append(diff.Replace, r)
+		}
+	}
+	for _, e := range observed {
+		n := awsgo.ToInt32(e.RuleNumber)
+		if awsgo.ToBool(e.Egress) != egress || n == DefaultNetworkACLRuleNumber || want[n] {
+			continue
+		}
+		diff.Delete = append(diff.Delete, n)
+	}
+
+	sort.Slice(diff.Create, func(i, j int) bool { return diff.Create[i].RuleNumber < diff.Create[j].RuleNumber })
+	sort.Slice(diff.Replace, func(i, j int) bool { return diff.Replace[i].RuleNumber < diff.Replace[j].RuleNumber })
+	sort.Slice(diff.Delete, func(i, j int) bool { return diff.Delete[i] < diff.Delete[j] })
+	return diff, nil
+}
+
+// DiffNetworkACLSubnets returns the subnets that have to be associated with
+// the network ACL and the observed associations that have to be moved back
+// to the default network ACL of the VPC.
+func DiffNetworkACLSubnets(desired []string, observed []ec2types.NetworkAclAssociation) (associate []string, disassociate []ec2types.NetworkAclAssociation) {
+	have := make(map[string]bool, len(observed))
+	for _, a := range observed {
+		have[awsgo.ToString(a.SubnetId)] = true
+	}
+	want := make(map[string]bool, len(desired))
+	for _, s := range desired {
+		want[s] = true
+		if !have[s] {
+			associate = append(associate, s)
+		}
+	}
+	for _, a := range observed {
+		if !want[awsgo.ToString(a.SubnetId)] {
+			disassociate = append(disassociate, a)
+		}
+	}
+	return associate, disassociate
+}
+
+// IsNetworkACLUpToDate checks whether the tags, the subnet associations and
+// the managed entries of the network ACL match the desired state.
+func IsNetworkACLUpToDate(p manualv1alpha1.NetworkACLParameters, acl ec2types.NetworkAcl) (bool, error) {
+	addTags, removeTags := awsclients.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(p.Tags), acl.Tags)
+	if len(addTags) != 0 || len(removeTags) != 0 {
+		return false, nil
+	}
+
+	associate, disassociate := DiffNetworkACLSubnets(p.SubnetIDs, acl.Associations)
+	if len(associate) != 0 || len(disassociate) != 0 {
+		return false, nil
+	}
+
+	for _, dir := range []struct {
+		rules  []manualv1alpha1.NetworkACLRule
+		egress bool
+	}{{p.Ingress, false}, {p.Egress, true}} {
+		if dir.rules == nil {
+			continue
+		}
+		diff, err := DiffNetworkACLEntries(dir.rules, acl.Entries, dir.egress)
+		if err != nil {
+			return false, err
+		}
+		if !diff.IsEmpty() {
+			return false, nil
+		}
+	}
+	return true, nil
+}
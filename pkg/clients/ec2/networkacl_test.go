@@ -0,0 +1,310 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func naclRule(n int32, cidr string) manualv1alpha1.NetworkACLRule {
+	return manualv1alpha1.NetworkACLRule{
+		RuleNumber: n,
+		Protocol:   tcpProtocol,
+		RuleAction: manualv1alpha1.NetworkACLRuleActionAllow,
+		CIDRBlock:  aws.String(cidr),
+		FromPort:   &port80,
+		ToPort:     &port80,
+	}
+}
+
+func naclEntry(n int32, egress bool, cidr string) ec2types.NetworkAclEntry {
+	return ec2types.NetworkAclEntry{
+		RuleNumber: aws.Int32(n),
+		Egress:     aws.Bool(egress),
+		Protocol:   aws.String("6"),
+		RuleAction: ec2types.RuleActionAllow,
+		CidrBlock:  aws.String(cidr),
+		PortRange:  &ec2types.PortRange{From: &port80, To: &port80},
+	}
+}
+
+func defaultNACLEntry(egress bool) ec2types.NetworkAclEntry {
+	return ec2types.NetworkAclEntry{
+		RuleNumber: aws.Int32(DefaultNetworkACLRuleNumber),
+		Egress:     aws.Bool(egress),
+		Protocol:   aws.String("-1"),
+		RuleAction: ec2types.RuleActionDeny,
+		CidrBlock:  aws.String("0.0.0.0/0"),
+	}
+}
+
+func TestIsNetworkACLRuleUpToDate(t *testing.T) {
+	type args struct {
+		r manualv1alpha1.NetworkACLRule
+		e ec2types.NetworkAclEntry
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"ProtocolName": {
+			args: args{
+				r: naclRule(100, cidr),
+				e: naclEntry(100, false, cidr),
+			},
+			want: true,
+		},
+		"DifferentPorts": {
+			args: args{
+				r: naclRule(100, cidr),
+				e: func() ec2types.NetworkAclEntry {
+					e := naclEntry(100, false, cidr)
+					e.PortRange.To = &port100
+					return e
+				}(),
+			},
+			want: false,
+		},
+		"DifferentAction": {
+			args: args{
+				r: func() manualv1alpha1.NetworkACLRule {
+					r := naclRule(100, cidr)
+					r.RuleAction = manualv1alpha1.NetworkACLRuleActionDeny
+					return r
+				}(),
+				e: naclEntry(100, false, cidr),
+			},
+			want: false,
+		},
+		"AllProtocolsIgnoresPorts": {
+			args: args{
+				r: func() manualv1alpha1.NetworkACLRule {
+					r := naclRule(100, cidr)
+					r.Protocol = "-1"
+					return r
+				}(),
+				e: func() ec2types.NetworkAclEntry {
+					e := naclEntry(100, false, cidr)
+					e.Protocol = aws.String("-1")
+					e.PortRange = nil
+					return e
+				}(),
+			},
+			want: true,
+		},
+		"ICMP": {
+			args: args{
+				r: manualv1alpha1.NetworkACLRule{
+					RuleNumber: 100,
+					Protocol:   "icmp",
+					RuleAction: manualv1alpha1.NetworkACLRuleActionAllow,
+					CIDRBlock:  aws.String(cidr),
+					ICMPType:   aws.Int32(8),
+					ICMPCode:   aws.Int32(-1),
+				},
+				e: ec2types.NetworkAclEntry{
+					RuleNumber:   aws.Int32(100),
+					Protocol:     aws.String("1"),
+					RuleAction:   ec2types.RuleActionAllow,
+					CidrBlock:    aws.String(cidr),
+					IcmpTypeCode: &ec2types.IcmpTypeCode{Type: aws.Int32(0), Code: aws.Int32(-1)},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsNetworkACLRuleUpToDate(tc.args.r, tc.args.e)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffNetworkACLEntries(t *testing.T) {
+	type args struct {
+		desired  []manualv1alpha1.NetworkACLRule
+		observed []ec2types.NetworkAclEntry
+		egress   bool
+	}
+	type want struct {
+		diff NetworkACLEntriesDiff
+		err  error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				desired:  []manualv1alpha1.NetworkACLRule{naclRule(100, cidr)},
+				observed: []ec2types.NetworkAclEntry{naclEntry(100, false, cidr), defaultNACLEntry(false)},
+			},
+		},
+		"MatchedByRuleNumber": {
+			args: args{
+				desired: []manualv1alpha1.NetworkACLRule{
+					naclRule(300, cidr),
+					naclRule(100, "192.168.0.0/16"),
+					naclRule(200, cidr),
+				},
+				observed: []ec2types.NetworkAclEntry{
+					naclEntry(100, false, cidr),
+					naclEntry(200, false, cidr),
+					naclEntry(250, false, cidr),
+					naclEntry(50, false, cidr),
+					defaultNACLEntry(false),
+				},
+			},
+			want: want{
+				diff: NetworkACLEntriesDiff{
+					Create:  []manualv1alpha1.NetworkACLRule{naclRule(300, cidr)},
+					Replace: []manualv1alpha1.NetworkACLRule{naclRule(100, "192.168.0.0/16")},
+					Delete:  []int32{50, 250},
+				},
+			},
+		},
+		"OtherDirectionIgnored": {
+			args: args{
+				desired:  []manualv1alpha1.NetworkACLRule{naclRule(100, cidr)},
+				observed: []ec2types.NetworkAclEntry{naclEntry(100, false, cidr), naclEntry(200, true, cidr), defaultNACLEntry(true)},
+				egress:   true,
+			},
+			want: want{
+				diff: NetworkACLEntriesDiff{
+					Create: []manualv1alpha1.NetworkACLRule{naclRule(100, cidr)},
+					Delete: []int32{200},
+				},
+			},
+		},
+		"DuplicateRuleNumber": {
+			args: args{
+				desired: []manualv1alpha1.NetworkACLRule{naclRule(100, cidr), naclRule(100, "192.168.0.0/16")},
+			},
+			want: want{
+				err: fmt.Errorf("%s: %d", errDuplicateRuleNumber, 100),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := DiffNetworkACLEntries(tc.args.desired, tc.args.observed, tc.args.egress)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.diff, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsNetworkACLUpToDate(t *testing.T) {
+	subnet := "subnet-1"
+	association := ec2types.NetworkAclAssociation{
+		NetworkAclAssociationId: aws.String("aclassoc-1"),
+		SubnetId:                aws.String(subnet),
+	}
+	acl := ec2types.NetworkAcl{
+		Associations: []ec2types.NetworkAclAssociation{association},
+		Entries:      []ec2types.NetworkAclEntry{naclEntry(100, false, cidr), defaultNACLEntry(false), defaultNACLEntry(true)},
+	}
+
+	cases := map[string]struct {
+		p    manualv1alpha1.NetworkACLParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: manualv1alpha1.NetworkACLParameters{
+				SubnetIDs: []string{subnet},
+				Ingress:   []manualv1alpha1.NetworkACLRule{naclRule(100, cidr)},
+				Egress:    []manualv1alpha1.NetworkACLRule{},
+			},
+			want: true,
+		},
+		"UnmanagedEntries": {
+			p: manualv1alpha1.NetworkACLParameters{
+				SubnetIDs: []string{subnet},
+			},
+			want: true,
+		},
+		"EntriesRemoved": {
+			p: manualv1alpha1.NetworkACLParameters{
+				SubnetIDs: []string{subnet},
+				Ingress:   []manualv1alpha1.NetworkACLRule{},
+			},
+			want: false,
+		},
+		"SubnetAdded": {
+			p: manualv1alpha1.NetworkACLParameters{
+				SubnetIDs: []string{subnet, "subnet-2"},
+			},
+			want: false,
+		},
+		"TagAdded": {
+			p: manualv1alpha1.NetworkACLParameters{
+				SubnetIDs: []string{subnet},
+				Tags:      []manualv1alpha1.Tag{{Key: "k", Value: "v"}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsNetworkACLUpToDate(tc.p, acl)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffNetworkACLSubnets(t *testing.T) {
+	observed := []ec2types.NetworkAclAssociation{
+		{NetworkAclAssociationId: aws.String("aclassoc-1"), SubnetId: aws.String("subnet-1")},
+		{NetworkAclAssociationId: aws.String("aclassoc-2"), SubnetId: aws.String("subnet-2")},
+	}
+
+	associate, disassociate := DiffNetworkACLSubnets([]string{"subnet-2", "subnet-3"}, observed)
+	if diff := cmp.Diff([]string{"subnet-3"}, associate); diff != "" {
+		t.Errorf("associate: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(observed[:1], disassociate, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+		t.Errorf("disassociate: -want, +got:\n%s", diff)
+	}
+}
@@ -130,14 +130,27 @@ func IsSGUpToDate(sg v1beta1.SecurityGroupParameters, observed ec2types.Security
 		return false
 	}
 
-	add, remove := DiffPermissions(GenerateEC2Permissions(sg.Ingress), observed.IpPermissions)
+	add, remove := DiffSGPermissions(sg, sg.Ingress, observed.IpPermissions)
 	if len(add) > 0 || len(remove) > 0 {
 		return false
 	}
 
-	add, remove = DiffPermissions(GenerateEC2Permissions(sg.Egress), observed.IpPermissionsEgress)
+	add, remove = DiffSGPermissions(sg, sg.Egress, observed.IpPermissionsEgress)
 	if len(add) > 0 || len(remove) > 0 {
 		return false
 	}
 	return true
 }
+
+// DiffSGPermissions returns the rules to add to and remove from the observed
+// rules of a security group to make them match the desired ones. Only the
+// rules that must be replaced to change their descriptions are removed if
+// the security group preserves rules it does not own.
+func DiffSGPermissions(sg v1beta1.SecurityGroupParameters, desired []v1beta1.IPPermission, observed []ec2types.IpPermission) (add, remove []ec2types.IpPermission) {
+	want := GenerateEC2Permissions(desired)
+	add, remove = DiffPermissions(want, observed)
+	if aws.BoolValue(sg.PreserveUnownedRules) {
+		remove = OwnedPermissions(remove, want)
+	}
+	return add, remove
+}
@@ -231,3 +231,51 @@ func DiffPermissions(want, have []ec2types.IpPermission) (add, remove []ec2types
 
 	return add, remove
 }
+
+// OwnedPermissions returns the rules in perms that are also in want,
+// regardless of their descriptions. It is used to revoke only the rules that
+// must be replaced to change their descriptions.
+func OwnedPermissions(perms, want []ec2types.IpPermission) []ec2types.IpPermission { // nolint:gocyclo
+	wantMap := convertToMaps(want)
+
+	var owned []ec2types.IpPermission
+	for _, perm := range perms {
+		w, ok := wantMap[getKey(perm)]
+		if !ok {
+			continue
+		}
+		o := ec2types.IpPermission{
+			IpProtocol: perm.IpProtocol,
+			FromPort:   perm.FromPort,
+			ToPort:     perm.ToPort,
+		}
+		for _, r := range perm.IpRanges {
+			if _, ok := w.ipRanges[aws.ToString(r.CidrIp)]; ok {
+				o.IpRanges = append(o.IpRanges, r)
+			}
+		}
+		for _, r := range perm.Ipv6Ranges {
+			if _, ok := w.ipv6Ranges[aws.ToString(r.CidrIpv6)]; ok {
+				o.Ipv6Ranges = append(o.Ipv6Ranges, r)
+			}
+		}
+		for _, r := range perm.PrefixListIds {
+			if _, ok := w.prefixListIDs[aws.ToString(r.PrefixListId)]; ok {
+				o.PrefixListIds = append(o.PrefixListIds, r)
+			}
+		}
+		for _, r := range perm.UserIdGroupPairs {
+			key := aws.ToString(r.GroupId)
+			if key == "" {
+				key = aws.ToString(r.GroupName)
+			}
+			if _, ok := w.groups[key]; ok {
+				o.UserIdGroupPairs = append(o.UserIdGroupPairs, r)
+			}
+		}
+		if hasRules(o) {
+			owned = append(owned, o)
+		}
+	}
+	return owned
+}
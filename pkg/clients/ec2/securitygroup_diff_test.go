@@ -205,3 +205,41 @@ func TestDiffPermissions(t *testing.T) {
 		})
 	}
 }
+
+func TestOwnedPermissions(t *testing.T) {
+	cases := map[string]struct {
+		perms, want []ec2types.IpPermission
+		owned       []ec2types.IpPermission
+	}{
+		"Unowned": {
+			perms: sgPermissions(80, "10.0.0.0/8"),
+			want:  sgPermissions(80, "192.168.0.0/16"),
+			owned: nil,
+		},
+		"DifferentPort": {
+			perms: sgPermissions(100, "10.0.0.0/8"),
+			want:  sgPermissions(80, "10.0.0.0/8"),
+			owned: nil,
+		},
+		"Owned": {
+			perms: sgPermissions(80, "10.0.0.0/8", "172.16.0.0/12"),
+			want:  sgPermissions(80, "10.0.0.0/8"),
+			owned: sgPermissions(80, "10.0.0.0/8"),
+		},
+		"OwnedGroup": {
+			perms: sgUserIDGroupPair(80, "sg-1", "sg-2"),
+			want:  sgUserIDGroupPair(80, "sg-2"),
+			owned: sgUserIDGroupPair(80, "sg-2"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			owned := OwnedPermissions(tc.perms, tc.want)
+
+			if diff := cmp.Diff(tc.owned, owned, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -113,6 +113,42 @@ func TestIsSGUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"PreserveUnownedRules": {
+			args: args{
+				sg: ec2types.SecurityGroup{
+					Description:   aws.String(sgDesc),
+					GroupName:     aws.String(sgName),
+					VpcId:         aws.String(sgVpc),
+					IpPermissions: sgIPPermission(80, 100),
+				},
+				p: v1beta1.SecurityGroupParameters{
+					Description:          sgDesc,
+					GroupName:            sgName,
+					VPCID:                aws.String(sgVpc),
+					Ingress:              specIPPermission(80),
+					PreserveUnownedRules: aws.Bool(true),
+				},
+			},
+			want: true,
+		},
+		"PreserveUnownedRulesMissingRule": {
+			args: args{
+				sg: ec2types.SecurityGroup{
+					Description:   aws.String(sgDesc),
+					GroupName:     aws.String(sgName),
+					VpcId:         aws.String(sgVpc),
+					IpPermissions: sgIPPermission(100),
+				},
+				p: v1beta1.SecurityGroupParameters{
+					Description:          sgDesc,
+					GroupName:            sgName,
+					VPCID:                aws.String(sgVpc),
+					Ingress:              specIPPermission(80),
+					PreserveUnownedRules: aws.Bool(true),
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// InvalidSecurityGroupRuleIDNotFound is the code that is returned by ec2
+	// when the given security group rule ID is not valid
+	InvalidSecurityGroupRuleIDNotFound = "InvalidSecurityGroupRuleId.NotFound"
+)
+
+// SecurityGroupRuleClient is the external client used for SecurityGroupRule
+// Custom Resource
+type SecurityGroupRuleClient interface {
+	DescribeSecurityGroupRules(ctx context.Context, input *ec2.DescribeSecurityGroupRulesInput, opts ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupRulesOutput, error)
+	AuthorizeSecurityGroupIngress(ctx context.Context, input *ec2.AuthorizeSecurityGroupIngressInput, opts ...func(*ec2.Options)) (*ec2.AuthorizeSecurityGroupIngressOutput, error)
+	AuthorizeSecurityGroupEgress(ctx context.Context, input *ec2.AuthorizeSecurityGroupEgressInput, opts ...func(*ec2.Options)) (*ec2.AuthorizeSecurityGroupEgressOutput, error)
+	ModifySecurityGroupRules(ctx context.Context, input *ec2.ModifySecurityGroupRulesInput, opts ...func(*ec2.Options)) (*ec2.ModifySecurityGroupRulesOutput, error)
+	RevokeSecurityGroupIngress(ctx context.Context, input *ec2.RevokeSecurityGroupIngressInput, opts ...func(*ec2.Options)) (*ec2.RevokeSecurityGroupIngressOutput, error)
+	RevokeSecurityGroupEgress(ctx context.Context, input *ec2.RevokeSecurityGroupEgressInput, opts ...func(*ec2.Options)) (*ec2.RevokeSecurityGroupEgressOutput, error)
+}
+
+// NewSecurityGroupRuleClient generates client for AWS Security Group Rule API
+func NewSecurityGroupRuleClient(cfg awsgo.Config) SecurityGroupRuleClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsSecurityGroupRuleNotFoundErr returns true if the error is because the
+// item doesn't exist
+func IsSecurityGroupRuleNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == InvalidSecurityGroupRuleIDNotFound
+}
+
+// GenerateSecurityGroupRulePermission converts a SecurityGroupRule to the
+// ec2 permission that authorizes it.
+func GenerateSecurityGroupRulePermission(p manualv1alpha1.SecurityGroupRuleParameters) ec2types.IpPermission {
+	perm := ec2types.IpPermission{
+		IpProtocol: awsgo.String(p.IPProtocol),
+		FromPort:   p.FromPort,
+		ToPort:     p.ToPort,
+	}
+	switch {
+	case p.CIDRIP != nil:
+		perm.IpRanges = []ec2types.IpRange{{CidrIp: p.CIDRIP, Description: p.Description}}
+	case p.CIDRIPv6 != nil:
+		perm.Ipv6Ranges = []ec2types.Ipv6Range{{CidrIpv6: p.CIDRIPv6, Description: p.Description}}
+	case p.PrefixListID != nil:
+		perm.PrefixListIds = []ec2types.PrefixListId{{PrefixListId: p.PrefixListID, Description: p.Description}}
+	case p.SourceSecurityGroupID != nil:
+		perm.UserIdGroupPairs = []ec2types.UserIdGroupPair{{GroupId: p.SourceSecurityGroupID, Description: p.Description}}
+	}
+	return perm
+}
+
+// GenerateSecurityGroupRuleRequest converts a SecurityGroupRule to the
+// request that modifies an existing rule to match it.
+func GenerateSecurityGroupRuleRequest(p manualv1alpha1.SecurityGroupRuleParameters) *ec2types.SecurityGroupRuleRequest {
+	return &ec2types.SecurityGroupRuleRequest{
+		IpProtocol:        awsgo.String(p.IPProtocol),
+		FromPort:          p.FromPort,
+		ToPort:            p.ToPort,
+		CidrIpv4:          p.CIDRIP,
+		CidrIpv6:          p.CIDRIPv6,
+		PrefixListId:      p.PrefixListID,
+		ReferencedGroupId: p.SourceSecurityGroupID,
+		Description:       p.Description,
+	}
+}
+
+// GenerateSecurityGroupRuleObservation is used to produce
+// manualv1alpha1.SecurityGroupRuleObservation from ec2types.SecurityGroupRule.
+func GenerateSecurityGroupRuleObservation(r ec2types.SecurityGroupRule) manualv1alpha1.SecurityGroupRuleObservation {
+	return manualv1alpha1.SecurityGroupRuleObservation{
+		SecurityGroupRuleID: awsclients.StringValue(r.SecurityGroupRuleId),
+		GroupOwnerID:        awsclients.StringValue(r.GroupOwnerId),
+	}
+}
+
+// LateInitializeSecurityGroupRule fills the empty fields in
+// *manualv1alpha1.SecurityGroupRuleParameters with the values seen in
+// ec2types.SecurityGroupRule.
+func LateInitializeSecurityGroupRule(in *manualv1alpha1.SecurityGroupRuleParameters, r *ec2types.SecurityGroupRule) {
+	if r == nil {
+		return
+	}
+	in.SecurityGroupID = awsclients.LateInitializeStringPtr(in.SecurityGroupID, r.GroupId)
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, r.Description)
+}
+
+// IsSecurityGroupRuleUpToDate returns true if the observed rule matches the
+// desired state.
+func IsSecurityGroupRuleUpToDate(p manualv1alpha1.SecurityGroupRuleParameters, r ec2types.SecurityGroupRule) bool {
+	var referencedGroupID *string
+	if r.ReferencedGroupInfo != nil {
+		referencedGroupID = r.ReferencedGroupInfo.GroupId
+	}
+	// AWS reports -1 for the ports of rules that allow all of them.
+	return strings.EqualFold(p.IPProtocol, awsclients.StringValue(r.IpProtocol)) &&
+		getInt32Key(p.FromPort) == getInt32Key(r.FromPort) &&
+		getInt32Key(p.ToPort) == getInt32Key(r.ToPort) &&
+		awsclients.StringValue(p.CIDRIP) == awsclients.StringValue(r.CidrIpv4) &&
+		awsclients.StringValue(p.CIDRIPv6) == awsclients.StringValue(r.CidrIpv6) &&
+		awsclients.StringValue(p.PrefixListID) == awsclients.StringValue(r.PrefixListId) &&
+		awsclients.StringValue(p.SourceSecurityGroupID) == awsclients.StringValue(referencedGroupID) &&
+		awsclients.StringValue(p.Description) == awsclients.StringValue(r.Description)
+}
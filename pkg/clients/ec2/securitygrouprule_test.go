@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func TestGenerateSecurityGroupRulePermission(t *testing.T) {
+	cases := map[string]struct {
+		p    manualv1alpha1.SecurityGroupRuleParameters
+		want ec2types.IpPermission
+	}{
+		"CIDR": {
+			p: manualv1alpha1.SecurityGroupRuleParameters{
+				IPProtocol:  tcpProtocol,
+				FromPort:    &port80,
+				ToPort:      &port80,
+				CIDRIP:      aws.String("10.0.0.0/8"),
+				Description: aws.String("http"),
+			},
+			want: ec2types.IpPermission{
+				IpProtocol: aws.String(tcpProtocol),
+				FromPort:   &port80,
+				ToPort:     &port80,
+				IpRanges:   []ec2types.IpRange{{CidrIp: aws.String("10.0.0.0/8"), Description: aws.String("http")}},
+			},
+		},
+		"SourceSecurityGroup": {
+			p: manualv1alpha1.SecurityGroupRuleParameters{
+				IPProtocol:            "-1",
+				SourceSecurityGroupID: aws.String("sg-1"),
+			},
+			want: ec2types.IpPermission{
+				IpProtocol:       aws.String("-1"),
+				UserIdGroupPairs: []ec2types.UserIdGroupPair{{GroupId: aws.String("sg-1")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateSecurityGroupRulePermission(tc.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSecurityGroupRuleUpToDate(t *testing.T) {
+	type args struct {
+		p manualv1alpha1.SecurityGroupRuleParameters
+		r ec2types.SecurityGroupRule
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"SameFields": {
+			args: args{
+				p: manualv1alpha1.SecurityGroupRuleParameters{
+					IPProtocol: "TCP",
+					FromPort:   &port80,
+					ToPort:     &port80,
+					CIDRIP:     aws.String("10.0.0.0/8"),
+				},
+				r: ec2types.SecurityGroupRule{
+					IpProtocol: aws.String(tcpProtocol),
+					FromPort:   &port80,
+					ToPort:     &port80,
+					CidrIpv4:   aws.String("10.0.0.0/8"),
+				},
+			},
+			want: true,
+		},
+		"AllPorts": {
+			args: args{
+				p: manualv1alpha1.SecurityGroupRuleParameters{
+					IPProtocol:            "-1",
+					SourceSecurityGroupID: aws.String("sg-1"),
+				},
+				r: ec2types.SecurityGroupRule{
+					IpProtocol:          aws.String("-1"),
+					FromPort:            aws.Int32(-1),
+					ToPort:              aws.Int32(-1),
+					ReferencedGroupInfo: &ec2types.ReferencedSecurityGroup{GroupId: aws.String("sg-1")},
+				},
+			},
+			want: true,
+		},
+		"DifferentDescription": {
+			args: args{
+				p: manualv1alpha1.SecurityGroupRuleParameters{
+					IPProtocol:  tcpProtocol,
+					FromPort:    &port80,
+					ToPort:      &port80,
+					CIDRIP:      aws.String("10.0.0.0/8"),
+					Description: aws.String("http"),
+				},
+				r: ec2types.SecurityGroupRule{
+					IpProtocol: aws.String(tcpProtocol),
+					FromPort:   &port80,
+					ToPort:     &port80,
+					CidrIpv4:   aws.String("10.0.0.0/8"),
+				},
+			},
+			want: false,
+		},
+		"DifferentPort": {
+			args: args{
+				p: manualv1alpha1.SecurityGroupRuleParameters{
+					IPProtocol: tcpProtocol,
+					FromPort:   &port100,
+					ToPort:     &port100,
+					CIDRIP:     aws.String("10.0.0.0/8"),
+				},
+				r: ec2types.SecurityGroupRule{
+					IpProtocol: aws.String(tcpProtocol),
+					FromPort:   &port80,
+					ToPort:     &port80,
+					CidrIpv4:   aws.String("10.0.0.0/8"),
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSecurityGroupRuleUpToDate(tc.args.p, tc.args.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
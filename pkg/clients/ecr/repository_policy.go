@@ -2,7 +2,6 @@ package ecr
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -11,7 +10,9 @@ import (
 	awsecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
 
 	"github.com/crossplane/provider-aws/apis/ecr/v1beta1"
+	policyv1alpha1 "github.com/crossplane/provider-aws/apis/policy/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
 )
 
 const (
@@ -52,151 +53,79 @@ func IsPolicyNotFoundErr(err error) bool {
 	return errors.As(err, &notFoundError)
 }
 
-// Serialize is the custom marshaller for the RepositoryPolicyBody
-func Serialize(p *v1beta1.RepositoryPolicyBody) (interface{}, error) {
-	m := make(map[string]interface{})
-	m["Version"] = p.Version
-	if p.ID != nil && *p.ID != "" {
-		m["Id"] = p.ID
-	}
-	slc := make([]interface{}, len(p.Statements))
-	for i, v := range p.Statements {
-		msg, err := SerializeRepositoryPolicyStatement(v)
-		if err != nil {
-			return nil, err
-		}
-		slc[i] = msg
-	}
-	m["Statement"] = slc
-	return m, nil
+// Serialize returns the JSON form of the supplied RepositoryPolicyBody.
+func Serialize(p *v1beta1.RepositoryPolicyBody) (string, error) {
+	return policyclient.Serialize(convertRepositoryPolicyBody(p))
 }
 
-// SerializeRepositoryPolicyStatement is the custom marshaller for the RepositoryPolicyStatement
-func SerializeRepositoryPolicyStatement(p v1beta1.RepositoryPolicyStatement) (interface{}, error) { // nolint:gocyclo
-	m := make(map[string]interface{})
-	if p.Principal != nil {
-		principal, err := SerializeRepositoryPrincipal(p.Principal)
-		if err != nil {
-			return nil, err
-		}
-		m["Principal"] = principal
-	}
-	if p.NotPrincipal != nil {
-		notPrincipal, err := SerializeRepositoryPrincipal(p.NotPrincipal)
-		if err != nil {
-			return nil, err
-		}
-		m["NotPrincipal"] = notPrincipal
-	}
-	if checkExistsArray(p.Action) {
-		m["Action"] = tryFirst(p.Action)
-	}
-	if checkExistsArray(p.NotAction) {
-		m["NotAction"] = tryFirst(p.NotAction)
-	}
-	if checkExistsArray(p.Resource) {
-		m["Resource"] = tryFirst(p.Resource)
-	}
-	if checkExistsArray(p.NotResource) {
-		m["NotResource"] = tryFirst(p.NotResource)
-	}
-	if p.Condition != nil {
-		condition, err := SerializeRepositoryCondition(p.Condition)
-		if err != nil {
-			return nil, err
+func convertRepositoryPolicyBody(p *v1beta1.RepositoryPolicyBody) *policyv1alpha1.Document {
+	d := &policyv1alpha1.Document{
+		Version:    p.Version,
+		Statements: make([]policyv1alpha1.Statement, len(p.Statements)),
+	}
+	if awsclient.StringValue(p.ID) != "" {
+		d.ID = p.ID
+	}
+	for i, s := range p.Statements {
+		d.Statements[i] = policyv1alpha1.Statement{
+			SID:          s.SID,
+			Effect:       s.Effect,
+			Principal:    convertRepositoryPrincipal(s.Principal),
+			NotPrincipal: convertRepositoryPrincipal(s.NotPrincipal),
+			Action:       s.Action,
+			NotAction:    s.NotAction,
+			Resource:     s.Resource,
+			NotResource:  s.NotResource,
+			Condition:    convertConditions(s.Condition),
 		}
-		m["Condition"] = condition
 	}
-	m["Effect"] = p.Effect
-	if p.SID != nil {
-		m["Sid"] = *p.SID
-	}
-	return m, nil
+	return d
 }
 
-// SerializeRepositoryPrincipal is the custom serializer for the RepositoryPrincipal
-func SerializeRepositoryPrincipal(p *v1beta1.RepositoryPrincipal) (interface{}, error) {
-	all := "*"
-	if awsclient.BoolValue(p.AllowAnon) {
-		return all, nil
+func convertRepositoryPrincipal(p *v1beta1.RepositoryPrincipal) *policyv1alpha1.Principal {
+	if p == nil {
+		return nil
 	}
-	m := make(map[string]interface{})
-	if p.Service != nil {
-		m["Service"] = tryFirst(p.Service)
+	r := &policyv1alpha1.Principal{
+		AllowAnon: awsclient.BoolValue(p.AllowAnon),
+		Service:   p.Service,
 	}
-
-	if len(p.AWSPrincipals) == 1 {
-		m["AWS"] = awsclient.StringValue(SerializeAWSPrincipal(p.AWSPrincipals[0]))
-	} else if len(p.AWSPrincipals) > 1 {
-		values := make([]interface{}, len(p.AWSPrincipals))
-		for i := range p.AWSPrincipals {
-			values[i] = awsclient.StringValue(SerializeAWSPrincipal(p.AWSPrincipals[i]))
-		}
-		m["AWS"] = values
+	for _, a := range p.AWSPrincipals {
+		r.AWSPrincipals = append(r.AWSPrincipals, convertAWSPrincipal(a))
 	}
-	return m, nil
+	return r
 }
 
-// SerializeAWSPrincipal converts an AWSPrincipal to a string
-func SerializeAWSPrincipal(p v1beta1.AWSPrincipal) *string {
-	switch {
-	case p.AWSAccountID != nil:
-		// Note: AWS Docs say you can specify the account ID either
-		// raw or as an ARN, but AWS actually converts internally to
-		// the ARN format, which is problematic for checking if we're
-		// up to date. So here we just do the conversion ourselves if
-		// we were given a string containing a number that looks like an
-		// AWS account ID (looks like a 12-digit integer).
+func convertAWSPrincipal(p v1beta1.AWSPrincipal) policyv1alpha1.AWSPrincipal {
+	r := policyv1alpha1.AWSPrincipal(p)
+	// Note: AWS Docs say you can specify the account ID either raw or as an
+	// ARN, but AWS actually converts internally to the ARN format, which is
+	// problematic for checking if we're up to date. So here we just do the
+	// conversion ourselves if we were given a string containing a number that
+	// looks like an AWS account ID (looks like a 12-digit integer).
+	if p.AWSAccountID != nil {
 		if _, err := strconv.ParseInt(*p.AWSAccountID, 10, 64); err == nil {
-			s := fmt.Sprintf("arn:aws:iam::%s:root", *p.AWSAccountID)
-			return &s
+			r.AWSAccountID = awsclient.String(fmt.Sprintf("arn:aws:iam::%s:root", *p.AWSAccountID))
 		}
-		return p.AWSAccountID
-	case p.IAMRoleARN != nil:
-		return p.IAMRoleARN
-	case p.UserARN != nil:
-		return p.UserARN
-	default:
-		return nil
 	}
+	return r
 }
 
-// SerializeRepositoryCondition converts the string -> Condition map
-// into a serialized version
-func SerializeRepositoryCondition(p []v1beta1.Condition) (interface{}, error) {
-	m := make(map[string]interface{})
-	for _, v := range p {
-		subMap := make(map[string]interface{})
-		for _, c := range v.Conditions {
-			switch {
-			case c.ConditionStringValue != nil:
-				subMap[c.ConditionKey] = *c.ConditionStringValue
-			case c.ConditionBooleanValue != nil:
-				subMap[c.ConditionKey] = *c.ConditionBooleanValue
-			case c.ConditionNumericValue != nil:
-				subMap[c.ConditionKey] = *c.ConditionNumericValue
-			case c.ConditionDateValue != nil:
-				subMap[c.ConditionKey] = c.ConditionDateValue.Time.Format("2006-01-02T15:04:05-0700")
-			case c.ConditionListValue != nil:
-				subMap[c.ConditionKey] = c.ConditionListValue
-			default:
-				return nil, fmt.Errorf("no value provided for key with value %s, condition %s", c.ConditionKey, v.OperatorKey)
-			}
-		}
-		m[v.OperatorKey] = subMap
+func convertConditions(c []v1beta1.Condition) []policyv1alpha1.Condition {
+	if c == nil {
+		return nil
 	}
-	return m, nil
-}
-
-func checkExistsArray(slc []string) bool {
-	return len(slc) != 0
-}
-
-func tryFirst(slc []string) interface{} {
-	if len(slc) == 1 {
-		return slc[0]
+	r := make([]policyv1alpha1.Condition, len(c))
+	for i := range c {
+		r[i] = policyv1alpha1.Condition{
+			OperatorKey: c[i].OperatorKey,
+			Conditions:  make([]policyv1alpha1.ConditionPair, len(c[i].Conditions)),
+		}
+		for j := range c[i].Conditions {
+			r[i].Conditions[j] = policyv1alpha1.ConditionPair(c[i].Conditions[j])
+		}
 	}
-	return slc
+	return r
 }
 
 // RawPolicyData parses and formats the RepositoryPolicy struct
@@ -208,17 +137,7 @@ func RawPolicyData(original *v1beta1.RepositoryPolicy) (string, error) {
 	case original.Spec.ForProvider.RawPolicy != nil:
 		return *original.Spec.ForProvider.RawPolicy, nil
 	case original.Spec.ForProvider.Policy != nil:
-		c := original.DeepCopy()
-		body, err := Serialize(c.Spec.ForProvider.Policy)
-		if err != nil {
-			return "", err
-		}
-		byteData, err := json.Marshal(body)
-		if err != nil {
-			return "", err
-		}
-		str := string(byteData)
-		return str, nil
+		return Serialize(original.Spec.ForProvider.Policy)
 	}
 	return "", errors.New(errNotSpecified)
 }
@@ -90,7 +90,7 @@ func repositoryPolicy(m ...repositoryPolicyModifier) *v1beta1.RepositoryPolicy {
 	return cr
 }
 
-func TestSerializeRepositoryPolicyStatement(t *testing.T) {
+func TestSerialize(t *testing.T) {
 	cases := map[string]struct {
 		in  v1beta1.RepositoryPolicyStatement
 		out string
@@ -149,22 +149,23 @@ func TestSerializeRepositoryPolicyStatement(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			js, err := SerializeRepositoryPolicyStatement(tc.in)
+			got, err := Serialize(&v1beta1.RepositoryPolicyBody{Version: "2012-10-17", Statements: []v1beta1.RepositoryPolicyStatement{tc.in}})
 
 			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 				return
 			}
 
-			var out interface{}
-			err = json.Unmarshal([]byte(tc.out), &out)
-			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("r: -want, +got:\n%s", diff)
-				return
+			var js, out interface{}
+			if err := json.Unmarshal([]byte(got), &js); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(`{"Version":"2012-10-17","Statement":[`+tc.out+`]}`), &out); err != nil {
+				t.Fatal(err)
 			}
 
-			if diff := cmp.Diff(js, out); diff != "" {
-				t.Errorf("SerializeRepositoryPolicyStatement(...): -want, +got\n:%s", diff)
+			if diff := cmp.Diff(out, js); diff != "" {
+				t.Errorf("Serialize(...): -want, +got\n:%s", diff)
 			}
 		})
 	}
@@ -2,7 +2,6 @@ package iam
 
 import (
 	"context"
-	"net/url"
 
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 
@@ -10,9 +9,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
-	"github.com/google/go-cmp/cmp"
 
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
 )
 
 // PolicyClient is the external client used for Policy Custom Resource
@@ -43,30 +42,27 @@ func NewSTSClient(cfg aws.Config) STSClient {
 	return sts.NewFromConfig(cfg)
 }
 
+// GeneratePolicyDocument returns the JSON policy document of the supplied policy
+// parameters. It serializes DocumentBody if Document is not set.
+func GeneratePolicyDocument(in v1beta1.PolicyParameters) (string, error) {
+	doc, err := policyclient.Render(awsclients.String(in.Document), in.DocumentBody)
+	return aws.ToString(doc), err
+}
+
 // IsPolicyUpToDate checks whether there is a change in any of the modifiable fields in policy.
 func IsPolicyUpToDate(in v1beta1.PolicyParameters, policy iamtypes.PolicyVersion) (bool, error) {
 	// The AWS API returns Policy Document as an escaped string.
 	// Due to differences in the methods to escape a string, the comparison result between
 	// the spec.Document and policy.Document can sometimes be false negative (due to spaces, line feeds).
-	// Escaping with a common method and then comparing is a safe way.
-
-	if *policy.Document == "" || in.Document == "" {
-		return false, nil
-	}
-
-	unescapedPolicy, err := url.QueryUnescape(aws.ToString(policy.Document))
-	if err != nil {
-		return false, nil
-	}
-
-	compactPolicy, err := awsclients.CompactAndEscapeJSON(unescapedPolicy)
+	// Comparing the unescaped documents semantically is a safe way.
+	doc, err := GeneratePolicyDocument(in)
 	if err != nil {
 		return false, err
 	}
-	compactSpecPolicy, err := awsclients.CompactAndEscapeJSON(in.Document)
-	if err != nil {
-		return false, err
+
+	if aws.ToString(policy.Document) == "" || doc == "" {
+		return false, nil
 	}
 
-	return cmp.Equal(compactPolicy, compactSpecPolicy), nil
+	return policyclient.Equal(doc, aws.ToString(policy.Document)), nil
 }
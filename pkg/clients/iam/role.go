@@ -19,12 +19,14 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
 )
 
 const (
-	errCheckUpToDate      = "unable to determine if external resource is up to date"
-	errPolicyJSONEscape   = "malformed AssumeRolePolicyDocument JSON"
-	errPolicyJSONUnescape = "malformed AssumeRolePolicyDocument escaping"
+	errCheckUpToDate       = "unable to determine if external resource is up to date"
+	errPolicyJSONEscape    = "malformed AssumeRolePolicyDocument JSON"
+	errPolicyJSONUnescape  = "malformed AssumeRolePolicyDocument escaping"
+	errPolicyJSONSerialize = "cannot serialize AssumeRolePolicyDocumentBody"
 )
 
 // RoleClient is the external client used for Role Custom Resource
@@ -43,11 +45,23 @@ func NewRoleClient(conf aws.Config) RoleClient {
 	return iam.NewFromConfig(conf)
 }
 
+// GenerateAssumeRolePolicyDocument returns the JSON trust relationship policy
+// document of the supplied role parameters. It serializes
+// AssumeRolePolicyDocumentBody if AssumeRolePolicyDocument is not set.
+func GenerateAssumeRolePolicyDocument(in v1beta1.RoleParameters) (string, error) {
+	doc, err := policyclient.Render(awsclients.String(in.AssumeRolePolicyDocument), in.AssumeRolePolicyDocumentBody)
+	return aws.ToString(doc), errors.Wrap(err, errPolicyJSONSerialize)
+}
+
 // GenerateCreateRoleInput from RoleSpec
-func GenerateCreateRoleInput(name string, p *v1beta1.RoleParameters) *iam.CreateRoleInput {
+func GenerateCreateRoleInput(name string, p *v1beta1.RoleParameters) (*iam.CreateRoleInput, error) {
+	doc, err := GenerateAssumeRolePolicyDocument(*p)
+	if err != nil {
+		return nil, err
+	}
 	m := &iam.CreateRoleInput{
 		RoleName:                 aws.String(name),
-		AssumeRolePolicyDocument: aws.String(p.AssumeRolePolicyDocument),
+		AssumeRolePolicyDocument: aws.String(doc),
 		Description:              p.Description,
 		MaxSessionDuration:       p.MaxSessionDuration,
 		Path:                     p.Path,
@@ -64,7 +78,7 @@ func GenerateCreateRoleInput(name string, p *v1beta1.RoleParameters) *iam.Create
 		}
 	}
 
-	return m
+	return m, nil
 }
 
 // GenerateRoleObservation is used to produce RoleExternalStatus from iamtypes.Role
@@ -77,9 +91,12 @@ func GenerateRoleObservation(role iamtypes.Role) v1beta1.RoleExternalStatus {
 
 // GenerateRole assigns the in RoleParamters to role.
 func GenerateRole(in v1beta1.RoleParameters, role *iamtypes.Role) error {
-
-	if in.AssumeRolePolicyDocument != "" {
-		s, err := awsclients.CompactAndEscapeJSON(in.AssumeRolePolicyDocument)
+	doc, err := GenerateAssumeRolePolicyDocument(in)
+	if err != nil {
+		return err
+	}
+	if doc != "" {
+		s, err := awsclients.CompactAndEscapeJSON(doc)
 		if err != nil {
 			return errors.Wrap(err, errPolicyJSONEscape)
 		}
@@ -108,7 +125,9 @@ func LateInitializeRole(in *v1beta1.RoleParameters, role *iamtypes.Role) {
 	if role == nil {
 		return
 	}
-	in.AssumeRolePolicyDocument = awsclients.LateInitializeString(in.AssumeRolePolicyDocument, role.AssumeRolePolicyDocument)
+	if in.AssumeRolePolicyDocumentBody == nil {
+		in.AssumeRolePolicyDocument = awsclients.LateInitializeString(in.AssumeRolePolicyDocument, role.AssumeRolePolicyDocument)
+	}
 	in.Description = awsclients.LateInitializeStringPtr(in.Description, role.Description)
 	in.MaxSessionDuration = awsclients.LateInitializeInt32Ptr(in.MaxSessionDuration, role.MaxSessionDuration)
 	in.Path = awsclients.LateInitializeStringPtr(in.Path, role.Path)
@@ -157,7 +176,7 @@ func isAssumeRolePolicyUpToDate(a, b *string) (bool, error) {
 		return false, errors.Wrap(err, errPolicyJSONUnescape)
 	}
 
-	return awsclients.IsPolicyUpToDate(&jsonA, &jsonB), nil
+	return policyclient.Equal(jsonA, jsonB), nil
 }
 
 // IsRoleUpToDate checks whether there is a change in any of the modifiable fields in role.
@@ -14,6 +14,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	policyv1alpha1 "github.com/crossplane/provider-aws/apis/policy/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
@@ -121,11 +122,33 @@ func TestGenerateCreateRoleInput(t *testing.T) {
 				MaxSessionDuration:       aws.Int32(1),
 			},
 		},
+		"TypedDocument": {
+			in: *roleParams(func(p *v1beta1.RoleParameters) {
+				p.AssumeRolePolicyDocument = ""
+				p.AssumeRolePolicyDocumentBody = &policyv1alpha1.Document{
+					Version: "2012-10-17",
+					Statements: []policyv1alpha1.Statement{{
+						Effect:    "Allow",
+						Principal: &policyv1alpha1.Principal{Service: []string{"eks.amazonaws.com"}},
+						Action:    []string{"sts:AssumeRole"},
+					}},
+				}
+			}),
+			out: iam.CreateRoleInput{
+				RoleName:                 aws.String(roleName),
+				Description:              &description,
+				AssumeRolePolicyDocument: aws.String(`{"Statement":[{"Action":"sts:AssumeRole","Effect":"Allow","Principal":{"Service":"eks.amazonaws.com"}}],"Version":"2012-10-17"}`),
+				MaxSessionDuration:       aws.Int32(1),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := GenerateCreateRoleInput(roleName, &tc.in)
+			r, err := GenerateCreateRoleInput(roleName, &tc.in)
+			if err != nil {
+				t.Fatalf("GenerateCreateRoleInput(...): %s", err)
+			}
 			if diff := cmp.Diff(r, &tc.out, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
 				t.Errorf("GenerateNetworkObservation(...): -want, +got:\n%s", diff)
 			}
@@ -0,0 +1,160 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsMiddlewareID = "crossplane.Metrics"
+
+	// errorCodeUnknown is the error code of calls that failed without an
+	// error code, e.g. because the service could not be reached.
+	errorCodeUnknown = "Unknown"
+)
+
+var metricLabels = []string{"service", "operation", "region", "status_code", "error_code"}
+
+var (
+	apiRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "provider_aws",
+		Subsystem: "api",
+		Name:      "requests_total",
+		Help:      "Number of requests made to AWS APIs, including retries.",
+	}, metricLabels)
+
+	apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "provider_aws",
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of the requests made to AWS APIs, including retries.",
+		Buckets:   prometheus.DefBuckets,
+	}, metricLabels)
+)
+
+func init() {
+	metrics.Registry.MustRegister(apiRequests, apiRequestDuration)
+}
+
+// apiRequest is the outcome of a request made to an AWS API.
+type apiRequest struct {
+	service    string
+	operation  string
+	region     string
+	statusCode int
+	errorCode  string
+	duration   time.Duration
+}
+
+func (r apiRequest) record() {
+	status := ""
+	if r.statusCode != 0 {
+		status = strconv.Itoa(r.statusCode)
+	}
+	l := prometheus.Labels{
+		"service":     r.service,
+		"operation":   r.operation,
+		"region":      r.region,
+		"status_code": status,
+		"error_code":  r.errorCode,
+	}
+	apiRequests.With(l).Inc()
+	apiRequestDuration.With(l).Observe(r.duration.Seconds())
+}
+
+// withMetrics records every attempt of the calls made with the supplied
+// config.
+func withMetrics(cfg *aws.Config) {
+	mw := middleware.FinalizeMiddlewareFunc(metricsMiddlewareID, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		start := time.Now()
+		out, md, err := next.HandleFinalize(ctx, in)
+		r := apiRequest{
+			service:   awsmiddleware.GetServiceID(ctx),
+			operation: awsmiddleware.GetOperationName(ctx),
+			region:    awsmiddleware.GetRegion(ctx),
+			errorCode: errorCode(err),
+			duration:  time.Since(start),
+		}
+		if resp, ok := awsmiddleware.GetRawResponse(md).(*smithyhttp.Response); ok {
+			r.statusCode = resp.StatusCode
+		}
+		var respErr *smithyhttp.ResponseError
+		if errors.As(err, &respErr) {
+			r.statusCode = respErr.HTTPStatusCode()
+		}
+		r.record()
+		return out, md, err
+	})
+	// The middleware is added after the retry middleware so that every
+	// attempt of a call is recorded.
+	cfg.APIOptions = append(cfg.APIOptions, func(s *middleware.Stack) error {
+		return s.Finalize.Add(mw, middleware.After)
+	})
+}
+
+func errorCode(err error) string {
+	if err == nil {
+		return ""
+	}
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+	return errorCodeUnknown
+}
+
+// withMetricsV1 records every attempt of the calls made with the supplied
+// session the same way withMetrics does for configs.
+func withMetricsV1(sess *session.Session) {
+	sess.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{Name: metricsMiddlewareID, Fn: func(r *request.Request) {
+		req := apiRequest{
+			service:  r.ClientInfo.ServiceID,
+			region:   awsv1.StringValue(r.Config.Region),
+			duration: time.Since(r.AttemptTime),
+		}
+		if r.Operation != nil {
+			req.operation = r.Operation.Name
+		}
+		if r.HTTPResponse != nil {
+			req.statusCode = r.HTTPResponse.StatusCode
+		}
+		if r.Error != nil {
+			req.errorCode = errorCodeUnknown
+			var awsErr awserr.Error
+			if errors.As(r.Error, &awsErr) {
+				req.errorCode = awsErr.Code()
+			}
+		}
+		req.record()
+	}})
+}
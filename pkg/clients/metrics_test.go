@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	stsv1 "github.com/aws/aws-sdk-go/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+const stsThrottlingResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>Throttling</Code>
+    <Message>Rate exceeded</Message>
+  </Error>
+  <RequestId>1</RequestId>
+</ErrorResponse>`
+
+func throttlingServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(stsThrottlingResponse))
+	}))
+}
+
+func TestWithMetrics(t *testing.T) {
+	srv := throttlingServer()
+	defer srv.Close()
+
+	cfg := aws.Config{
+		Region:      "us-east-1",
+		Credentials: credentials.NewStaticCredentialsProvider("id", "secret", ""),
+		EndpointResolver: aws.EndpointResolverFunc(func(_, _ string) (aws.Endpoint, error) {
+			return aws.Endpoint{URL: srv.URL}, nil
+		}),
+	}
+	withRetryOptions(&cfg, "metrics", RetryOptions{MaxRetries: 1})
+	withMetrics(&cfg)
+
+	l := prometheus.Labels{"service": "STS", "operation": "GetCallerIdentity", "region": "us-east-1", "status_code": "400", "error_code": "Throttling"}
+	before := testutil.ToFloat64(apiRequests.With(l))
+	if _, err := sts.NewFromConfig(cfg).GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{}); err == nil {
+		t.Fatal("GetCallerIdentity(...): expected a throttling error")
+	}
+	// Every attempt of the call should be recorded.
+	if diff := cmp.Diff(2.0, testutil.ToFloat64(apiRequests.With(l))-before); diff != "" {
+		t.Errorf("withMetrics(...): -want requests, +got requests:\n%s", diff)
+	}
+}
+
+func TestWithMetricsV1(t *testing.T) {
+	srv := throttlingServer()
+	defer srv.Close()
+
+	cfg := awsv1.NewConfig().
+		WithRegion("us-east-1").
+		WithEndpoint(srv.URL).
+		WithCredentials(credentialsv1.NewStaticCredentials("id", "secret", ""))
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	withRetryOptionsV1(sess, RetryOptions{MaxRetries: 1})
+	withMetricsV1(sess)
+
+	l := prometheus.Labels{"service": "STS", "operation": "GetCallerIdentity", "region": "us-east-1", "status_code": "400", "error_code": "Throttling"}
+	before := testutil.ToFloat64(apiRequests.With(l))
+	if _, err := stsv1.New(sess).GetCallerIdentity(&stsv1.GetCallerIdentityInput{}); err == nil {
+		t.Fatal("GetCallerIdentity(...): expected a throttling error")
+	}
+	if diff := cmp.Diff(2.0, testutil.ToFloat64(apiRequests.With(l))-before); diff != "" {
+		t.Errorf("withMetricsV1(...): -want requests, +got requests:\n%s", diff)
+	}
+}
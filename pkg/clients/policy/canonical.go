@@ -0,0 +1,297 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	errUnmarshal = "cannot unmarshal policy document"
+
+	// defaultVersion is the version of the policy language AWS assumes if a
+	// policy document does not specify one.
+	defaultVersion = "2008-10-17"
+
+	// currentVersion is the current version of the policy language.
+	currentVersion = "2012-10-17"
+)
+
+// accountRootARN matches the ARN AWS rewrites an AWS account ID principal to.
+var accountRootARN = regexp.MustCompile(`^arn:[^:]+:iam::(\d{12}):root$`)
+
+// Canonicalize returns the canonical JSON form of the supplied policy
+// document, which may be URL encoded. Two policy documents that AWS treats
+// the same have the same canonical form. Statements are a set, so their order
+// and duplicates are ignored. Action, NotAction, Resource and NotResource are
+// sets too, and a single string is the same as a list with that string.
+// Actions and condition keys are not case sensitive. A "*" principal is the
+// same as {"AWS": "*"}, and an AWS account ID principal is the same as the
+// ARN of the root user of the account, which AWS rewrites it to. Condition
+// values are sets of strings, so true is the same as "true". A missing
+// version is version 2008-10-17, and an empty Sid is no Sid. Unknown elements
+// are only normalized by ignoring the order of lists of scalars, and empty
+// lists and maps.
+func Canonicalize(doc string) (string, error) {
+	u, err := Unescape(doc)
+	if err != nil {
+		return "", err
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(u), &v); err != nil {
+		return "", errors.Wrap(err, errUnmarshal)
+	}
+	b, err := json.Marshal(canonicalDocument(v))
+	return string(b), errors.Wrap(err, errMarshal)
+}
+
+func canonicalDocument(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return normalize(v)
+	}
+	out := map[string]interface{}{}
+	for k, val := range m {
+		switch k {
+		case "Statement":
+			out[k] = canonicalStatements(val)
+		default:
+			out[k] = normalize(val)
+		}
+	}
+	if _, ok := out["Statement"]; ok && out["Version"] == nil {
+		out["Version"] = defaultVersion
+	}
+	return prune(out)
+}
+
+func canonicalStatements(v interface{}) interface{} {
+	var l []interface{}
+	switch s := v.(type) {
+	case map[string]interface{}:
+		l = []interface{}{s}
+	case []interface{}:
+		l = s
+	default:
+		return normalize(v)
+	}
+	out := make([]interface{}, len(l))
+	for i := range l {
+		out[i] = canonicalStatement(l[i])
+	}
+	return set(out)
+}
+
+func canonicalStatement(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return normalize(v)
+	}
+	out := map[string]interface{}{}
+	for k, val := range m {
+		switch k {
+		case "Sid":
+			if val != "" {
+				out[k] = val
+			}
+		case "Action", "NotAction":
+			out[k] = stringSet(val, strings.ToLower)
+		case "Resource", "NotResource":
+			out[k] = stringSet(val, nil)
+		case "Principal", "NotPrincipal":
+			out[k] = canonicalPrincipal(val)
+		case "Condition":
+			out[k] = canonicalCondition(val)
+		default:
+			out[k] = normalize(val)
+		}
+	}
+	return prune(out)
+}
+
+func canonicalPrincipal(v interface{}) interface{} {
+	if v == "*" {
+		return map[string]interface{}{"AWS": []interface{}{"*"}}
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return normalize(v)
+	}
+	out := map[string]interface{}{}
+	for k, val := range m {
+		if k == "AWS" {
+			out[k] = stringSet(val, func(s string) string {
+				if match := accountRootARN.FindStringSubmatch(s); match != nil {
+					return match[1]
+				}
+				return s
+			})
+			continue
+		}
+		out[k] = stringSet(val, nil)
+	}
+	return prune(out)
+}
+
+func canonicalCondition(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return normalize(v)
+	}
+	out := map[string]interface{}{}
+	for op, val := range m {
+		keys, ok := val.(map[string]interface{})
+		if !ok {
+			out[op] = normalize(val)
+			continue
+		}
+		c := map[string]interface{}{}
+		for k, values := range keys {
+			c[strings.ToLower(k)] = conditionValues(values)
+		}
+		out[op] = prune(c)
+	}
+	return prune(out)
+}
+
+// conditionValues returns the supplied condition values as a set of strings.
+func conditionValues(v interface{}) interface{} {
+	l, ok := v.([]interface{})
+	if !ok {
+		l = []interface{}{v}
+	}
+	out := make([]interface{}, len(l))
+	for i := range l {
+		switch s := l[i].(type) {
+		case string:
+			out[i] = s
+		case bool:
+			out[i] = strconv.FormatBool(s)
+		case float64:
+			out[i] = strconv.FormatFloat(s, 'f', -1, 64)
+		default:
+			return normalize(v)
+		}
+	}
+	return set(out)
+}
+
+// stringSet returns the supplied string or list of strings as a set of
+// strings, each transformed by the supplied function if it is not nil.
+func stringSet(v interface{}, fn func(string) string) interface{} {
+	l, ok := v.([]interface{})
+	if !ok {
+		l = []interface{}{v}
+	}
+	out := make([]interface{}, len(l))
+	for i := range l {
+		s, ok := l[i].(string)
+		if !ok {
+			return normalize(v)
+		}
+		if fn != nil {
+			s = fn(s)
+		}
+		out[i] = s
+	}
+	return set(out)
+}
+
+// normalize returns the supplied JSON value with lists of scalars sorted and
+// empty lists and maps removed.
+func normalize(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			out[k] = normalize(val)
+		}
+		return prune(out)
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		scalars := true
+		for i := range t {
+			out[i] = normalize(t[i])
+			switch out[i].(type) {
+			case map[string]interface{}, []interface{}:
+				scalars = false
+			}
+		}
+		if scalars {
+			sortByJSON(out)
+		}
+		return out
+	}
+	return v
+}
+
+// set returns the supplied list sorted and without duplicates.
+func set(l []interface{}) []interface{} {
+	keys := sortByJSON(l)
+	out := l[:0]
+	for i := range l {
+		if i > 0 && keys[i] == keys[i-1] {
+			continue
+		}
+		out = append(out, l[i])
+	}
+	return out
+}
+
+// sortByJSON sorts the supplied list by the JSON form of its elements, which
+// it returns in the same order.
+func sortByJSON(l []interface{}) []string {
+	type entry struct {
+		key   string
+		value interface{}
+	}
+	e := make([]entry, len(l))
+	for i := range l {
+		b, _ := json.Marshal(l[i])
+		e[i] = entry{key: string(b), value: l[i]}
+	}
+	sort.SliceStable(e, func(i, j int) bool { return e[i].key < e[j].key })
+	keys := make([]string, len(l))
+	for i := range e {
+		l[i] = e[i].value
+		keys[i] = e[i].key
+	}
+	return keys
+}
+
+// prune removes the empty lists and maps from the supplied map.
+func prune(m map[string]interface{}) map[string]interface{} {
+	for k, v := range m {
+		switch t := v.(type) {
+		case []interface{}:
+			if len(t) == 0 {
+				delete(m, k)
+			}
+		case map[string]interface{}:
+			if len(t) == 0 {
+				delete(m, k)
+			}
+		}
+	}
+	return m
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestCanonicalize(t *testing.T) {
+	type want struct {
+		doc string
+		err error
+	}
+
+	cases := map[string]struct {
+		doc  string
+		want want
+	}{
+		"Statement": {
+			doc: `{"Statement":{"Sid":"","Effect":"Allow","Principal":"*","Action":["S3:GetObject","s3:getobject"],"Resource":"*","Condition":{"Bool":{"aws:SecureTransport":true}}}}`,
+			want: want{
+				doc: `{"Statement":[{"Action":["s3:getobject"],"Condition":{"Bool":{"aws:securetransport":["true"]}},"Effect":"Allow","Principal":{"AWS":["*"]},"Resource":["*"]}],"Version":"2008-10-17"}`,
+			},
+		},
+		"AccountPrincipal": {
+			doc: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111122223333:root","111122223333"]},"Action":"sts:AssumeRole"}]}`,
+			want: want{
+				doc: `{"Statement":[{"Action":["sts:assumerole"],"Effect":"Allow","Principal":{"AWS":["111122223333"]}}],"Version":"2012-10-17"}`,
+			},
+		},
+		"Unmarshal": {
+			doc: `{"Version":`,
+			want: want{
+				err: errors.Wrap(json.Unmarshal([]byte(`{"Version":`), new(interface{})), errUnmarshal),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Canonicalize(tc.doc)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Canonicalize(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.doc, got); diff != "" {
+				t.Errorf("Canonicalize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
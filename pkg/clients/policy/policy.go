@@ -0,0 +1,362 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package policy serializes and compares the AWS policy documents of managed
+// resources.
+package policy
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/policy/v1alpha1"
+)
+
+// BucketNotificationSIDPrefix prefixes the Sid of the statements Buckets add
+// to the policies of the queues, topics and functions they publish
+// notifications to. Those statements are managed by the Buckets, so the
+// controllers of the targets leave them alone.
+const BucketNotificationSIDPrefix = "S3BucketNotification"
+
+const (
+	errNoConditionValue = "no value provided for key %s of condition %s"
+	errNoResourceARN    = "the arn of resourceFrom[%d] is not resolved"
+	errMarshal          = "cannot marshal policy document"
+	errUnescape         = "cannot unescape policy document"
+)
+
+// Serialize returns the JSON form of the supplied Document.
+func Serialize(d *v1alpha1.Document) (string, error) {
+	m := map[string]interface{}{"Version": d.Version}
+	if aws.ToString(d.ID) != "" {
+		m["Id"] = aws.ToString(d.ID)
+	}
+	statements := make([]interface{}, len(d.Statements))
+	for i := range d.Statements {
+		s, err := serializeStatement(d.Statements[i])
+		if err != nil {
+			return "", errors.Wrapf(err, "statements[%d]", i)
+		}
+		statements[i] = s
+	}
+	m["Statement"] = statements
+	b, err := json.Marshal(m)
+	return string(b), errors.Wrap(err, errMarshal)
+}
+
+func serializeStatement(s v1alpha1.Statement) (map[string]interface{}, error) { // nolint:gocyclo
+	m := map[string]interface{}{"Effect": s.Effect}
+	if s.SID != nil {
+		m["Sid"] = *s.SID
+	}
+	if s.Principal != nil {
+		m["Principal"] = serializePrincipal(s.Principal)
+	}
+	if s.NotPrincipal != nil {
+		m["NotPrincipal"] = serializePrincipal(s.NotPrincipal)
+	}
+	if len(s.Action) != 0 {
+		m["Action"] = tryFirst(s.Action)
+	}
+	if len(s.NotAction) != 0 {
+		m["NotAction"] = tryFirst(s.NotAction)
+	}
+	resources := append([]string{}, s.Resource...)
+	for i, r := range s.ResourceFrom {
+		if aws.ToString(r.ARN) == "" {
+			return nil, errors.Errorf(errNoResourceARN, i)
+		}
+		resources = append(resources, aws.ToString(r.ARN)+r.Suffix)
+	}
+	if len(resources) != 0 {
+		m["Resource"] = tryFirst(resources)
+	}
+	if len(s.NotResource) != 0 {
+		m["NotResource"] = tryFirst(s.NotResource)
+	}
+	if len(s.Condition) != 0 {
+		c, err := serializeCondition(s.Condition)
+		if err != nil {
+			return nil, err
+		}
+		m["Condition"] = c
+	}
+	return m, nil
+}
+
+func serializePrincipal(p *v1alpha1.Principal) interface{} {
+	if p.AllowAnon {
+		return "*"
+	}
+	m := map[string]interface{}{}
+	if len(p.Service) != 0 {
+		m["Service"] = tryFirst(p.Service)
+	}
+	if p.Federated != nil {
+		m["Federated"] = aws.ToString(p.Federated)
+	}
+	if len(p.AWSPrincipals) != 0 {
+		principals := make([]string, len(p.AWSPrincipals))
+		for i := range p.AWSPrincipals {
+			principals[i] = serializeAWSPrincipal(p.AWSPrincipals[i])
+		}
+		m["AWS"] = tryFirst(principals)
+	}
+	return m
+}
+
+func serializeAWSPrincipal(p v1alpha1.AWSPrincipal) string {
+	switch {
+	case p.AWSAccountID != nil:
+		return aws.ToString(p.AWSAccountID)
+	case p.IAMRoleARN != nil:
+		return aws.ToString(p.IAMRoleARN)
+	default:
+		return aws.ToString(p.UserARN)
+	}
+}
+
+func serializeCondition(conditions []v1alpha1.Condition) (map[string]interface{}, error) {
+	m := map[string]interface{}{}
+	for _, c := range conditions {
+		pairs := map[string]interface{}{}
+		for _, p := range c.Conditions {
+			switch {
+			case p.ConditionStringValue != nil:
+				pairs[p.ConditionKey] = *p.ConditionStringValue
+			case p.ConditionBooleanValue != nil:
+				pairs[p.ConditionKey] = *p.ConditionBooleanValue
+			case p.ConditionNumericValue != nil:
+				pairs[p.ConditionKey] = *p.ConditionNumericValue
+			case p.ConditionDateValue != nil:
+				pairs[p.ConditionKey] = p.ConditionDateValue.Time.Format("2006-01-02T15:04:05-0700")
+			case p.ConditionListValue != nil:
+				pairs[p.ConditionKey] = p.ConditionListValue
+			default:
+				return nil, errors.Errorf(errNoConditionValue, p.ConditionKey, c.OperatorKey)
+			}
+		}
+		m[c.OperatorKey] = pairs
+	}
+	return m, nil
+}
+
+func tryFirst(s []string) interface{} {
+	if len(s) == 1 {
+		return s[0]
+	}
+	return s
+}
+
+// Render returns the supplied raw policy document, or the JSON form of the
+// supplied typed Document if raw is nil. It returns nil if both are nil.
+func Render(raw *string, d *v1alpha1.Document) (*string, error) {
+	if raw != nil || d == nil {
+		return raw, nil
+	}
+	s, err := Serialize(d)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// SetStatement returns the supplied JSON policy document with the supplied
+// Statement added to it, replacing any statement with the same Sid. The
+// document may be empty or URL encoded. SetStatement also returns false if
+// the document already contains an equivalent statement, in which case the
+// document is returned unchanged.
+func SetStatement(doc string, s v1alpha1.Statement) (string, bool, error) {
+	sm, err := serializeStatement(s)
+	if err != nil {
+		return "", false, err
+	}
+	// Round trip the statement so that it has the same types as the
+	// statements of the unmarshalled document.
+	b, err := json.Marshal(sm)
+	if err != nil {
+		return "", false, errors.Wrap(err, errMarshal)
+	}
+	var want interface{}
+	if err := json.Unmarshal(b, &want); err != nil {
+		return "", false, errors.Wrap(err, errUnmarshal)
+	}
+
+	m, statements, err := parseStatements(doc)
+	if err != nil {
+		return "", false, err
+	}
+
+	cw, err := json.Marshal(canonicalStatement(want))
+	if err != nil {
+		return "", false, errors.Wrap(err, errMarshal)
+	}
+	replaced := false
+	for i := range statements {
+		c, err := json.Marshal(canonicalStatement(statements[i]))
+		if err != nil {
+			return "", false, errors.Wrap(err, errMarshal)
+		}
+		if string(c) == string(cw) {
+			return doc, false, nil
+		}
+		if st, ok := statements[i].(map[string]interface{}); ok && s.SID != nil && st["Sid"] == *s.SID {
+			statements[i] = want
+			replaced = true
+		}
+	}
+	if !replaced {
+		statements = append(statements, want)
+	}
+	m["Statement"] = statements
+	b, err = json.Marshal(m)
+	return string(b), true, errors.Wrap(err, errMarshal)
+}
+
+// RemoveStatements returns the supplied JSON policy document without the
+// statements whose Sid starts with the supplied prefix. The document may be
+// URL encoded. An empty document is returned if no other statements remain,
+// and the document is returned unchanged if no statement was removed.
+func RemoveStatements(doc, sidPrefix string) (string, error) {
+	if doc == "" {
+		return "", nil
+	}
+	m, statements, err := parseStatements(doc)
+	if err != nil {
+		return "", err
+	}
+	kept := make([]interface{}, 0, len(statements))
+	for _, st := range statements {
+		if !hasSIDPrefix(st, sidPrefix) {
+			kept = append(kept, st)
+		}
+	}
+	switch {
+	case len(kept) == len(statements):
+		return doc, nil
+	case len(kept) == 0:
+		return "", nil
+	}
+	m["Statement"] = kept
+	b, err := json.Marshal(m)
+	return string(b), errors.Wrap(err, errMarshal)
+}
+
+// CopyStatements returns the dst JSON policy document with the statements of
+// the src document whose Sid starts with the supplied prefix added to it,
+// replacing any statement of dst with the same Sid. Either document may be
+// empty or URL encoded. dst is returned unchanged if there is nothing to
+// copy.
+func CopyStatements(dst, src, sidPrefix string) (string, error) {
+	if src == "" {
+		return dst, nil
+	}
+	_, from, err := parseStatements(src)
+	if err != nil {
+		return "", err
+	}
+	copied := map[interface{}]interface{}{}
+	for _, st := range from {
+		if hasSIDPrefix(st, sidPrefix) {
+			copied[st.(map[string]interface{})["Sid"]] = st
+		}
+	}
+	if len(copied) == 0 {
+		return dst, nil
+	}
+	m, statements, err := parseStatements(dst)
+	if err != nil {
+		return "", err
+	}
+	for i, st := range statements {
+		if sm, ok := st.(map[string]interface{}); ok && copied[sm["Sid"]] != nil {
+			statements[i] = copied[sm["Sid"]]
+			delete(copied, sm["Sid"])
+		}
+	}
+	for _, st := range from {
+		if sm, ok := st.(map[string]interface{}); ok && copied[sm["Sid"]] != nil {
+			statements = append(statements, st)
+		}
+	}
+	m["Statement"] = statements
+	b, err := json.Marshal(m)
+	return string(b), errors.Wrap(err, errMarshal)
+}
+
+// parseStatements returns the supplied JSON policy document, which may be
+// empty or URL encoded, along with its statements.
+func parseStatements(doc string) (map[string]interface{}, []interface{}, error) {
+	m := map[string]interface{}{"Version": currentVersion}
+	if doc != "" {
+		u, err := Unescape(doc)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := json.Unmarshal([]byte(u), &m); err != nil {
+			return nil, nil, errors.Wrap(err, errUnmarshal)
+		}
+	}
+	switch v := m["Statement"].(type) {
+	case []interface{}:
+		return m, v, nil
+	case map[string]interface{}:
+		return m, []interface{}{v}, nil
+	}
+	return m, nil, nil
+}
+
+func hasSIDPrefix(statement interface{}, prefix string) bool {
+	st, ok := statement.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	sid, ok := st["Sid"].(string)
+	return ok && strings.HasPrefix(sid, prefix)
+}
+
+// Unescape returns the supplied policy document with its URL encoding
+// removed. IAM returns the policy documents of policies and roles URL
+// encoded.
+func Unescape(doc string) (string, error) {
+	if strings.HasPrefix(strings.TrimSpace(doc), "{") {
+		return doc, nil
+	}
+	s, err := url.QueryUnescape(doc)
+	return s, errors.Wrap(err, errUnescape)
+}
+
+// Equal returns true if the supplied JSON policy documents have the same
+// canonical form. Either document may be URL encoded. An empty document is
+// only equal to another empty document.
+func Equal(a, b string) bool {
+	if a == "" || b == "" {
+		return a == b
+	}
+	ca, err := Canonicalize(a)
+	if err != nil {
+		return false
+	}
+	cb, err := Canonicalize(b)
+	if err != nil {
+		return false
+	}
+	return ca == cb
+}
@@ -0,0 +1,516 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/policy/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestSerialize(t *testing.T) {
+	cases := map[string]struct {
+		in  v1alpha1.Document
+		out string
+		err error
+	}{
+		"Minimal": {
+			in: v1alpha1.Document{
+				Version: "2012-10-17",
+				Statements: []v1alpha1.Statement{{
+					Effect: "Allow",
+					Action: []string{"s3:GetObject"},
+				}},
+			},
+			out: `{"Statement":[{"Action":"s3:GetObject","Effect":"Allow"}],"Version":"2012-10-17"}`,
+		},
+		"Complex": {
+			in: v1alpha1.Document{
+				Version: "2012-10-17",
+				ID:      awsclient.String("id"),
+				Statements: []v1alpha1.Statement{{
+					SID:    awsclient.String("1"),
+					Effect: "Allow",
+					Principal: &v1alpha1.Principal{
+						AWSPrincipals: []v1alpha1.AWSPrincipal{
+							{IAMRoleARN: awsclient.String("arn:aws:iam::111122223333:role/a")},
+							{AWSAccountID: awsclient.String("111122223333")},
+						},
+					},
+					Action:   []string{"s3:GetObject", "s3:PutObject"},
+					Resource: []string{"arn:aws:s3:::a/*"},
+					ResourceFrom: []v1alpha1.ResourceSource{
+						{ARN: awsclient.String("arn:aws:s3:::b"), Suffix: "/*"},
+					},
+					Condition: []v1alpha1.Condition{{
+						OperatorKey: "Bool",
+						Conditions: []v1alpha1.ConditionPair{
+							{ConditionKey: "aws:SecureTransport", ConditionBooleanValue: awsclient.Bool(true)},
+						},
+					}},
+				}, {
+					Effect:    "Deny",
+					Principal: &v1alpha1.Principal{AllowAnon: true},
+					NotAction: []string{"s3:GetObject"},
+				}},
+			},
+			out: `{"Id":"id","Statement":[{"Action":["s3:GetObject","s3:PutObject"],"Condition":{"Bool":{"aws:SecureTransport":true}},"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111122223333:role/a","111122223333"]},"Resource":["arn:aws:s3:::a/*","arn:aws:s3:::b/*"],"Sid":"1"},{"Effect":"Deny","NotAction":"s3:GetObject","Principal":"*"}],"Version":"2012-10-17"}`,
+		},
+		"UnresolvedResource": {
+			in: v1alpha1.Document{
+				Version: "2012-10-17",
+				Statements: []v1alpha1.Statement{{
+					Effect:       "Allow",
+					ResourceFrom: []v1alpha1.ResourceSource{{Suffix: "/*"}},
+				}},
+			},
+			err: errors.Wrap(errors.Errorf(errNoResourceARN, 0), "statements[0]"),
+		},
+		"MissingConditionValue": {
+			in: v1alpha1.Document{
+				Version: "2012-10-17",
+				Statements: []v1alpha1.Statement{{
+					Effect: "Allow",
+					Condition: []v1alpha1.Condition{{
+						OperatorKey: "StringEquals",
+						Conditions:  []v1alpha1.ConditionPair{{ConditionKey: "aws:SourceAccount"}},
+					}},
+				}},
+			},
+			err: errors.Wrap(errors.Errorf(errNoConditionValue, "aws:SourceAccount", "StringEquals"), "statements[0]"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := Serialize(&tc.in)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.out, got); diff != "" {
+				t.Errorf("Serialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEqual(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"BothEmpty": {
+			args: args{},
+			want: true,
+		},
+		"OneEmpty": {
+			args: args{a: `{"Version":"2012-10-17"}`},
+			want: false,
+		},
+		"Invalid": {
+			args: args{a: `{"Version":`, b: `{"Version":`},
+			want: false,
+		},
+		"SameFields": {
+			args: args{
+				a: `{"testone": "one", "testtwo": "two"}`,
+				b: `{"testtwo": "two", "testone": "one"}`,
+			},
+			want: true,
+		},
+		"DifferentFields": {
+			args: args{
+				a: `{"testone": "one", "testtwo": "two"}`,
+				b: `{"testthree": "three", "testone": "one"}`,
+			},
+			want: false,
+		},
+		"DifferentFormatting": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"]}]}`,
+				b: `{
+					"Statement": [{"Action": ["s3:PutObject", "s3:GetObject"], "Effect": "Allow"}],
+					"Version": "2012-10-17"
+				}`,
+			},
+			want: true,
+		},
+		"URLEncoded": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole"}]}`,
+				b: "%7B%22Version%22%3A%222012-10-17%22%2C%22Statement%22%3A%5B%7B%22Effect%22%3A%22Allow%22%2C%22Action%22%3A%22sts%3AAssumeRole%22%7D%5D%7D",
+			},
+			want: true,
+		},
+		"SingleStatementObject": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"s3:GetObject"}}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"]}]}`,
+			},
+			want: true,
+		},
+		"ReorderedStatements": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject"},{"Effect":"Deny","Action":"s3:DeleteObject"}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:DeleteObject"},{"Effect":"Allow","Action":"s3:GetObject"}]}`,
+			},
+			want: true,
+		},
+		"DuplicateStatements": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject"},{"Effect":"Allow","Action":"s3:GetObject"}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject"}]}`,
+			},
+			want: true,
+		},
+		"ActionCase": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:getobject"}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject"}]}`,
+			},
+			want: true,
+		},
+		"ResourceCase": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/X"}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::a/x"}]}`,
+			},
+			want: false,
+		},
+		"SingleResource": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","NotResource":["arn:aws:s3:::a"]}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","NotResource":"arn:aws:s3:::a"}]}`,
+			},
+			want: true,
+		},
+		"AnonymousPrincipal": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject"}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"s3:GetObject"}]}`,
+			},
+			want: true,
+		},
+		"AccountPrincipal": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["111122223333","arn:aws:iam::111122223333:role/a"]},"Action":"sts:AssumeRole"}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111122223333:role/a","arn:aws:iam::111122223333:root"]},"Action":"sts:AssumeRole"}]}`,
+			},
+			want: true,
+		},
+		"AccountPrincipalOtherPartition": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"111122223333"},"Action":"sts:AssumeRole"}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws-cn:iam::111122223333:root"},"Action":"sts:AssumeRole"}]}`,
+			},
+			want: true,
+		},
+		"DifferentAccountPrincipal": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"111122223333"},"Action":"sts:AssumeRole"}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::111122223334:root"},"Action":"sts:AssumeRole"}]}`,
+			},
+			want: false,
+		},
+		"ServicePrincipalList": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			},
+			want: true,
+		},
+		"ReorderedPrincipals": {
+			args: args{
+				a: `{"Statement":[{"Action":"ecr:ListImages","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::111122223333:userARN","111122223334","arn:aws:iam::111122223333:roleARN"]}}],"Version":"2012-10-17"}`,
+				b: `{"Statement":[{"Action":"ecr:ListImages","Effect":"Allow","Principal":{"AWS":["111122223334","arn:aws:iam::111122223333:userARN","arn:aws:iam::111122223333:roleARN"]}}],"Version":"2012-10-17"}`,
+			},
+			want: true,
+		},
+		"NumericPrincipals": {
+			args: args{
+				a: `{"Statement":[{"Effect":"Allow","Action":"ecr:ListImages","Principal":[2,1,"foo","bar"]}],"Version":"2012-10-17"}`,
+				b: `{"Statement":[{"Effect":"Allow","Action":"ecr:ListImages","Principal":[2,1,"bar","foo"]}],"Version":"2012-10-17"}`,
+			},
+			want: true,
+		},
+		"ConditionScalarTypes": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Condition":{"Bool":{"aws:SecureTransport":false},"NumericLessThan":{"s3:TlsVersion":1.2}}}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Condition":{"Bool":{"aws:SecureTransport":"false"},"NumericLessThan":{"s3:TlsVersion":"1.2"}}}]}`,
+			},
+			want: true,
+		},
+		"ConditionValueList": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Condition":{"StringEquals":{"aws:SourceVpce":["vpce-2","vpce-1"]}}}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Condition":{"StringEquals":{"aws:SourceVpce":["vpce-1","vpce-2"]}}}]}`,
+			},
+			want: true,
+		},
+		"ConditionSingleValue": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Condition":{"StringEquals":{"aws:SourceVpce":["vpce-1"]}}}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Condition":{"StringEquals":{"aws:SourceVpce":"vpce-1"}}}]}`,
+			},
+			want: true,
+		},
+		"ConditionKeyCase": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Condition":{"StringEquals":{"aws:sourcevpce":"vpce-1"}}}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Condition":{"StringEquals":{"aws:SourceVpce":"vpce-1"}}}]}`,
+			},
+			want: true,
+		},
+		"DifferentConditionValue": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Condition":{"StringEquals":{"aws:SourceVpce":"vpce-1"}}}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Condition":{"StringEquals":{"aws:SourceVpce":"VPCE-1"}}}]}`,
+			},
+			want: false,
+		},
+		"MissingVersion": {
+			args: args{
+				a: `{"Statement":[{"Action":"*","Effect":"Allow","Principal":"*","Resource":"*"}]}`,
+				b: `{"Version":"2008-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"*","Resource":"*"}]}`,
+			},
+			want: true,
+		},
+		"DifferentVersion": {
+			args: args{
+				a: `{"Statement":[{"Action":"*","Effect":"Allow","Resource":"*"}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			},
+			want: false,
+		},
+		"EmptySid": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Sid":"","Effect":"Allow","Action":"s3:GetObject"}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject"}]}`,
+			},
+			want: true,
+		},
+		"DifferentEffect": {
+			args: args{
+				a: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject"}]}`,
+				b: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject"}]}`,
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Equal(tc.args.a, tc.args.b)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Equal(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetStatement(t *testing.T) {
+	statement := v1alpha1.Statement{
+		SID:       awsclient.String("AllowBucket"),
+		Effect:    "Allow",
+		Principal: &v1alpha1.Principal{Service: []string{"s3.amazonaws.com"}},
+		Action:    []string{"sqs:SendMessage"},
+		Resource:  []string{"arn:aws:sqs:us-east-1:111122223333:queue"},
+	}
+
+	type args struct {
+		doc string
+		s   v1alpha1.Statement
+	}
+
+	type want struct {
+		doc     string
+		changed bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Empty": {
+			args: args{s: statement},
+			want: want{
+				doc:     `{"Statement":[{"Action":"sqs:SendMessage","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Resource":"arn:aws:sqs:us-east-1:111122223333:queue","Sid":"AllowBucket"}],"Version":"2012-10-17"}`,
+				changed: true,
+			},
+		},
+		"Append": {
+			args: args{
+				doc: `{"Version":"2008-10-17","Statement":{"Effect":"Deny","Action":"sqs:*","Principal":"*"}}`,
+				s:   statement,
+			},
+			want: want{
+				doc:     `{"Statement":[{"Action":"sqs:*","Effect":"Deny","Principal":"*"},{"Action":"sqs:SendMessage","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Resource":"arn:aws:sqs:us-east-1:111122223333:queue","Sid":"AllowBucket"}],"Version":"2008-10-17"}`,
+				changed: true,
+			},
+		},
+		"Equivalent": {
+			args: args{
+				doc: `{"Version":"2012-10-17","Statement":[{"Sid":"AllowBucket","Effect":"Allow","Principal":{"Service":["s3.amazonaws.com"]},"Action":["sqs:sendmessage"],"Resource":"arn:aws:sqs:us-east-1:111122223333:queue"}]}`,
+				s:   statement,
+			},
+			want: want{
+				doc: `{"Version":"2012-10-17","Statement":[{"Sid":"AllowBucket","Effect":"Allow","Principal":{"Service":["s3.amazonaws.com"]},"Action":["sqs:sendmessage"],"Resource":"arn:aws:sqs:us-east-1:111122223333:queue"}]}`,
+			},
+		},
+		"ReplaceSameSid": {
+			args: args{
+				doc: `{"Version":"2012-10-17","Statement":[{"Sid":"AllowBucket","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"arn:aws:sqs:us-east-1:111122223333:old"}]}`,
+				s:   statement,
+			},
+			want: want{
+				doc:     `{"Statement":[{"Action":"sqs:SendMessage","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Resource":"arn:aws:sqs:us-east-1:111122223333:queue","Sid":"AllowBucket"}],"Version":"2012-10-17"}`,
+				changed: true,
+			},
+		},
+		"Invalid": {
+			args: args{
+				doc: `{"Version":`,
+				s:   statement,
+			},
+			want: want{
+				err: errors.Wrap(errors.New("unexpected end of JSON input"), errUnmarshal),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			doc, changed, err := SetStatement(tc.args.doc, tc.args.s)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("SetStatement(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("SetStatement(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.doc, doc); diff != "" {
+				t.Errorf("SetStatement(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveStatements(t *testing.T) {
+	type want struct {
+		doc string
+		err error
+	}
+
+	cases := map[string]struct {
+		doc  string
+		want want
+	}{
+		"Empty": {},
+		"NoneRemoved": {
+			doc:  `{"Version":"2012-10-17","Statement":{"Sid":"Other","Effect":"Deny","Action":"sqs:*","Principal":"*"}}`,
+			want: want{doc: `{"Version":"2012-10-17","Statement":{"Sid":"Other","Effect":"Deny","Action":"sqs:*","Principal":"*"}}`},
+		},
+		"Removed": {
+			doc:  `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"sqs:*","Principal":"*"},{"Sid":"Bucket1","Effect":"Allow","Action":"sqs:SendMessage"}]}`,
+			want: want{doc: `{"Statement":[{"Action":"sqs:*","Effect":"Deny","Principal":"*"}],"Version":"2012-10-17"}`},
+		},
+		"AllRemoved": {
+			doc: `{"Version":"2012-10-17","Statement":[{"Sid":"Bucket1","Effect":"Allow","Action":"sqs:SendMessage"}]}`,
+		},
+		"Invalid": {
+			doc:  `{"Version":`,
+			want: want{err: errors.Wrap(errors.New("unexpected end of JSON input"), errUnmarshal)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			doc, err := RemoveStatements(tc.doc, "Bucket")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("RemoveStatements(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.doc, doc); diff != "" {
+				t.Errorf("RemoveStatements(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCopyStatements(t *testing.T) {
+	type args struct {
+		dst string
+		src string
+	}
+
+	type want struct {
+		doc string
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NothingToCopy": {
+			args: args{
+				dst: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"sqs:*","Principal":"*"}]}`,
+				src: `{"Version":"2012-10-17","Statement":[{"Sid":"Other","Effect":"Allow","Action":"sqs:SendMessage"}]}`,
+			},
+			want: want{doc: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"sqs:*","Principal":"*"}]}`},
+		},
+		"EmptyDestination": {
+			args: args{
+				src: `{"Version":"2008-10-17","Statement":{"Sid":"Bucket1","Effect":"Allow","Action":"sqs:SendMessage"}}`,
+			},
+			want: want{doc: `{"Statement":[{"Action":"sqs:SendMessage","Effect":"Allow","Sid":"Bucket1"}],"Version":"2012-10-17"}`},
+		},
+		"AppendAndReplace": {
+			args: args{
+				dst: `{"Version":"2012-10-17","Statement":[{"Sid":"Bucket1","Effect":"Allow","Action":"sqs:*"},{"Effect":"Deny","Action":"sqs:*","Principal":"*"}]}`,
+				src: `{"Version":"2012-10-17","Statement":[{"Sid":"Bucket2","Effect":"Allow","Action":"sqs:SendMessage"},{"Sid":"Bucket1","Effect":"Allow","Action":"sqs:SendMessage"}]}`,
+			},
+			want: want{doc: `{"Statement":[{"Action":"sqs:SendMessage","Effect":"Allow","Sid":"Bucket1"},{"Action":"sqs:*","Effect":"Deny","Principal":"*"},{"Action":"sqs:SendMessage","Effect":"Allow","Sid":"Bucket2"}],"Version":"2012-10-17"}`},
+		},
+		"InvalidSource": {
+			args: args{src: `{"Version":`},
+			want: want{err: errors.Wrap(errors.New("unexpected end of JSON input"), errUnmarshal)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			doc, err := CopyStatements(tc.args.dst, tc.args.src, "Bucket")
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("CopyStatements(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.doc, doc); diff != "" {
+				t.Errorf("CopyStatements(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
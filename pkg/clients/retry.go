@@ -0,0 +1,413 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Default retry options.
+const (
+	// UseServiceDefaultRetries makes every AWS client retry failed calls
+	// as often as the SDK does by default for its service.
+	UseServiceDefaultRetries = -1
+	DefaultMaxBackoff        = 20 * time.Second
+)
+
+const (
+	// minAdaptiveRate is the lowest rate adaptive rate limits go down to.
+	minAdaptiveRate rate.Limit = 1
+
+	// adaptiveBackoff and adaptiveRecovery are the factors adaptive rate
+	// limits are multiplied by when a call is throttled and when it succeeds
+	// respectively.
+	adaptiveBackoff  = 0.5
+	adaptiveRecovery = 1.05
+
+	rateLimitMiddlewareID = "crossplane.RateLimit"
+	retryHandlerName      = "crossplane.Retry"
+
+	// signingMiddlewareID is the ID of the middleware that signs every
+	// attempt of a call.
+	signingMiddlewareID = "Signing"
+)
+
+// throttleErrorCodes are the error codes AWS services return when they
+// throttle a call.
+var throttleErrorCodes = map[string]struct{}{
+	"Throttling":                {},
+	"ThrottlingException":       {},
+	"ThrottledException":        {},
+	"RequestThrottledException": {},
+	"TooManyRequestsException":  {},
+	"RequestLimitExceeded":      {},
+	"RequestThrottled":          {},
+	"SlowDown":                  {},
+	"EC2ThrottledException":     {},
+}
+
+// A RateLimit is a token bucket limit of the rate of calls made to an AWS
+// service.
+type RateLimit struct {
+	RequestsPerSecond int
+	Burst             int
+}
+
+// RetryOptions configure how AWS API calls are retried and rate limited.
+type RetryOptions struct {
+	// MaxRetries of a failed call, or UseServiceDefaultRetries.
+	MaxRetries int
+	MaxBackoff time.Duration
+	Mode       v1beta1.RetryMode
+
+	// RateLimits by service ID.
+	RateLimits map[string]RateLimit
+}
+
+var defaultRetryOptions = RetryOptions{
+	MaxRetries: UseServiceDefaultRetries,
+	MaxBackoff: DefaultMaxBackoff,
+	Mode:       v1beta1.RetryModeStandard,
+}
+
+// SetRetryOptions configures how AWS API calls are retried and rate limited
+// unless a ProviderConfig overrides it. It must be called before any config
+// is built.
+func SetRetryOptions(o RetryOptions) {
+	limits := make(map[string]RateLimit, len(o.RateLimits))
+	for svc, l := range o.RateLimits {
+		limits[serviceKey(svc)] = l
+	}
+	o.RateLimits = limits
+	defaultRetryOptions = o
+}
+
+// ParseRateLimit parses a rate limit of the form SERVICE=RPS[:BURST] and
+// returns the service it applies to along with the limit. The burst defaults
+// to the requests per second.
+func ParseRateLimit(s string) (string, RateLimit, error) {
+	svc, limit := splitPair(s, "=")
+	if svc == "" || limit == "" {
+		return "", RateLimit{}, errors.Errorf("rate limit %q is not of the form SERVICE=RPS[:BURST]", s)
+	}
+	rps, burst := splitPair(limit, ":")
+	l := RateLimit{}
+	var err error
+	if l.RequestsPerSecond, err = strconv.Atoi(rps); err != nil || l.RequestsPerSecond < 1 {
+		return "", RateLimit{}, errors.Errorf("requests per second of rate limit %q must be a positive integer", s)
+	}
+	l.Burst = l.RequestsPerSecond
+	if burst != "" {
+		if l.Burst, err = strconv.Atoi(burst); err != nil || l.Burst < 1 {
+			return "", RateLimit{}, errors.Errorf("burst of rate limit %q must be a positive integer", s)
+		}
+	}
+	return serviceKey(svc), l, nil
+}
+
+func splitPair(s, sep string) (string, string) {
+	p := strings.SplitN(s, sep, 2)
+	if len(p) == 1 {
+		return strings.TrimSpace(p[0]), ""
+	}
+	return strings.TrimSpace(p[0]), strings.TrimSpace(p[1])
+}
+
+// serviceKey normalises the ID of an AWS service, e.g. "Elastic Load
+// Balancing v2" becomes "elasticloadbalancingv2".
+func serviceKey(id string) string {
+	return strings.ToLower(strings.ReplaceAll(id, " ", ""))
+}
+
+// retryOptions returns the retry options of the supplied ProviderConfig,
+// which override the defaults.
+func retryOptions(pc *v1beta1.ProviderConfig) RetryOptions {
+	o := defaultRetryOptions
+	o.RateLimits = make(map[string]RateLimit, len(defaultRetryOptions.RateLimits))
+	for svc, l := range defaultRetryOptions.RateLimits {
+		o.RateLimits[svc] = l
+	}
+	if pc == nil || pc.Spec.Retry == nil {
+		return o
+	}
+	r := pc.Spec.Retry
+	if r.MaxRetries != nil {
+		o.MaxRetries = *r.MaxRetries
+	}
+	if r.MaxBackoff != nil {
+		o.MaxBackoff = r.MaxBackoff.Duration
+	}
+	if r.Mode != nil {
+		o.Mode = *r.Mode
+	}
+	for _, l := range r.RateLimits {
+		rl := RateLimit{RequestsPerSecond: l.RequestsPerSecond, Burst: l.RequestsPerSecond}
+		if l.Burst != nil {
+			rl.Burst = *l.Burst
+		}
+		o.RateLimits[serviceKey(l.Service)] = rl
+	}
+	return o
+}
+
+// providerLimiterScope returns the scope the rate limits of the supplied
+// deprecated Provider are shared in. It can't collide with the name of a
+// ProviderConfig, since those can't contain a slash.
+func providerLimiterScope(provider string) string {
+	return "provider/" + provider
+}
+
+// withRetryOptions configures the retryer of the supplied config and limits
+// the rate of the calls made with it. Calls are limited per service and
+// scope, usually the name of a ProviderConfig, since AWS throttles calls per
+// service and account.
+func withRetryOptions(cfg *aws.Config, scope string, o RetryOptions) {
+	cfg.Retryer = func() aws.Retryer {
+		return retry.NewStandard(func(so *retry.StandardOptions) {
+			if o.MaxRetries != UseServiceDefaultRetries {
+				so.MaxAttempts = o.MaxRetries + 1
+			}
+			so.MaxBackoff = o.MaxBackoff
+		})
+	}
+	mw := middleware.FinalizeMiddlewareFunc(rateLimitMiddlewareID, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		l := serviceLimiters.get(scope, awsmiddleware.GetServiceID(ctx), o)
+		if l == nil {
+			return next.HandleFinalize(ctx, in)
+		}
+		if err := l.Wait(ctx); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+		out, md, err := next.HandleFinalize(ctx, in)
+		l.Observe(err == nil, isErrorThrottle(err))
+		return out, md, err
+	})
+	// The middleware runs after the retry middleware so that every attempt
+	// of a call is rate limited, but before the attempt is signed so that
+	// waiting for the limiter can't expire its signature.
+	cfg.APIOptions = append(cfg.APIOptions, func(s *middleware.Stack) error {
+		if _, ok := s.Finalize.Get(signingMiddlewareID); ok {
+			return s.Finalize.Insert(mw, signingMiddlewareID, middleware.Before)
+		}
+		return s.Finalize.Add(mw, middleware.After)
+	})
+}
+
+// withRetryOptionsV1 configures the retryers of the clients built from the
+// supplied session. Services default to different numbers of retries, so the
+// default retryer of each client is only adjusted once its calls are built.
+func withRetryOptionsV1(sess *session.Session, o RetryOptions) {
+	sess.Handlers.Validate.PushBackNamed(request.NamedHandler{Name: retryHandlerName, Fn: func(r *request.Request) {
+		d, ok := r.Retryer.(client.DefaultRetryer)
+		if !ok {
+			return
+		}
+		if o.MaxRetries != UseServiceDefaultRetries {
+			d.NumMaxRetries = o.MaxRetries
+		}
+		d.MaxRetryDelay, d.MaxThrottleDelay = o.MaxBackoff, o.MaxBackoff
+		r.Retryer = d
+	}})
+}
+
+// withRateLimitsV1 limits the rate of the calls made with the supplied
+// session the same way withRetryOptions does for configs.
+func withRateLimitsV1(sess *session.Session, scope string, o RetryOptions) {
+	// Sign handlers run for every attempt of a call.
+	sess.Handlers.Sign.PushFrontNamed(request.NamedHandler{Name: rateLimitMiddlewareID, Fn: func(r *request.Request) {
+		if l := serviceLimiters.get(scope, r.ClientInfo.ServiceID, o); l != nil {
+			if err := l.Wait(r.Context()); err != nil {
+				r.Error = err
+			}
+		}
+	}})
+	sess.Handlers.Retry.PushFrontNamed(request.NamedHandler{Name: rateLimitMiddlewareID, Fn: func(r *request.Request) {
+		if l := serviceLimiters.get(scope, r.ClientInfo.ServiceID, o); l != nil {
+			l.Observe(false, r.IsErrorThrottle())
+		}
+	}})
+	sess.Handlers.Complete.PushBackNamed(request.NamedHandler{Name: rateLimitMiddlewareID, Fn: func(r *request.Request) {
+		if l := serviceLimiters.get(scope, r.ClientInfo.ServiceID, o); l != nil && r.Error == nil {
+			l.Observe(true, false)
+		}
+	}})
+}
+
+func isErrorThrottle(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	_, ok := throttleErrorCodes[apiErr.ErrorCode()]
+	return ok
+}
+
+// serviceLimiters are shared by all configs built by this provider so that
+// all managed resources calling a service with the same ProviderConfig share
+// its rate limit.
+var serviceLimiters = &serviceLimiterRegistry{limiters: map[serviceLimiterKey]*serviceLimiter{}}
+
+type serviceLimiterKey struct {
+	scope   string
+	service string
+}
+
+type serviceLimiterRegistry struct {
+	mu       sync.Mutex
+	limiters map[serviceLimiterKey]*serviceLimiter
+}
+
+// get returns the limiter of the supplied service and scope, configured with
+// the supplied options, or nil if calls to the service are not limited.
+func (r *serviceLimiterRegistry) get(scope, service string, o RetryOptions) *serviceLimiter {
+	svc := serviceKey(service)
+	rl, limited := o.RateLimits[svc]
+	adaptive := o.Mode == v1beta1.RetryModeAdaptive
+	if !limited && !adaptive {
+		return nil
+	}
+	max, burst := rate.Inf, 0
+	if limited {
+		max, burst = rate.Limit(rl.RequestsPerSecond), rl.Burst
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	k := serviceLimiterKey{scope: scope, service: svc}
+	l, ok := r.limiters[k]
+	if !ok {
+		l = newServiceLimiter(max, burst, adaptive)
+		r.limiters[k] = l
+		return l
+	}
+	l.configure(max, burst, adaptive)
+	return l
+}
+
+// A serviceLimiter is a token bucket rate limiter of the calls made to an AWS
+// service. Adaptive limiters multiplicatively decrease their rate whenever a
+// call is throttled and slowly recover up to their configured rate as calls
+// succeed again.
+type serviceLimiter struct {
+	limiter *rate.Limiter
+	now     func() time.Time
+
+	mu       sync.Mutex
+	max      rate.Limit
+	burst    int
+	adaptive bool
+
+	// window, sent and lastRate measure the rate calls are made at, which
+	// is where limiters without a configured rate start to adapt from.
+	window   time.Time
+	sent     int
+	lastRate float64
+}
+
+func newServiceLimiter(max rate.Limit, burst int, adaptive bool) *serviceLimiter {
+	return &serviceLimiter{
+		limiter:  rate.NewLimiter(max, burst),
+		now:      time.Now,
+		max:      max,
+		burst:    burst,
+		adaptive: adaptive,
+	}
+}
+
+func (l *serviceLimiter) configure(max rate.Limit, burst int, adaptive bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.adaptive = adaptive
+	if max == l.max && burst == l.burst {
+		return
+	}
+	l.max, l.burst = max, burst
+	l.limiter.SetLimit(max)
+	l.limiter.SetBurst(burst)
+}
+
+// Wait blocks until a call is allowed.
+func (l *serviceLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := l.now()
+	if elapsed := now.Sub(l.window); elapsed >= time.Second {
+		l.lastRate = float64(l.sent) / elapsed.Seconds()
+		l.window, l.sent = now, 0
+	}
+	l.sent++
+	l.mu.Unlock()
+	return l.limiter.Wait(ctx)
+}
+
+// Observe adapts the rate of an adaptive limiter to the outcome of a call.
+func (l *serviceLimiter) Observe(succeeded, throttled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.adaptive {
+		return
+	}
+	current := l.limiter.Limit()
+	switch {
+	case throttled:
+		if current == rate.Inf {
+			current = rate.Limit(l.lastRate)
+			if sent := rate.Limit(l.sent); sent > current {
+				current = sent
+			}
+		}
+		l.setLimit(current * adaptiveBackoff)
+	case succeeded && current < l.max:
+		next := current * adaptiveRecovery
+		if next >= l.max || (l.max == rate.Inf && float64(next) > 2*l.lastRate+1) {
+			// Limiters without a configured rate are lifted once calls
+			// are made at less than half the rate they allow.
+			l.limiter.SetLimit(l.max)
+			l.limiter.SetBurst(l.burst)
+			return
+		}
+		l.setLimit(next)
+	}
+}
+
+func (l *serviceLimiter) setLimit(r rate.Limit) {
+	if r < minAdaptiveRate {
+		r = minAdaptiveRate
+	}
+	burst := int(r)
+	if l.burst > 0 && l.burst < burst {
+		burst = l.burst
+	}
+	l.limiter.SetLimit(r)
+	l.limiter.SetBurst(burst)
+}
@@ -0,0 +1,366 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	dynamodbv1 "github.com/aws/aws-sdk-go/service/dynamodb"
+	stsv1 "github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	type want struct {
+		service string
+		limit   RateLimit
+		err     bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		arg    string
+		want   want
+	}{
+		"RequestsPerSecond": {
+			reason: "The burst should default to the requests per second.",
+			arg:    "EC2=20",
+			want:   want{service: "ec2", limit: RateLimit{RequestsPerSecond: 20, Burst: 20}},
+		},
+		"Burst": {
+			reason: "The burst should be parsed if given.",
+			arg:    "Elastic Load Balancing v2=5:10",
+			want:   want{service: "elasticloadbalancingv2", limit: RateLimit{RequestsPerSecond: 5, Burst: 10}},
+		},
+		"NoLimit": {
+			reason: "A rate limit without a limit should be rejected.",
+			arg:    "EC2",
+			want:   want{err: true},
+		},
+		"InvalidRequestsPerSecond": {
+			reason: "A rate limit with a non-positive rate should be rejected.",
+			arg:    "EC2=0",
+			want:   want{err: true},
+		},
+		"InvalidBurst": {
+			reason: "A rate limit with an invalid burst should be rejected.",
+			arg:    "EC2=1:x",
+			want:   want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			svc, l, err := ParseRateLimit(tc.arg)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nParseRateLimit(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.service, svc); diff != "" {
+				t.Errorf("\n%s\nParseRateLimit(...): -want service, +got service:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.limit, l); diff != "" {
+				t.Errorf("\n%s\nParseRateLimit(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRetryOptions(t *testing.T) {
+	adaptive := v1beta1.RetryModeAdaptive
+	defaults := RetryOptions{
+		MaxRetries: UseServiceDefaultRetries,
+		MaxBackoff: 20 * time.Second,
+		Mode:       v1beta1.RetryModeStandard,
+		RateLimits: map[string]RateLimit{"ec2": {RequestsPerSecond: 10, Burst: 10}},
+	}
+
+	cases := map[string]struct {
+		reason string
+		pc     *v1beta1.ProviderConfig
+		want   RetryOptions
+	}{
+		"Defaults": {
+			reason: "The defaults should be used if the ProviderConfig does not configure retries.",
+			pc:     &v1beta1.ProviderConfig{},
+			want:   defaults,
+		},
+		"Overrides": {
+			reason: "The retry options of the ProviderConfig should override the defaults.",
+			pc: &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{Retry: &v1beta1.RetryConfig{
+				MaxRetries: intPtr(5),
+				MaxBackoff: &metav1.Duration{Duration: time.Minute},
+				Mode:       &adaptive,
+				RateLimits: []v1beta1.ServiceRateLimit{
+					{Service: "EC2", RequestsPerSecond: 5},
+					{Service: "IAM", RequestsPerSecond: 2, Burst: intPtr(4)},
+				},
+			}}},
+			want: RetryOptions{
+				MaxRetries: 5,
+				MaxBackoff: time.Minute,
+				Mode:       v1beta1.RetryModeAdaptive,
+				RateLimits: map[string]RateLimit{
+					"ec2": {RequestsPerSecond: 5, Burst: 5},
+					"iam": {RequestsPerSecond: 2, Burst: 4},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			orig := defaultRetryOptions
+			defer func() { defaultRetryOptions = orig }()
+			SetRetryOptions(defaults)
+
+			if diff := cmp.Diff(tc.want, retryOptions(tc.pc)); diff != "" {
+				t.Errorf("\n%s\nretryOptions(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWithRetryOptions(t *testing.T) {
+	noop := func(id string) middleware.FinalizeMiddleware {
+		return middleware.FinalizeMiddlewareFunc(id, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			return next.HandleFinalize(ctx, in)
+		})
+	}
+
+	cases := map[string]struct {
+		reason string
+		stack  []string
+		want   []string
+	}{
+		"Signed": {
+			reason: "Attempts should be rate limited after they are retried but before they are signed.",
+			stack:  []string{"Retry", signingMiddlewareID, "Other"},
+			want:   []string{"Retry", rateLimitMiddlewareID, signingMiddlewareID, "Other"},
+		},
+		"Unsigned": {
+			reason: "Attempts of calls that are not signed should be rate limited last.",
+			stack:  []string{"Retry", "Other"},
+			want:   []string{"Retry", "Other", rateLimitMiddlewareID},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := middleware.NewStack("test", nil)
+			for _, id := range tc.stack {
+				if err := s.Finalize.Add(noop(id), middleware.After); err != nil {
+					t.Fatal(err)
+				}
+			}
+			cfg := aws.Config{}
+			withRetryOptions(&cfg, "pc", RetryOptions{MaxRetries: UseServiceDefaultRetries})
+			for _, fn := range cfg.APIOptions {
+				if err := fn(s); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if diff := cmp.Diff(tc.want, s.Finalize.List()); diff != "" {
+				t.Errorf("\n%s\nwithRetryOptions(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWithRetryOptionsV1(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		o      RetryOptions
+		want   map[string]int
+	}{
+		"ServiceDefaults": {
+			reason: "Every service should keep its default number of retries unless it is configured.",
+			o:      RetryOptions{MaxRetries: UseServiceDefaultRetries, MaxBackoff: time.Second},
+			want:   map[string]int{"DynamoDB": 10, "STS": client.DefaultRetryerMaxNumRetries},
+		},
+		"Configured": {
+			reason: "The configured number of retries should override the defaults of all services.",
+			o:      RetryOptions{MaxRetries: 5, MaxBackoff: time.Second},
+			want:   map[string]int{"DynamoDB": 5, "STS": 5},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			sess, err := session.NewSession(awsv1.NewConfig().
+				WithRegion("us-east-1").
+				WithCredentials(credentialsv1.NewStaticCredentials("id", "secret", "")))
+			if err != nil {
+				t.Fatal(err)
+			}
+			withRetryOptionsV1(sess, tc.o)
+
+			ddb, _ := dynamodbv1.New(sess).DescribeTableRequest(&dynamodbv1.DescribeTableInput{TableName: awsv1.String("table")})
+			sts, _ := stsv1.New(sess).GetCallerIdentityRequest(&stsv1.GetCallerIdentityInput{})
+			got := map[string]int{}
+			for svc, r := range map[string]interface {
+				Build() error
+				MaxRetries() int
+			}{"DynamoDB": ddb, "STS": sts} {
+				if err := r.Build(); err != nil {
+					t.Fatal(err)
+				}
+				got[svc] = r.MaxRetries()
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nwithRetryOptionsV1(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if d := ddb.Retryer.(client.DefaultRetryer); d.MaxRetryDelay != tc.o.MaxBackoff || d.MaxThrottleDelay != tc.o.MaxBackoff {
+				t.Errorf("\n%s\nwithRetryOptionsV1(...): want backoff of at most %s, got %s and %s", tc.reason, tc.o.MaxBackoff, d.MaxRetryDelay, d.MaxThrottleDelay)
+			}
+		})
+	}
+}
+
+func TestServiceLimiterRegistry(t *testing.T) {
+	r := &serviceLimiterRegistry{limiters: map[serviceLimiterKey]*serviceLimiter{}}
+	o := RetryOptions{Mode: v1beta1.RetryModeStandard, RateLimits: map[string]RateLimit{"ec2": {RequestsPerSecond: 10, Burst: 10}}}
+
+	if l := r.get("pc", "IAM", o); l != nil {
+		t.Errorf("get(...): services without a rate limit should not be limited in standard mode")
+	}
+	l := r.get("pc", "EC2", o)
+	if l == nil {
+		t.Fatalf("get(...): services with a rate limit should be limited")
+	}
+	if r.get("pc", "ec2", o) != l {
+		t.Errorf("get(...): calls to the same service with the same ProviderConfig should share a limiter")
+	}
+	if r.get("other", "EC2", o) == l {
+		t.Errorf("get(...): calls with different ProviderConfigs should not share a limiter")
+	}
+	if r.get(providerLimiterScope("pc"), "EC2", o) == l {
+		t.Errorf("get(...): calls with a Provider should not share a limiter with a ProviderConfig of the same name")
+	}
+	o.RateLimits["ec2"] = RateLimit{RequestsPerSecond: 20, Burst: 20}
+	if got := r.get("pc", "EC2", o).limiter.Limit(); got != 20 {
+		t.Errorf("get(...): limiters should be reconfigured when their limit changes, want 20, got %v", got)
+	}
+}
+
+func TestServiceLimiterObserve(t *testing.T) {
+	type step struct {
+		succeeded bool
+		throttled bool
+	}
+
+	cases := map[string]struct {
+		reason   string
+		limiter  *serviceLimiter
+		lastRate float64
+		steps    []step
+		want     rate.Limit
+	}{
+		"StandardThrottled": {
+			reason:  "Limiters that are not adaptive should not change when calls are throttled.",
+			limiter: newServiceLimiter(10, 10, false),
+			steps:   []step{{throttled: true}},
+			want:    10,
+		},
+		"AdaptiveThrottled": {
+			reason:  "Adaptive limiters should halve their rate when calls are throttled.",
+			limiter: newServiceLimiter(10, 10, true),
+			steps:   []step{{throttled: true}, {throttled: true}},
+			want:    2.5,
+		},
+		"AdaptiveMinimum": {
+			reason:  "Adaptive limiters should not go below the minimum rate.",
+			limiter: newServiceLimiter(2, 2, true),
+			steps:   []step{{throttled: true}, {throttled: true}, {throttled: true}},
+			want:    minAdaptiveRate,
+		},
+		"AdaptiveRecovery": {
+			reason:  "Adaptive limiters should recover up to their configured rate as calls succeed.",
+			limiter: newServiceLimiter(10, 10, true),
+			steps:   append([]step{{throttled: true}}, make([]step, 20)...),
+			want:    10,
+		},
+		"AdaptiveUnlimitedThrottled": {
+			reason:   "Adaptive limiters without a configured rate should halve the rate calls are made at when they are throttled.",
+			limiter:  newServiceLimiter(rate.Inf, 0, true),
+			lastRate: 40,
+			steps:    []step{{throttled: true}},
+			want:     20,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.limiter.lastRate = tc.lastRate
+			for i := range tc.steps {
+				s := tc.steps[i]
+				if !s.throttled {
+					s.succeeded = true
+				}
+				tc.limiter.Observe(s.succeeded, s.throttled)
+			}
+			if diff := cmp.Diff(tc.want, tc.limiter.limiter.Limit()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want limit, +got limit:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsErrorThrottle(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"Throttled": {
+			err:  errors.Wrap(&smithy.GenericAPIError{Code: "RequestLimitExceeded"}, "cannot describe"),
+			want: true,
+		},
+		"OtherAPIError": {
+			err:  &smithy.GenericAPIError{Code: "InvalidParameterValue"},
+			want: false,
+		},
+		"NotAPIError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, isErrorThrottle(tc.err)); diff != "" {
+				t.Errorf("isErrorThrottle(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
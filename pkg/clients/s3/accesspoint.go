@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
+)
+
+const (
+	// AccessPointNotFoundErrCode is the error code sent by AWS when an
+	// access point does not exist
+	AccessPointNotFoundErrCode = "NoSuchAccessPoint"
+
+	// AccessPointPolicyNotFoundErrCode is the error code sent by AWS when an
+	// access point has no policy
+	AccessPointPolicyNotFoundErrCode = "NoSuchAccessPointPolicy"
+
+	errNoAccessPointPolicy = "no rawPolicy or policy specified"
+)
+
+// AccessPointClient is the external client used for the AccessPoint and
+// AccessPointPolicy Custom Resources. Access points are managed through the
+// S3 Control API, which is only available in aws-sdk-go.
+type AccessPointClient interface {
+	CreateAccessPointWithContext(ctx awsv1.Context, input *s3control.CreateAccessPointInput, opts ...request.Option) (*s3control.CreateAccessPointOutput, error)
+	GetAccessPointWithContext(ctx awsv1.Context, input *s3control.GetAccessPointInput, opts ...request.Option) (*s3control.GetAccessPointOutput, error)
+	DeleteAccessPointWithContext(ctx awsv1.Context, input *s3control.DeleteAccessPointInput, opts ...request.Option) (*s3control.DeleteAccessPointOutput, error)
+	GetAccessPointPolicyWithContext(ctx awsv1.Context, input *s3control.GetAccessPointPolicyInput, opts ...request.Option) (*s3control.GetAccessPointPolicyOutput, error)
+	PutAccessPointPolicyWithContext(ctx awsv1.Context, input *s3control.PutAccessPointPolicyInput, opts ...request.Option) (*s3control.PutAccessPointPolicyOutput, error)
+	DeleteAccessPointPolicyWithContext(ctx awsv1.Context, input *s3control.DeleteAccessPointPolicyInput, opts ...request.Option) (*s3control.DeleteAccessPointPolicyOutput, error)
+}
+
+// NewAccessPointClient returns a new client given an aws session
+func NewAccessPointClient(sess *session.Session) AccessPointClient {
+	return s3control.New(sess)
+}
+
+// CallerIdentityClient is the external client used to determine the account
+// that owns an access point if none is specified.
+type CallerIdentityClient interface {
+	GetCallerIdentityWithContext(ctx awsv1.Context, input *sts.GetCallerIdentityInput, opts ...request.Option) (*sts.GetCallerIdentityOutput, error)
+}
+
+// NewCallerIdentityClient returns a new client given an aws session
+func NewCallerIdentityClient(sess *session.Session) CallerIdentityClient {
+	return sts.New(sess)
+}
+
+// AccountID returns the supplied account ID, or the account of the caller if
+// it is nil.
+func AccountID(ctx context.Context, c CallerIdentityClient, accountID *string) (string, error) {
+	if accountID != nil {
+		return *accountID, nil
+	}
+	resp, err := c.GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+	return awsv1.StringValue(resp.Account), nil
+}
+
+// IsErrorAccessPointNotFound returns true if the error code indicates that
+// the access point was not found
+func IsErrorAccessPointNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == AccessPointNotFoundErrCode
+}
+
+// IsErrorAccessPointPolicyNotFound returns true if the error code indicates
+// that the access point has no policy
+func IsErrorAccessPointPolicyNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == AccessPointPolicyNotFoundErrCode
+}
+
+// AccessPointARN returns the ARN of the access point with the supplied name.
+func AccessPointARN(region, accountID, name string) string {
+	partition := endpoints.AwsPartitionID
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		partition = p.ID()
+	}
+	return fmt.Sprintf("arn:%s:s3:%s:%s:accesspoint/%s", partition, region, accountID, name)
+}
+
+// AccessPointEndpoint returns the virtual-hosted-style endpoint of the access
+// point with the supplied name.
+func AccessPointEndpoint(region, accountID, name string) string {
+	suffix := "amazonaws.com"
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+		suffix = p.DNSSuffix()
+	}
+	return fmt.Sprintf("%s-%s.s3-accesspoint.%s.%s", name, accountID, region, suffix)
+}
+
+// GenerateCreateAccessPointInput returns the input of the request that creates
+// the access point with the supplied name.
+func GenerateCreateAccessPointInput(name, accountID string, p v1alpha3.AccessPointParameters) *s3control.CreateAccessPointInput {
+	input := &s3control.CreateAccessPointInput{
+		AccountId: awsv1.String(accountID),
+		Bucket:    p.BucketName,
+		Name:      awsv1.String(name),
+	}
+	if p.VPCConfiguration != nil {
+		input.VpcConfiguration = &s3control.VpcConfiguration{VpcId: p.VPCConfiguration.VPCID}
+	}
+	if p.PublicAccessBlockConfiguration != nil {
+		input.PublicAccessBlockConfiguration = &s3control.PublicAccessBlockConfiguration{
+			BlockPublicAcls:       p.PublicAccessBlockConfiguration.BlockPublicACLs,
+			BlockPublicPolicy:     p.PublicAccessBlockConfiguration.BlockPublicPolicy,
+			IgnorePublicAcls:      p.PublicAccessBlockConfiguration.IgnorePublicACLs,
+			RestrictPublicBuckets: p.PublicAccessBlockConfiguration.RestrictPublicBuckets,
+		}
+	}
+	return input
+}
+
+// LateInitializeAccessPoint fills the empty fields of the supplied parameters
+// with the observed state of the access point.
+func LateInitializeAccessPoint(p *v1alpha3.AccessPointParameters, ap *s3control.GetAccessPointOutput) {
+	if ap == nil {
+		return
+	}
+	p.BucketName = awsclient.LateInitializeStringPtr(p.BucketName, ap.Bucket)
+	if p.VPCConfiguration == nil && ap.VpcConfiguration != nil {
+		p.VPCConfiguration = &v1alpha3.AccessPointVPCConfiguration{VPCID: ap.VpcConfiguration.VpcId}
+	}
+	if pab := ap.PublicAccessBlockConfiguration; pab != nil {
+		if p.PublicAccessBlockConfiguration == nil {
+			p.PublicAccessBlockConfiguration = &v1alpha3.AccessPointPublicAccessBlockConfiguration{}
+		}
+		c := p.PublicAccessBlockConfiguration
+		c.BlockPublicACLs = awsclient.LateInitializeBoolPtr(c.BlockPublicACLs, pab.BlockPublicAcls)
+		c.BlockPublicPolicy = awsclient.LateInitializeBoolPtr(c.BlockPublicPolicy, pab.BlockPublicPolicy)
+		c.IgnorePublicACLs = awsclient.LateInitializeBoolPtr(c.IgnorePublicACLs, pab.IgnorePublicAcls)
+		c.RestrictPublicBuckets = awsclient.LateInitializeBoolPtr(c.RestrictPublicBuckets, pab.RestrictPublicBuckets)
+	}
+}
+
+// FormatAccessPointPolicy returns the JSON document of the supplied access
+// point policy.
+func FormatAccessPointPolicy(p v1alpha3.AccessPointPolicyParameters) (string, error) {
+	switch {
+	case p.RawPolicy != nil:
+		return *p.RawPolicy, nil
+	case p.Policy != nil:
+		return policyclient.Serialize(p.Policy)
+	}
+	return "", errors.New(errNoAccessPointPolicy)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestAccessPointARN(t *testing.T) {
+	cases := map[string]struct {
+		region string
+		want   string
+	}{
+		"AWS": {
+			region: "eu-west-1",
+			want:   "arn:aws:s3:eu-west-1:123456789012:accesspoint/team-a",
+		},
+		"China": {
+			region: "cn-north-1",
+			want:   "arn:aws-cn:s3:cn-north-1:123456789012:accesspoint/team-a",
+		},
+		"GovCloud": {
+			region: "us-gov-west-1",
+			want:   "arn:aws-us-gov:s3:us-gov-west-1:123456789012:accesspoint/team-a",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, AccessPointARN(tc.region, "123456789012", "team-a")); diff != "" {
+				t.Errorf("AccessPointARN(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeAccessPoint(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha3.AccessPointParameters
+		ap     *s3control.GetAccessPointOutput
+		want   v1alpha3.AccessPointParameters
+	}{
+		"Empty": {
+			params: v1alpha3.AccessPointParameters{},
+			ap: &s3control.GetAccessPointOutput{
+				Bucket:           awsclient.String("bucket"),
+				VpcConfiguration: &s3control.VpcConfiguration{VpcId: awsclient.String("vpc-1")},
+				PublicAccessBlockConfiguration: &s3control.PublicAccessBlockConfiguration{
+					BlockPublicAcls:       aws.Bool(true),
+					BlockPublicPolicy:     aws.Bool(true),
+					IgnorePublicAcls:      aws.Bool(true),
+					RestrictPublicBuckets: aws.Bool(false),
+				},
+			},
+			want: v1alpha3.AccessPointParameters{
+				BucketName:       awsclient.String("bucket"),
+				VPCConfiguration: &v1alpha3.AccessPointVPCConfiguration{VPCID: awsclient.String("vpc-1")},
+				PublicAccessBlockConfiguration: &v1alpha3.AccessPointPublicAccessBlockConfiguration{
+					BlockPublicACLs:       aws.Bool(true),
+					BlockPublicPolicy:     aws.Bool(true),
+					IgnorePublicACLs:      aws.Bool(true),
+					RestrictPublicBuckets: aws.Bool(false),
+				},
+			},
+		},
+		"KeepSpecified": {
+			params: v1alpha3.AccessPointParameters{
+				BucketName: awsclient.String("bucket"),
+				PublicAccessBlockConfiguration: &v1alpha3.AccessPointPublicAccessBlockConfiguration{
+					BlockPublicPolicy: aws.Bool(false),
+				},
+			},
+			ap: &s3control.GetAccessPointOutput{
+				Bucket: awsclient.String("bucket"),
+				PublicAccessBlockConfiguration: &s3control.PublicAccessBlockConfiguration{
+					BlockPublicPolicy: aws.Bool(true),
+				},
+			},
+			want: v1alpha3.AccessPointParameters{
+				BucketName: awsclient.String("bucket"),
+				PublicAccessBlockConfiguration: &v1alpha3.AccessPointPublicAccessBlockConfiguration{
+					BlockPublicPolicy: aws.Bool(false),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeAccessPoint(&tc.params, tc.ap)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("LateInitializeAccessPoint(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
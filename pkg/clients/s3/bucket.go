@@ -50,6 +50,10 @@ var (
 	TaggingNotFoundErrCode = "NoSuchTagSet"
 	// WebsiteNotFoundErrCode is the error code sent by AWS when the website config does not exist
 	WebsiteNotFoundErrCode = "NoSuchWebsiteConfiguration"
+	// OwnershipControlsNotFoundErrCode is the error code sent by AWS when the ownership controls do not exist
+	OwnershipControlsNotFoundErrCode = "OwnershipControlsNotFoundError"
+	// ObjectLockNotFoundErrCode is the error code sent by AWS when the object lock config does not exist
+	ObjectLockNotFoundErrCode = "ObjectLockConfigurationNotFoundError"
 
 	// MethodNotAllowed is the error code sent by AWS when the request method for an object is not allowed
 	MethodNotAllowed = "MethodNotAllowed"
@@ -63,6 +67,9 @@ type BucketClient interface {
 	CreateBucket(ctx context.Context, input *s3.CreateBucketInput, opts ...func(*s3.Options)) (*s3.CreateBucketOutput, error)
 	DeleteBucket(ctx context.Context, input *s3.DeleteBucketInput, opts ...func(*s3.Options)) (*s3.DeleteBucketOutput, error)
 
+	ListObjectVersions(ctx context.Context, input *s3.ListObjectVersionsInput, opts ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error)
+	DeleteObjects(ctx context.Context, input *s3.DeleteObjectsInput, opts ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error)
+
 	PutBucketEncryption(ctx context.Context, input *s3.PutBucketEncryptionInput, opts ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error)
 	GetBucketEncryption(ctx context.Context, input *s3.GetBucketEncryptionInput, opts ...func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error)
 	DeleteBucketEncryption(ctx context.Context, input *s3.DeleteBucketEncryptionInput, opts ...func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error)
@@ -97,6 +104,20 @@ type BucketClient interface {
 
 	PutBucketAnalyticsConfiguration(ctx context.Context, input *s3.PutBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error)
 	GetBucketAnalyticsConfiguration(ctx context.Context, input *s3.GetBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.GetBucketAnalyticsConfigurationOutput, error)
+	ListBucketAnalyticsConfigurations(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error)
+	DeleteBucketAnalyticsConfiguration(ctx context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error)
+
+	ListBucketInventoryConfigurations(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error)
+	PutBucketInventoryConfiguration(ctx context.Context, input *s3.PutBucketInventoryConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error)
+	DeleteBucketInventoryConfiguration(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error)
+
+	ListBucketMetricsConfigurations(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error)
+	PutBucketMetricsConfiguration(ctx context.Context, input *s3.PutBucketMetricsConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error)
+	DeleteBucketMetricsConfiguration(ctx context.Context, input *s3.DeleteBucketMetricsConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error)
+
+	ListBucketIntelligentTieringConfigurations(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error)
+	PutBucketIntelligentTieringConfiguration(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error)
+	DeleteBucketIntelligentTieringConfiguration(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error)
 
 	PutBucketLifecycleConfiguration(ctx context.Context, input *s3.PutBucketLifecycleConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error)
 	GetBucketLifecycleConfiguration(ctx context.Context, input *s3.GetBucketLifecycleConfigurationInput, opts ...func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error)
@@ -110,6 +131,13 @@ type BucketClient interface {
 	GetPublicAccessBlock(ctx context.Context, input *s3.GetPublicAccessBlockInput, opts ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error)
 	PutPublicAccessBlock(ctx context.Context, input *s3.PutPublicAccessBlockInput, opts ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error)
 	DeletePublicAccessBlock(ctx context.Context, input *s3.DeletePublicAccessBlockInput, opts ...func(*s3.Options)) (*s3.DeletePublicAccessBlockOutput, error)
+
+	GetBucketOwnershipControls(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error)
+	PutBucketOwnershipControls(ctx context.Context, input *s3.PutBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error)
+	DeleteBucketOwnershipControls(ctx context.Context, input *s3.DeleteBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error)
+
+	GetObjectLockConfiguration(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error)
+	PutObjectLockConfiguration(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error)
 }
 
 // NewClient returns a new client using AWS credentials as JSON encoded data.
@@ -147,6 +175,37 @@ func GenerateCreateBucketInput(name string, s v1beta1.BucketParameters) *s3.Crea
 	return cbi
 }
 
+// GenerateDeleteObjectsInput creates the input for the DeleteObjects S3 Client
+// request that deletes all object versions and delete markers of the supplied
+// ListObjectVersions page. It returns nil if the page is empty.
+func GenerateDeleteObjectsInput(name string, page *s3.ListObjectVersionsOutput) *s3.DeleteObjectsInput {
+	ids := make([]s3types.ObjectIdentifier, 0, len(page.Versions)+len(page.DeleteMarkers))
+	for _, v := range page.Versions {
+		ids = append(ids, s3types.ObjectIdentifier{Key: v.Key, VersionId: v.VersionId})
+	}
+	for _, m := range page.DeleteMarkers {
+		ids = append(ids, s3types.ObjectIdentifier{Key: m.Key, VersionId: m.VersionId})
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return &s3.DeleteObjectsInput{
+		Bucket: aws.String(name),
+		Delete: &s3types.Delete{Objects: ids, Quiet: true},
+	}
+}
+
+// DeleteObjectsError returns an error describing the objects a DeleteObjects
+// S3 Client request failed to delete, if any.
+func DeleteObjectsError(out *s3.DeleteObjectsOutput) error {
+	if len(out.Errors) == 0 {
+		return nil
+	}
+	e := out.Errors[0]
+	return fmt.Errorf("cannot delete %d objects, including version %q of %q: %s: %s",
+		len(out.Errors), aws.ToString(e.VersionId), aws.ToString(e.Key), aws.ToString(e.Code), aws.ToString(e.Message))
+}
+
 // GenerateBucketObservation generates the ARN string for the external status
 func GenerateBucketObservation(name string) v1beta1.BucketExternalStatus {
 	return v1beta1.BucketExternalStatus{
@@ -196,6 +255,18 @@ func WebsiteConfigurationNotFound(err error) bool {
 	return errors.As(err, &awsErr) && awsErr.ErrorCode() == WebsiteNotFoundErrCode
 }
 
+// OwnershipControlsNotFound is parses the aws Error and validates if the ownership controls do not exist
+func OwnershipControlsNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == OwnershipControlsNotFoundErrCode
+}
+
+// ObjectLockConfigurationNotFound is parses the aws Error and validates if the object lock configuration does not exist
+func ObjectLockConfigurationNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == ObjectLockNotFoundErrCode
+}
+
 // MethodNotSupported is parses the aws Error and validates if the method is allowed for a request
 func MethodNotSupported(err error) bool {
 	var awsErr smithy.APIError
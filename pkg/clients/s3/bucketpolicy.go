@@ -19,14 +19,15 @@ package s3
 import (
 	"context"
 	"errors"
-	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
 
+	policyv1alpha1 "github.com/crossplane/provider-aws/apis/policy/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
 )
 
 // BucketPolicyClient is the external client used for S3BucketPolicy Custom Resource
@@ -53,141 +54,63 @@ func IsErrorBucketNotFound(err error) bool {
 	return errors.As(err, &nsb)
 }
 
-// Serialize is the custom marshaller for the BucketPolicyParameters
-func Serialize(p *v1alpha3.BucketPolicyBody) (interface{}, error) {
-	m := make(map[string]interface{})
-	m["Version"] = p.Version
-	if p.ID != "" {
-		m["Id"] = p.ID
-	}
-	slc := make([]interface{}, len(p.Statements))
-	for i, v := range p.Statements {
-		msg, err := SerializeBucketPolicyStatement(v)
-		if err != nil {
-			return nil, err
-		}
-		slc[i] = msg
-	}
-	m["Statement"] = slc
-	return m, nil
+// Serialize returns the JSON form of the supplied BucketPolicyBody.
+func Serialize(p *v1alpha3.BucketPolicyBody) (string, error) {
+	return policyclient.Serialize(convertBucketPolicyBody(p))
 }
 
-// SerializeBucketPolicyStatement is the custom marshaller for the BucketPolicyStatement
-func SerializeBucketPolicyStatement(p v1alpha3.BucketPolicyStatement) (interface{}, error) { // nolint:gocyclo
-	m := make(map[string]interface{})
-	if p.Principal != nil {
-		principal, err := SerializeBucketPrincipal(p.Principal)
-		if err != nil {
-			return nil, err
-		}
-		m["Principal"] = principal
-	}
-	if p.NotPrincipal != nil {
-		notPrincipal, err := SerializeBucketPrincipal(p.NotPrincipal)
-		if err != nil {
-			return nil, err
-		}
-		m["NotPrincipal"] = notPrincipal
-	}
-	if checkExistsArray(p.Action) {
-		m["Action"] = tryFirst(p.Action)
-	}
-	if checkExistsArray(p.NotAction) {
-		m["NotAction"] = tryFirst(p.NotAction)
+func convertBucketPolicyBody(p *v1alpha3.BucketPolicyBody) *policyv1alpha1.Document {
+	d := &policyv1alpha1.Document{
+		Version:    p.Version,
+		Statements: make([]policyv1alpha1.Statement, len(p.Statements)),
 	}
-	if checkExistsArray(p.Resource) {
-		m["Resource"] = tryFirst(p.Resource)
-	}
-	if checkExistsArray(p.NotResource) {
-		m["NotResource"] = tryFirst(p.NotResource)
-	}
-	if p.Condition != nil {
-		condition, err := SerializeBucketCondition(p.Condition)
-		if err != nil {
-			return nil, err
+	if p.ID != "" {
+		d.ID = aws.String(p.ID)
+	}
+	for i, s := range p.Statements {
+		d.Statements[i] = policyv1alpha1.Statement{
+			SID:          s.SID,
+			Effect:       s.Effect,
+			Principal:    convertBucketPrincipal(s.Principal),
+			NotPrincipal: convertBucketPrincipal(s.NotPrincipal),
+			Action:       s.Action,
+			NotAction:    s.NotAction,
+			Resource:     s.Resource,
+			NotResource:  s.NotResource,
+			Condition:    convertConditions(s.Condition),
 		}
-		m["Condition"] = condition
 	}
-	m["Effect"] = p.Effect
-	if p.SID != nil {
-		m["Sid"] = *p.SID
-	}
-	return m, nil
+	return d
 }
 
-// SerializeBucketPrincipal is the custom serializer for the BucketPrincipal
-func SerializeBucketPrincipal(p *v1alpha3.BucketPrincipal) (interface{}, error) {
-	all := "*"
-	if p.AllowAnon {
-		return all, nil
-	}
-	m := make(map[string]interface{})
-	if p.Service != nil {
-		m["Service"] = tryFirst(p.Service)
+func convertBucketPrincipal(p *v1alpha3.BucketPrincipal) *policyv1alpha1.Principal {
+	if p == nil {
+		return nil
 	}
-	if p.Federated != nil {
-		m["Federated"] = aws.ToString(p.Federated)
+	r := &policyv1alpha1.Principal{
+		AllowAnon: p.AllowAnon,
+		Federated: p.Federated,
+		Service:   p.Service,
 	}
-	if len(p.AWSPrincipals) == 1 {
-		m["AWS"] = aws.ToString(SerializeAWSPrincipal(p.AWSPrincipals[0]))
-	} else if len(p.AWSPrincipals) > 1 {
-		values := make([]interface{}, len(p.AWSPrincipals))
-		for i := range p.AWSPrincipals {
-			values[i] = aws.ToString(SerializeAWSPrincipal(p.AWSPrincipals[i]))
-		}
-		m["AWS"] = values
+	for _, a := range p.AWSPrincipals {
+		r.AWSPrincipals = append(r.AWSPrincipals, policyv1alpha1.AWSPrincipal(a))
 	}
-	return m, nil
+	return r
 }
 
-// SerializeAWSPrincipal converts an AWSPrincipal to a string
-func SerializeAWSPrincipal(p v1alpha3.AWSPrincipal) *string {
-	switch {
-	case p.AWSAccountID != nil:
-		return p.AWSAccountID
-	case p.IAMRoleARN != nil:
-		return p.IAMRoleARN
-	case p.UserARN != nil:
-		return p.UserARN
-	default:
+func convertConditions(c []v1alpha3.Condition) []policyv1alpha1.Condition {
+	if c == nil {
 		return nil
 	}
-}
-
-// SerializeBucketCondition converts the string -> Condition map
-// into a serialized version
-func SerializeBucketCondition(p []v1alpha3.Condition) (interface{}, error) {
-	m := make(map[string]interface{})
-	for _, v := range p {
-		subMap := make(map[string]interface{})
-		for _, c := range v.Conditions {
-			switch {
-			case c.ConditionStringValue != nil:
-				subMap[c.ConditionKey] = *c.ConditionStringValue
-			case c.ConditionBooleanValue != nil:
-				subMap[c.ConditionKey] = *c.ConditionBooleanValue
-			case c.ConditionNumericValue != nil:
-				subMap[c.ConditionKey] = *c.ConditionNumericValue
-			case c.ConditionDateValue != nil:
-				subMap[c.ConditionKey] = c.ConditionDateValue.Time.Format("2006-01-02T15:04:05-0700")
-			case c.ConditionListValue != nil:
-				subMap[c.ConditionKey] = c.ConditionListValue
-			default:
-				return nil, fmt.Errorf("no value provided for key with value %s, condition %s", c.ConditionKey, v.OperatorKey)
-			}
+	r := make([]policyv1alpha1.Condition, len(c))
+	for i := range c {
+		r[i] = policyv1alpha1.Condition{
+			OperatorKey: c[i].OperatorKey,
+			Conditions:  make([]policyv1alpha1.ConditionPair, len(c[i].Conditions)),
+		}
+		for j := range c[i].Conditions {
+			r[i].Conditions[j] = policyv1alpha1.ConditionPair(c[i].Conditions[j])
 		}
-		m[v.OperatorKey] = subMap
-	}
-	return m, nil
-}
-
-func checkExistsArray(slc []string) bool {
-	return len(slc) != 0
-}
-
-func tryFirst(slc []string) interface{} {
-	if len(slc) == 1 {
-		return slc[0]
 	}
-	return slc
+	return r
 }
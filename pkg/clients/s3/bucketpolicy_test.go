@@ -70,7 +70,7 @@ func policyStatement(m ...statementModifier) *v1alpha3.BucketPolicyStatement {
 	return cr
 }
 
-func TestSerializeBucketPolicyStatement(t *testing.T) {
+func TestSerialize(t *testing.T) {
 	cases := map[string]struct {
 		in  v1alpha3.BucketPolicyStatement
 		out string
@@ -129,22 +129,23 @@ func TestSerializeBucketPolicyStatement(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			js, err := SerializeBucketPolicyStatement(tc.in)
+			got, err := Serialize(&v1alpha3.BucketPolicyBody{Version: "2012-10-17", Statements: []v1alpha3.BucketPolicyStatement{tc.in}})
 
 			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 				return
 			}
 
-			var out interface{}
-			err = json.Unmarshal([]byte(tc.out), &out)
-			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("r: -want, +got:\n%s", diff)
-				return
+			var js, out interface{}
+			if err := json.Unmarshal([]byte(got), &js); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(`{"Version":"2012-10-17","Statement":[`+tc.out+`]}`), &out); err != nil {
+				t.Fatal(err)
 			}
 
-			if diff := cmp.Diff(js, out); diff != "" {
-				t.Errorf("SerializeBucketPolicyStatement(...): -want, +got\n:%s", diff)
+			if diff := cmp.Diff(out, js); diff != "" {
+				t.Errorf("Serialize(...): -want, +got\n:%s", diff)
 			}
 		})
 	}
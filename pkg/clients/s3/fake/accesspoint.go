@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sts"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+// this ensures that the mocks implement the client interfaces
+var (
+	_ clientset.AccessPointClient    = (*MockAccessPointClient)(nil)
+	_ clientset.CallerIdentityClient = (*MockCallerIdentityClient)(nil)
+)
+
+// MockAccessPointClient is a type that implements all the methods for AccessPointClient interface
+type MockAccessPointClient struct {
+	MockCreateAccessPoint       func(ctx aws.Context, input *s3control.CreateAccessPointInput, opts []request.Option) (*s3control.CreateAccessPointOutput, error)
+	MockGetAccessPoint          func(ctx aws.Context, input *s3control.GetAccessPointInput, opts []request.Option) (*s3control.GetAccessPointOutput, error)
+	MockDeleteAccessPoint       func(ctx aws.Context, input *s3control.DeleteAccessPointInput, opts []request.Option) (*s3control.DeleteAccessPointOutput, error)
+	MockGetAccessPointPolicy    func(ctx aws.Context, input *s3control.GetAccessPointPolicyInput, opts []request.Option) (*s3control.GetAccessPointPolicyOutput, error)
+	MockPutAccessPointPolicy    func(ctx aws.Context, input *s3control.PutAccessPointPolicyInput, opts []request.Option) (*s3control.PutAccessPointPolicyOutput, error)
+	MockDeleteAccessPointPolicy func(ctx aws.Context, input *s3control.DeleteAccessPointPolicyInput, opts []request.Option) (*s3control.DeleteAccessPointPolicyOutput, error)
+}
+
+// CreateAccessPointWithContext mocks CreateAccessPointWithContext method
+func (m *MockAccessPointClient) CreateAccessPointWithContext(ctx aws.Context, input *s3control.CreateAccessPointInput, opts ...request.Option) (*s3control.CreateAccessPointOutput, error) {
+	return m.MockCreateAccessPoint(ctx, input, opts)
+}
+
+// GetAccessPointWithContext mocks GetAccessPointWithContext method
+func (m *MockAccessPointClient) GetAccessPointWithContext(ctx aws.Context, input *s3control.GetAccessPointInput, opts ...request.Option) (*s3control.GetAccessPointOutput, error) {
+	return m.MockGetAccessPoint(ctx, input, opts)
+}
+
+// DeleteAccessPointWithContext mocks DeleteAccessPointWithContext method
+func (m *MockAccessPointClient) DeleteAccessPointWithContext(ctx aws.Context, input *s3control.DeleteAccessPointInput, opts ...request.Option) (*s3control.DeleteAccessPointOutput, error) {
+	return m.MockDeleteAccessPoint(ctx, input, opts)
+}
+
+// GetAccessPointPolicyWithContext mocks GetAccessPointPolicyWithContext method
+func (m *MockAccessPointClient) GetAccessPointPolicyWithContext(ctx aws.Context, input *s3control.GetAccessPointPolicyInput, opts ...request.Option) (*s3control.GetAccessPointPolicyOutput, error) {
+	return m.MockGetAccessPointPolicy(ctx, input, opts)
+}
+
+// PutAccessPointPolicyWithContext mocks PutAccessPointPolicyWithContext method
+func (m *MockAccessPointClient) PutAccessPointPolicyWithContext(ctx aws.Context, input *s3control.PutAccessPointPolicyInput, opts ...request.Option) (*s3control.PutAccessPointPolicyOutput, error) {
+	return m.MockPutAccessPointPolicy(ctx, input, opts)
+}
+
+// DeleteAccessPointPolicyWithContext mocks DeleteAccessPointPolicyWithContext method
+func (m *MockAccessPointClient) DeleteAccessPointPolicyWithContext(ctx aws.Context, input *s3control.DeleteAccessPointPolicyInput, opts ...request.Option) (*s3control.DeleteAccessPointPolicyOutput, error) {
+	return m.MockDeleteAccessPointPolicy(ctx, input, opts)
+}
+
+// MockCallerIdentityClient is a type that implements all the methods for CallerIdentityClient interface
+type MockCallerIdentityClient struct {
+	MockGetCallerIdentity func(ctx aws.Context, input *sts.GetCallerIdentityInput, opts []request.Option) (*sts.GetCallerIdentityOutput, error)
+}
+
+// GetCallerIdentityWithContext mocks GetCallerIdentityWithContext method
+func (m *MockCallerIdentityClient) GetCallerIdentityWithContext(ctx aws.Context, input *sts.GetCallerIdentityInput, opts ...request.Option) (*sts.GetCallerIdentityOutput, error) {
+	return m.MockGetCallerIdentity(ctx, input, opts)
+}
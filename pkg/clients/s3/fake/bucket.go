@@ -33,6 +33,9 @@ type MockBucketClient struct {
 	MockCreateBucket func(ctx context.Context, input *s3.CreateBucketInput, opts []func(*s3.Options)) (*s3.CreateBucketOutput, error)
 	MockDeleteBucket func(ctx context.Context, input *s3.DeleteBucketInput, opts []func(*s3.Options)) (*s3.DeleteBucketOutput, error)
 
+	MockListObjectVersions func(ctx context.Context, input *s3.ListObjectVersionsInput, opts []func(*s3.Options)) (*s3.ListObjectVersionsOutput, error)
+	MockDeleteObjects      func(ctx context.Context, input *s3.DeleteObjectsInput, opts []func(*s3.Options)) (*s3.DeleteObjectsOutput, error)
+
 	MockPutBucketEncryption    func(ctx context.Context, input *s3.PutBucketEncryptionInput, opts []func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error)
 	MockGetBucketEncryption    func(ctx context.Context, input *s3.GetBucketEncryptionInput, opts []func(*s3.Options)) (*s3.GetBucketEncryptionOutput, error)
 	MockDeleteBucketEncryption func(ctx context.Context, input *s3.DeleteBucketEncryptionInput, opts []func(*s3.Options)) (*s3.DeleteBucketEncryptionOutput, error)
@@ -65,8 +68,22 @@ type MockBucketClient struct {
 	MockGetBucketTagging    func(ctx context.Context, input *s3.GetBucketTaggingInput, opts []func(*s3.Options)) (*s3.GetBucketTaggingOutput, error)
 	MockDeleteBucketTagging func(ctx context.Context, input *s3.DeleteBucketTaggingInput, opts []func(*s3.Options)) (*s3.DeleteBucketTaggingOutput, error)
 
-	MockPutBucketAnalyticsConfiguration func(ctx context.Context, input *s3.PutBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error)
-	MockGetBucketAnalyticsConfiguration func(ctx context.Context, input *s3.GetBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.GetBucketAnalyticsConfigurationOutput, error)
+	MockPutBucketAnalyticsConfiguration    func(ctx context.Context, input *s3.PutBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error)
+	MockGetBucketAnalyticsConfiguration    func(ctx context.Context, input *s3.GetBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.GetBucketAnalyticsConfigurationOutput, error)
+	MockListBucketAnalyticsConfigurations  func(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error)
+	MockDeleteBucketAnalyticsConfiguration func(ctx context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error)
+
+	MockListBucketInventoryConfigurations  func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error)
+	MockPutBucketInventoryConfiguration    func(ctx context.Context, input *s3.PutBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error)
+	MockDeleteBucketInventoryConfiguration func(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error)
+
+	MockListBucketMetricsConfigurations  func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error)
+	MockPutBucketMetricsConfiguration    func(ctx context.Context, input *s3.PutBucketMetricsConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error)
+	MockDeleteBucketMetricsConfiguration func(ctx context.Context, input *s3.DeleteBucketMetricsConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error)
+
+	MockListBucketIntelligentTieringConfigurations  func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error)
+	MockPutBucketIntelligentTieringConfiguration    func(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error)
+	MockDeleteBucketIntelligentTieringConfiguration func(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error)
 
 	MockPutBucketLifecycleConfiguration func(ctx context.Context, input *s3.PutBucketLifecycleConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error)
 	MockGetBucketLifecycleConfiguration func(ctx context.Context, input *s3.GetBucketLifecycleConfigurationInput, opts []func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error)
@@ -81,6 +98,13 @@ type MockBucketClient struct {
 	MockGetPublicAccessBlock    func(ctx context.Context, input *s3.GetPublicAccessBlockInput, opts []func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error)
 	MockPutPublicAccessBlock    func(ctx context.Context, input *s3.PutPublicAccessBlockInput, opts []func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error)
 	MockDeletePublicAccessBlock func(ctx context.Context, input *s3.DeletePublicAccessBlockInput, opts []func(*s3.Options)) (*s3.DeletePublicAccessBlockOutput, error)
+
+	MockGetBucketOwnershipControls    func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error)
+	MockPutBucketOwnershipControls    func(ctx context.Context, input *s3.PutBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error)
+	MockDeleteBucketOwnershipControls func(ctx context.Context, input *s3.DeleteBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error)
+
+	MockGetObjectLockConfiguration func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error)
+	MockPutObjectLockConfiguration func(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error)
 }
 
 // HeadBucket is the fake method call to invoke the internal mock method
@@ -98,6 +122,16 @@ func (m MockBucketClient) DeleteBucket(ctx context.Context, input *s3.DeleteBuck
 	return m.MockDeleteBucket(ctx, input, opts)
 }
 
+// ListObjectVersions is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListObjectVersions(ctx context.Context, input *s3.ListObjectVersionsInput, opts ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+	return m.MockListObjectVersions(ctx, input, opts)
+}
+
+// DeleteObjects is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteObjects(ctx context.Context, input *s3.DeleteObjectsInput, opts ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+	return m.MockDeleteObjects(ctx, input, opts)
+}
+
 // PutBucketEncryption is the fake method call to invoke the internal mock method
 func (m MockBucketClient) PutBucketEncryption(ctx context.Context, input *s3.PutBucketEncryptionInput, opts ...func(*s3.Options)) (*s3.PutBucketEncryptionOutput, error) {
 	return m.MockPutBucketEncryption(ctx, input, opts)
@@ -223,6 +257,61 @@ func (m MockBucketClient) GetBucketAnalyticsConfiguration(ctx context.Context, i
 	return m.MockGetBucketAnalyticsConfiguration(ctx, input, opts)
 }
 
+// ListBucketAnalyticsConfigurations is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketAnalyticsConfigurations(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+	return m.MockListBucketAnalyticsConfigurations(ctx, input, opts)
+}
+
+// DeleteBucketAnalyticsConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketAnalyticsConfiguration(ctx context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
+	return m.MockDeleteBucketAnalyticsConfiguration(ctx, input, opts)
+}
+
+// ListBucketInventoryConfigurations is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketInventoryConfigurations(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+	return m.MockListBucketInventoryConfigurations(ctx, input, opts)
+}
+
+// PutBucketInventoryConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutBucketInventoryConfiguration(ctx context.Context, input *s3.PutBucketInventoryConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+	return m.MockPutBucketInventoryConfiguration(ctx, input, opts)
+}
+
+// DeleteBucketInventoryConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketInventoryConfiguration(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+	return m.MockDeleteBucketInventoryConfiguration(ctx, input, opts)
+}
+
+// ListBucketMetricsConfigurations is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketMetricsConfigurations(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+	return m.MockListBucketMetricsConfigurations(ctx, input, opts)
+}
+
+// PutBucketMetricsConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutBucketMetricsConfiguration(ctx context.Context, input *s3.PutBucketMetricsConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error) {
+	return m.MockPutBucketMetricsConfiguration(ctx, input, opts)
+}
+
+// DeleteBucketMetricsConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketMetricsConfiguration(ctx context.Context, input *s3.DeleteBucketMetricsConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error) {
+	return m.MockDeleteBucketMetricsConfiguration(ctx, input, opts)
+}
+
+// ListBucketIntelligentTieringConfigurations is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketIntelligentTieringConfigurations(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	return m.MockListBucketIntelligentTieringConfigurations(ctx, input, opts)
+}
+
+// PutBucketIntelligentTieringConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutBucketIntelligentTieringConfiguration(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+	return m.MockPutBucketIntelligentTieringConfiguration(ctx, input, opts)
+}
+
+// DeleteBucketIntelligentTieringConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketIntelligentTieringConfiguration(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+	return m.MockDeleteBucketIntelligentTieringConfiguration(ctx, input, opts)
+}
+
 // PutBucketLifecycleConfiguration is the fake method call to invoke the internal mock method
 func (m MockBucketClient) PutBucketLifecycleConfiguration(ctx context.Context, input *s3.PutBucketLifecycleConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error) {
 	return m.MockPutBucketLifecycleConfiguration(ctx, input, opts)
@@ -272,3 +361,28 @@ func (m MockBucketClient) PutPublicAccessBlock(ctx context.Context, input *s3.Pu
 func (m MockBucketClient) DeletePublicAccessBlock(ctx context.Context, input *s3.DeletePublicAccessBlockInput, opts ...func(*s3.Options)) (*s3.DeletePublicAccessBlockOutput, error) {
 	return m.MockDeletePublicAccessBlock(ctx, input, opts)
 }
+
+// GetBucketOwnershipControls is the fake method call to invoke the internal mock method
+func (m MockBucketClient) GetBucketOwnershipControls(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+	return m.MockGetBucketOwnershipControls(ctx, input, opts)
+}
+
+// PutBucketOwnershipControls is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutBucketOwnershipControls(ctx context.Context, input *s3.PutBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error) {
+	return m.MockPutBucketOwnershipControls(ctx, input, opts)
+}
+
+// DeleteBucketOwnershipControls is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketOwnershipControls(ctx context.Context, input *s3.DeleteBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error) {
+	return m.MockDeleteBucketOwnershipControls(ctx, input, opts)
+}
+
+// GetObjectLockConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) GetObjectLockConfiguration(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts ...func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+	return m.MockGetObjectLockConfiguration(ctx, input, opts)
+}
+
+// PutObjectLockConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutObjectLockConfiguration(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+	return m.MockPutObjectLockConfiguration(ctx, input, opts)
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ObjectClient = (*MockObjectClient)(nil)
+
+// MockObjectClient is a type that implements all the methods for ObjectClient interface
+type MockObjectClient struct {
+	MockHeadObject       func(ctx context.Context, input *s3.HeadObjectInput, opts []func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	MockPutObject        func(ctx context.Context, input *s3.PutObjectInput, opts []func(*s3.Options)) (*s3.PutObjectOutput, error)
+	MockDeleteObject     func(ctx context.Context, input *s3.DeleteObjectInput, opts []func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	MockGetObjectTagging func(ctx context.Context, input *s3.GetObjectTaggingInput, opts []func(*s3.Options)) (*s3.GetObjectTaggingOutput, error)
+}
+
+// HeadObject mocks HeadObject method
+func (m *MockObjectClient) HeadObject(ctx context.Context, input *s3.HeadObjectInput, opts ...func(*s3.Options)) (*s3.HeadObjectOutput, error) {
+	return m.MockHeadObject(ctx, input, opts)
+}
+
+// PutObject mocks PutObject method
+func (m *MockObjectClient) PutObject(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	return m.MockPutObject(ctx, input, opts)
+}
+
+// DeleteObject mocks DeleteObject method
+func (m *MockObjectClient) DeleteObject(ctx context.Context, input *s3.DeleteObjectInput, opts ...func(*s3.Options)) (*s3.DeleteObjectOutput, error) {
+	return m.MockDeleteObject(ctx, input, opts)
+}
+
+// GetObjectTagging mocks GetObjectTagging method
+func (m *MockObjectClient) GetObjectTagging(ctx context.Context, input *s3.GetObjectTaggingInput, opts ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error) {
+	return m.MockGetObjectTagging(ctx, input, opts)
+}
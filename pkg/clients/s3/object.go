@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// MetadataKeyContentSHA256 is the key of the user-defined metadata of an
+// Object that holds the hex encoded SHA-256 checksum of its content.
+const MetadataKeyContentSHA256 = "content-sha256"
+
+// ObjectClient is the external client used for Object Custom Resource
+type ObjectClient interface {
+	HeadObject(ctx context.Context, input *s3.HeadObjectInput, opts ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	PutObject(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	DeleteObject(ctx context.Context, input *s3.DeleteObjectInput, opts ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	GetObjectTagging(ctx context.Context, input *s3.GetObjectTaggingInput, opts ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error)
+}
+
+// NewObjectClient returns a new client given an aws config
+func NewObjectClient(cfg aws.Config) ObjectClient {
+	return s3.NewFromConfig(cfg)
+}
+
+// ContentSHA256 returns the hex encoded SHA-256 checksum of the supplied
+// Object content.
+func ContentSHA256(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// GeneratePutObjectInput returns the input for the PutObject request that
+// stores the supplied content.
+func GeneratePutObjectInput(p v1alpha3.ObjectParameters, content []byte) *s3.PutObjectInput {
+	input := &s3.PutObjectInput{
+		Bucket:      p.BucketName,
+		Key:         awsclient.String(p.Key),
+		Body:        bytes.NewReader(content),
+		ContentType: p.ContentType,
+		Metadata:    map[string]string{MetadataKeyContentSHA256: ContentSHA256(content)},
+		SSEKMSKeyId: p.SSEKMSKeyID,
+	}
+	if p.ServerSideEncryption != nil {
+		input.ServerSideEncryption = s3types.ServerSideEncryption(*p.ServerSideEncryption)
+	}
+	if len(p.Tags) != 0 {
+		v := url.Values{}
+		for _, t := range p.Tags {
+			v.Add(t.Key, t.Value)
+		}
+		input.Tagging = awsclient.String(v.Encode())
+	}
+	return input
+}
+
+// IsObjectUpToDate returns true if the supplied content and parameters match
+// the observed Object and its tags.
+func IsObjectUpToDate(p v1alpha3.ObjectParameters, content []byte, head *s3.HeadObjectOutput, tags []s3types.Tag) bool { // nolint:gocyclo
+	switch {
+	case head.Metadata[MetadataKeyContentSHA256] != ContentSHA256(content):
+		return false
+	case p.ContentType != nil && *p.ContentType != awsclient.StringValue(head.ContentType):
+		return false
+	case p.ServerSideEncryption != nil && *p.ServerSideEncryption != string(head.ServerSideEncryption):
+		return false
+	case p.SSEKMSKeyID != nil && !isKMSKey(*p.SSEKMSKeyID, awsclient.StringValue(head.SSEKMSKeyId)):
+		return false
+	}
+	local := make([]s3types.Tag, len(p.Tags))
+	for i, t := range p.Tags {
+		local[i] = s3types.Tag{Key: awsclient.String(t.Key), Value: awsclient.String(t.Value)}
+	}
+	return cmp.Equal(SortS3TagSet(local), SortS3TagSet(tags), cmpopts.EquateEmpty(), cmpopts.IgnoreTypes(document.NoSerde{}))
+}
+
+// isKMSKey returns true if the supplied key ID or ARN identifies the key with
+// the supplied ARN. S3 reports the ARN of the key that encrypts an Object.
+func isKMSKey(id, arn string) bool {
+	return id == arn || strings.HasSuffix(arn, ":key/"+id)
+}
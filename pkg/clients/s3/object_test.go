@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func TestIsObjectUpToDate(t *testing.T) {
+	content := []byte("<html></html>")
+	kms := "aws:kms"
+	keyID := "1234abcd-12ab-34cd-56ef-1234567890ab"
+	keyARN := "arn:aws:kms:us-east-1:111122223333:key/" + keyID
+	upToDate := func() *s3.HeadObjectOutput {
+		return &s3.HeadObjectOutput{
+			ContentType:          awsclient.String("text/html"),
+			Metadata:             map[string]string{MetadataKeyContentSHA256: ContentSHA256(content)},
+			ServerSideEncryption: s3types.ServerSideEncryptionAwsKms,
+			SSEKMSKeyId:          awsclient.String(keyARN),
+		}
+	}
+	params := v1alpha3.ObjectParameters{
+		ContentType:          awsclient.String("text/html"),
+		ServerSideEncryption: &kms,
+		SSEKMSKeyID:          &keyID,
+		Tags:                 []v1alpha3.Tag{{Key: "b", Value: "2"}, {Key: "a", Value: "1"}},
+	}
+	tags := []s3types.Tag{
+		{Key: awsclient.String("a"), Value: awsclient.String("1")},
+		{Key: awsclient.String("b"), Value: awsclient.String("2")},
+	}
+
+	cases := map[string]struct {
+		head *s3.HeadObjectOutput
+		tags []s3types.Tag
+		want bool
+	}{
+		"UpToDate": {
+			head: upToDate(),
+			tags: tags,
+			want: true,
+		},
+		"ContentChanged": {
+			head: func() *s3.HeadObjectOutput {
+				h := upToDate()
+				h.Metadata = nil
+				return h
+			}(),
+			tags: tags,
+			want: false,
+		},
+		"ContentTypeChanged": {
+			head: func() *s3.HeadObjectOutput {
+				h := upToDate()
+				h.ContentType = awsclient.String("binary/octet-stream")
+				return h
+			}(),
+			tags: tags,
+			want: false,
+		},
+		"KMSKeyChanged": {
+			head: func() *s3.HeadObjectOutput {
+				h := upToDate()
+				h.SSEKMSKeyId = awsclient.String("arn:aws:kms:us-east-1:111122223333:key/other")
+				return h
+			}(),
+			tags: tags,
+			want: false,
+		},
+		"TagsChanged": {
+			head: upToDate(),
+			tags: tags[:1],
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsObjectUpToDate(params, content, tc.head, tc.tags)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsObjectUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePutObjectInputTagging(t *testing.T) {
+	p := v1alpha3.ObjectParameters{
+		Key:  "index.html",
+		Tags: []v1alpha3.Tag{{Key: "team", Value: "web & mobile"}, {Key: "app", Value: "site"}},
+	}
+	got := GeneratePutObjectInput(p, nil)
+	if diff := cmp.Diff("app=site&team=web+%26+mobile", awsclient.StringValue(got.Tagging)); diff != "" {
+		t.Errorf("GeneratePutObjectInput(...).Tagging: -want, +got:\n%s", diff)
+	}
+}
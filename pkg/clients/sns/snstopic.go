@@ -18,15 +18,16 @@ package sns
 
 import (
 	"context"
-	"errors"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
 	snstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/pkg/errors"
 
 	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
 )
 
 // TopicAttributes refers to AWS SNS Topic Attributes List
@@ -54,6 +55,8 @@ const (
 	TopicARN TopicAttributes = "TopicArn"
 )
 
+const errPolicy = "cannot serialize policy"
+
 // TopicClient is the external client used for AWS SNSTopic
 type TopicClient interface {
 	CreateTopic(ctx context.Context, input *sns.CreateTopicInput, opts ...func(*sns.Options)) (*sns.CreateTopicOutput, error)
@@ -92,8 +95,17 @@ func LateInitializeTopicAttr(in *v1alpha1.SNSTopicParameters, attrs map[string]s
 	in.DisplayName = awsclients.LateInitializeStringPtr(in.DisplayName, aws.String(attrs[string(TopicDisplayName)]))
 	in.DeliveryPolicy = awsclients.LateInitializeStringPtr(in.DeliveryPolicy, aws.String(attrs[string(TopicDeliveryPolicy)]))
 	in.KMSMasterKeyID = awsclients.LateInitializeStringPtr(in.KMSMasterKeyID, aws.String(attrs[string(TopicKmsMasterKeyID)]))
-	in.Policy = awsclients.LateInitializeStringPtr(in.Policy, aws.String(attrs[string(TopicPolicy)]))
+	if in.PolicyBody == nil {
+		if policy, err := ownPolicy(attrs[string(TopicPolicy)]); err == nil {
+			in.Policy = awsclients.LateInitializeStringPtr(in.Policy, aws.String(policy))
+		}
+	}
+}
 
+// ownPolicy returns the supplied policy of a topic without the statements
+// Buckets add to publish notifications to it, which the Buckets manage.
+func ownPolicy(doc string) (string, error) {
+	return policyclient.RemoveStatements(doc, policyclient.BucketNotificationSIDPrefix)
 }
 
 // GetChangedAttributes will return the changed attributes for a topic in AWS side.
@@ -102,16 +114,31 @@ func LateInitializeTopicAttr(in *v1alpha1.SNSTopicParameters, attrs map[string]s
 // Please see https://docs.aws.amazon.com/sns/latest/api/API_SetTopicAttributes.html
 // So we need to compare each topic attribute and call SetTopicAttribute for ones which has
 // changed.
-func GetChangedAttributes(p v1alpha1.SNSTopicParameters, attrs map[string]string) map[string]string {
-	topicAttrs := getTopicAttributes(p)
+func GetChangedAttributes(p v1alpha1.SNSTopicParameters, attrs map[string]string) (map[string]string, error) {
+	topicAttrs, err := getTopicAttributes(p)
+	if err != nil {
+		return nil, err
+	}
 	changedAttrs := make(map[string]string)
 	for k, v := range topicAttrs {
+		if k == string(TopicPolicy) {
+			own, err := ownPolicy(attrs[k])
+			if err != nil {
+				return nil, errors.Wrap(err, errPolicy)
+			}
+			if policyclient.Equal(v, own) {
+				continue
+			}
+			if v, err = policyclient.CopyStatements(v, attrs[k], policyclient.BucketNotificationSIDPrefix); err != nil {
+				return nil, errors.Wrap(err, errPolicy)
+			}
+		}
 		if v != attrs[k] {
 			changedAttrs[k] = v
 		}
 	}
 
-	return changedAttrs
+	return changedAttrs, nil
 }
 
 // GenerateTopicObservation is used to produce SNSTopicObservation from attributes
@@ -138,23 +165,35 @@ func GenerateTopicObservation(attr map[string]string) v1alpha1.SNSTopicObservati
 }
 
 // IsSNSTopicUpToDate checks if object is up to date
-func IsSNSTopicUpToDate(p v1alpha1.SNSTopicParameters, attr map[string]string) bool {
+func IsSNSTopicUpToDate(p v1alpha1.SNSTopicParameters, attr map[string]string) (bool, error) {
+	policy, err := policyclient.Render(p.Policy, p.PolicyBody)
+	if err != nil {
+		return false, errors.Wrap(err, errPolicy)
+	}
+	own, err := ownPolicy(attr[string(TopicPolicy)])
+	if err != nil {
+		return false, errors.Wrap(err, errPolicy)
+	}
 	return aws.ToString(p.DeliveryPolicy) == attr[string(TopicDeliveryPolicy)] &&
 		aws.ToString(p.DisplayName) == attr[string(TopicDisplayName)] &&
 		aws.ToString(p.KMSMasterKeyID) == attr[string(TopicKmsMasterKeyID)] &&
-		aws.ToString(p.Policy) == attr[string(TopicPolicy)]
+		policyclient.Equal(aws.ToString(policy), own), nil
 }
 
-func getTopicAttributes(p v1alpha1.SNSTopicParameters) map[string]string {
+func getTopicAttributes(p v1alpha1.SNSTopicParameters) (map[string]string, error) {
+	policy, err := policyclient.Render(p.Policy, p.PolicyBody)
+	if err != nil {
+		return nil, errors.Wrap(err, errPolicy)
+	}
 
 	topicAttr := make(map[string]string)
 
 	topicAttr[string(TopicDeliveryPolicy)] = aws.ToString(p.DeliveryPolicy)
 	topicAttr[string(TopicDisplayName)] = aws.ToString(p.DisplayName)
 	topicAttr[string(TopicKmsMasterKeyID)] = aws.ToString(p.KMSMasterKeyID)
-	topicAttr[string(TopicPolicy)] = aws.ToString(p.Policy)
+	topicAttr[string(TopicPolicy)] = aws.ToString(policy)
 
-	return topicAttr
+	return topicAttr, nil
 }
 
 // IsTopicNotFound returns true if the error code indicates that the item was not found
@@ -29,6 +29,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 
 	"github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	policyv1alpha1 "github.com/crossplane/provider-aws/apis/policy/v1alpha1"
 )
 
 var (
@@ -44,8 +45,21 @@ var (
 	tagValue1         = "value-1"
 	tagKey2           = "name-2"
 	tagValue2         = "value-2"
+	topicPolicy       = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sns:Publish", "Resource": "sometopicArn"}]}`
+	bucketPolicy      = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sns:Publish", "Resource": "sometopicArn"}, {"Sid": "S3BucketNotification0123", "Effect": "Allow", "Principal": {"Service": "s3.amazonaws.com"}, "Action": "sns:Publish", "Resource": "sometopicArn"}]}`
 )
 
+func topicPolicyBody() *policyv1alpha1.Document {
+	return &policyv1alpha1.Document{
+		Version: "2012-10-17",
+		Statements: []policyv1alpha1.Statement{{
+			Effect:   "Allow",
+			Action:   []string{"sns:Publish"},
+			Resource: []string{topicArn},
+		}},
+	}
+}
+
 // Topic Attribute Modifier
 type topicAttrModifier func(*map[string]string)
 
@@ -86,6 +100,12 @@ func withAttrDisplayName(s *string) topicAttrModifier {
 	}
 }
 
+func withAttrPolicy(s *string) topicAttrModifier {
+	return func(attr *map[string]string) {
+		(*attr)[string(TopicPolicy)] = *s
+	}
+}
+
 // topic Observation Modifier
 type topicObservationModifier func(*v1alpha1.SNSTopicObservation)
 
@@ -178,6 +198,46 @@ func TestGenerateCreateTopicInput(t *testing.T) {
 	}
 }
 
+func TestLateInitializeTopicAttr(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      v1alpha1.SNSTopicParameters
+		attr   *map[string]string
+		want   v1alpha1.SNSTopicParameters
+	}{
+		"Policy": {
+			reason: "The policy of the topic should be late initialized.",
+			attr:   topicAttributes(withAttrPolicy(&topicPolicy), withAttrDisplayName(&topicDisplayName)),
+			want:   v1alpha1.SNSTopicParameters{Policy: &topicPolicy, DisplayName: &topicDisplayName, DeliveryPolicy: &empty, KMSMasterKeyID: &empty},
+		},
+		"BucketStatement": {
+			reason: "Statements that Buckets add to the policy should not be late initialized.",
+			attr:   topicAttributes(withAttrPolicy(&bucketPolicy)),
+			want: v1alpha1.SNSTopicParameters{
+				Policy:         aws.String(`{"Statement":[{"Action":"sns:Publish","Effect":"Allow","Resource":"sometopicArn"}],"Version":"2012-10-17"}`),
+				DisplayName:    &empty,
+				DeliveryPolicy: &empty,
+				KMSMasterKeyID: &empty,
+			},
+		},
+		"PolicyBody": {
+			reason: "The policy should not be late initialized if a policy body is specified.",
+			p:      v1alpha1.SNSTopicParameters{PolicyBody: topicPolicyBody()},
+			attr:   topicAttributes(withAttrPolicy(&topicPolicy)),
+			want:   v1alpha1.SNSTopicParameters{PolicyBody: topicPolicyBody(), DisplayName: &empty, DeliveryPolicy: &empty, KMSMasterKeyID: &empty},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeTopicAttr(&tc.p, *tc.attr)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("\n%s\nLateInitializeTopicAttr(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestGetChangedAttributes(t *testing.T) {
 
 	type args struct {
@@ -215,11 +275,52 @@ func TestGetChangedAttributes(t *testing.T) {
 				withAttrDisplayName(&topicDisplayName),
 			),
 		},
+		"NoPolicyBodyChange": {
+			args: args{
+				p: v1alpha1.SNSTopicParameters{
+					Name:       topicName,
+					PolicyBody: topicPolicyBody(),
+				},
+				attr: topicAttributes(
+					withAttrPolicy(&topicPolicy),
+				),
+			},
+			want: topicAttributes(),
+		},
+		"NoBucketStatementChange": {
+			args: args{
+				p: v1alpha1.SNSTopicParameters{
+					Name:       topicName,
+					PolicyBody: topicPolicyBody(),
+				},
+				attr: topicAttributes(
+					withAttrPolicy(&bucketPolicy),
+				),
+			},
+			want: topicAttributes(),
+		},
+		"PolicyChangeKeepsBucketStatement": {
+			args: args{
+				p: v1alpha1.SNSTopicParameters{
+					Name:   topicName,
+					Policy: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"sns:Publish","Resource":"sometopicArn"}]}`),
+				},
+				attr: topicAttributes(
+					withAttrPolicy(&bucketPolicy),
+				),
+			},
+			want: topicAttributes(
+				withAttrPolicy(aws.String(`{"Statement":[{"Action":"sns:Publish","Effect":"Deny","Resource":"sometopicArn"},{"Action":"sns:Publish","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Resource":"sometopicArn","Sid":"S3BucketNotification0123"}],"Version":"2012-10-17"}`)),
+			),
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			c := GetChangedAttributes(tc.args.p, *tc.args.attr)
+			c, err := GetChangedAttributes(tc.args.p, *tc.args.attr)
+			if err != nil {
+				t.Fatalf("GetChangedAttributes(...): %s", err)
+			}
 			if diff := cmp.Diff(*tc.want, c); diff != "" {
 				t.Errorf("GetChangedAttributes(...): -want, +got:\n%s", diff)
 			}
@@ -303,11 +404,36 @@ func TestIsSNSTopicUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"SamePolicyBody": {
+			args: args{
+				attr: topicAttributes(
+					withAttrPolicy(&topicPolicy),
+				),
+				p: v1alpha1.SNSTopicParameters{
+					PolicyBody: topicPolicyBody(),
+				},
+			},
+			want: true,
+		},
+		"BucketStatement": {
+			args: args{
+				attr: topicAttributes(
+					withAttrPolicy(&bucketPolicy),
+				),
+				p: v1alpha1.SNSTopicParameters{
+					PolicyBody: topicPolicyBody(),
+				},
+			},
+			want: true,
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := IsSNSTopicUpToDate(tc.args.p, *tc.args.attr)
+			got, err := IsSNSTopicUpToDate(tc.args.p, *tc.args.attr)
+			if err != nil {
+				t.Fatalf("IsSNSTopicUpToDate(...): %s", err)
+			}
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("Topic : -want, +got:\n%s", diff)
 			}
@@ -19,7 +19,6 @@ package sqs
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"strconv"
 	"strings"
 	"time"
@@ -30,17 +29,21 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/smithy-go"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
 	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
 )
 
 const (
 	// QueueNotFound is the code that is returned by AWS when the given QueueURL is not valid
 	QueueNotFound = "AWS.SimpleQueueService.NonExistentQueue"
+
+	errPolicy = "cannot serialize policy"
 )
 
 // Client defines Queue client operations
@@ -61,8 +64,11 @@ func NewClient(cfg aws.Config) Client {
 }
 
 // GenerateCreateAttributes returns a map of queue attributes for Create operation
-func GenerateCreateAttributes(p *v1beta1.QueueParameters) map[string]string {
-	m := GenerateQueueAttributes(p)
+func GenerateCreateAttributes(p *v1beta1.QueueParameters) (map[string]string, error) {
+	m, err := GenerateQueueAttributes(p)
+	if err != nil {
+		return nil, err
+	}
 	if aws.ToBool(p.FIFOQueue) {
 		// SQS expects this attribute only if its value is true.
 		// https://github.com/aws/aws-sdk-php/issues/1331
@@ -71,12 +77,16 @@ func GenerateCreateAttributes(p *v1beta1.QueueParameters) map[string]string {
 		}
 		m[v1beta1.AttributeFifoQueue] = "true"
 	}
-	return m
+	return m, nil
 }
 
 // GenerateQueueAttributes returns a map of queue attributes
-func GenerateQueueAttributes(p *v1beta1.QueueParameters) map[string]string { // nolint:gocyclo
+func GenerateQueueAttributes(p *v1beta1.QueueParameters) (map[string]string, error) { // nolint:gocyclo
 	m := map[string]string{}
+	policy, err := policyclient.Render(p.Policy, p.PolicyBody)
+	if err != nil {
+		return nil, errors.Wrap(err, errPolicy)
+	}
 	if p.DelaySeconds != nil {
 		m[v1beta1.AttributeDelaySeconds] = strconv.FormatInt(aws.ToInt64(p.DelaySeconds), 10)
 	}
@@ -86,8 +96,8 @@ func GenerateQueueAttributes(p *v1beta1.QueueParameters) map[string]string { //
 	if p.MessageRetentionPeriod != nil {
 		m[v1beta1.AttributeMessageRetentionPeriod] = strconv.FormatInt(aws.ToInt64(p.MessageRetentionPeriod), 10)
 	}
-	if p.Policy != nil {
-		m[v1beta1.AttributePolicy] = aws.ToString(p.Policy)
+	if policy != nil {
+		m[v1beta1.AttributePolicy] = aws.ToString(policy)
 	}
 	if p.ReceiveMessageWaitTimeSeconds != nil {
 		m[v1beta1.AttributeReceiveMessageWaitTimeSeconds] = strconv.FormatInt(aws.ToInt64(p.ReceiveMessageWaitTimeSeconds), 10)
@@ -118,9 +128,9 @@ func GenerateQueueAttributes(p *v1beta1.QueueParameters) map[string]string { //
 		m[v1beta1.AttributeContentBasedDeduplication] = strconv.FormatBool(aws.ToBool(p.ContentBasedDeduplication))
 	}
 	if len(m) == 0 {
-		return nil
+		return nil, nil
 	}
-	return m
+	return m, nil
 }
 
 // GenerateQueueObservation returns a QueueObservation with information retrieved
@@ -172,44 +182,53 @@ func LateInitialize(in *v1beta1.QueueParameters, attributes map[string]string, t
 }
 
 // IsUpToDate checks whether there is a change in any of the modifiable fields.
-func IsUpToDate(p v1beta1.QueueParameters, attributes map[string]string, tags map[string]string) bool { // nolint:gocyclo
+func IsUpToDate(p v1beta1.QueueParameters, attributes map[string]string, tags map[string]string) (bool, error) { // nolint:gocyclo
+	policy, err := policyclient.Render(p.Policy, p.PolicyBody)
+	if err != nil {
+		return false, errors.Wrap(err, errPolicy)
+	}
+
 	if len(p.Tags) != len(tags) {
-		return false
+		return false, nil
 	}
 
 	for k, v := range p.Tags {
 		pVal, ok := tags[k]
 		if !ok || !strings.EqualFold(pVal, v) {
-			return false
+			return false, nil
 		}
 	}
 
 	if aws.ToInt64(p.DelaySeconds) != toInt64(attributes[v1beta1.AttributeDelaySeconds]) {
-		return false
+		return false, nil
 	}
 	if aws.ToInt64(p.KMSDataKeyReusePeriodSeconds) != toInt64(attributes[v1beta1.AttributeKmsDataKeyReusePeriodSeconds]) {
-		return false
+		return false, nil
 	}
 	if aws.ToInt64(p.MaximumMessageSize) != toInt64(attributes[v1beta1.AttributeMaximumMessageSize]) {
-		return false
+		return false, nil
 	}
 	if aws.ToInt64(p.MessageRetentionPeriod) != toInt64(attributes[v1beta1.AttributeMessageRetentionPeriod]) {
-		return false
+		return false, nil
 	}
 	if aws.ToInt64(p.ReceiveMessageWaitTimeSeconds) != toInt64(attributes[v1beta1.AttributeReceiveMessageWaitTimeSeconds]) {
-		return false
+		return false, nil
 	}
 	if aws.ToInt64(p.VisibilityTimeout) != toInt64(attributes[v1beta1.AttributeVisibilityTimeout]) {
-		return false
+		return false, nil
 	}
 	if !cmp.Equal(aws.ToString(p.KMSMasterKeyID), attributes[v1beta1.AttributeKmsMasterKeyID]) {
-		return false
+		return false, nil
 	}
-	if !cmp.Equal(aws.ToString(p.Policy), attributes[v1beta1.AttributePolicy]) {
-		return false
+	own, err := policyclient.RemoveStatements(attributes[v1beta1.AttributePolicy], policyclient.BucketNotificationSIDPrefix)
+	if err != nil {
+		return false, errors.Wrap(err, errPolicy)
+	}
+	if !policyclient.Equal(aws.ToString(policy), own) {
+		return false, nil
 	}
 	if attributes[v1beta1.AttributeContentBasedDeduplication] != "" && strconv.FormatBool(aws.ToBool(p.ContentBasedDeduplication)) != attributes[v1beta1.AttributeContentBasedDeduplication] {
-		return false
+		return false, nil
 	}
 	if p.RedrivePolicy != nil {
 		r := map[string]interface{}{
@@ -219,11 +238,28 @@ func IsUpToDate(p v1beta1.QueueParameters, attributes map[string]string, tags ma
 		val, err := json.Marshal(r)
 		if err == nil {
 			if string(val) != attributes[v1beta1.AttributeRedrivePolicy] {
-				return false
+				return false, nil
 			}
 		}
 	}
-	return true
+	return true, nil
+}
+
+// KeepBucketStatements adds the statements Buckets added to the supplied
+// current policy of a queue to the policy set by the supplied attributes, if
+// any, so that updating the queue does not stop the Buckets from publishing
+// notifications to it.
+func KeepBucketStatements(attributes map[string]string, current string) error {
+	policy, ok := attributes[v1beta1.AttributePolicy]
+	if !ok {
+		return nil
+	}
+	policy, err := policyclient.CopyStatements(policy, current, policyclient.BucketNotificationSIDPrefix)
+	if err != nil {
+		return errors.Wrap(err, errPolicy)
+	}
+	attributes[v1beta1.AttributePolicy] = policy
+	return nil
 }
 
 // TagsDiff returns the tags added and removed from spec when compared to the AWS SQS tags.
@@ -26,6 +26,7 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
+	policyv1alpha1 "github.com/crossplane/provider-aws/apis/policy/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
 )
 
@@ -38,6 +39,8 @@ var (
 	url                               = "url"
 	maxReceiveCount int64             = 5
 	m               map[string]string = make(map[string]string)
+
+	bucketStatement = `{"Sid": "S3BucketNotification0123", "Effect": "Allow", "Principal": {"Service": "s3.amazonaws.com"}, "Action": "sqs:SendMessage", "Resource": "arn"}`
 )
 
 func sqsParams(m ...func(*v1beta1.QueueParameters)) *v1beta1.QueueParameters {
@@ -53,6 +56,17 @@ func sqsParams(m ...func(*v1beta1.QueueParameters)) *v1beta1.QueueParameters {
 	return o
 }
 
+func policyBody() *policyv1alpha1.Document {
+	return &policyv1alpha1.Document{
+		Version: "2012-10-17",
+		Statements: []policyv1alpha1.Statement{{
+			Effect:   "Allow",
+			Action:   []string{"sqs:SendMessage"},
+			Resource: []string{arn},
+		}},
+	}
+}
+
 func attributes(p ...map[string]string) map[string]string {
 	m := map[string]string{}
 
@@ -171,11 +185,56 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"SamePolicyBody": {
+			args: args{
+				p: v1beta1.QueueParameters{
+					PolicyBody: policyBody(),
+				},
+				attributes: map[string]string{
+					v1beta1.AttributePolicy: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "arn"}]}`,
+				},
+			},
+			want: true,
+		},
+		"DifferentPolicyBody": {
+			args: args{
+				p: v1beta1.QueueParameters{
+					PolicyBody: policyBody(),
+				},
+				attributes: map[string]string{
+					v1beta1.AttributePolicy: `{"Version": "2012-10-17", "Statement": [{"Effect": "Deny", "Action": "sqs:SendMessage", "Resource": "arn"}]}`,
+				},
+			},
+			want: false,
+		},
+		"SamePolicyBodyWithBucketStatement": {
+			args: args{
+				p: v1beta1.QueueParameters{
+					PolicyBody: policyBody(),
+				},
+				attributes: map[string]string{
+					v1beta1.AttributePolicy: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "arn"}, ` + bucketStatement + `]}`,
+				},
+			},
+			want: true,
+		},
+		"NoPolicyWithBucketStatement": {
+			args: args{
+				p: v1beta1.QueueParameters{},
+				attributes: map[string]string{
+					v1beta1.AttributePolicy: `{"Version": "2012-10-17", "Statement": [` + bucketStatement + `]}`,
+				},
+			},
+			want: true,
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := IsUpToDate(tc.args.p, tc.args.attributes, tc.args.tags)
+			got, err := IsUpToDate(tc.args.p, tc.args.attributes, tc.args.tags)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): %s", err)
+			}
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
@@ -183,6 +242,45 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+func TestKeepBucketStatements(t *testing.T) {
+	cases := map[string]struct {
+		reason     string
+		attributes map[string]string
+		current    string
+		want       map[string]string
+	}{
+		"NoPolicy": {
+			reason:     "Attributes that do not set a policy should not be changed.",
+			attributes: map[string]string{v1beta1.AttributeDelaySeconds: "30"},
+			current:    `{"Version": "2012-10-17", "Statement": [` + bucketStatement + `]}`,
+			want:       map[string]string{v1beta1.AttributeDelaySeconds: "30"},
+		},
+		"NoBucketStatement": {
+			reason:     "The policy should not be changed if no Bucket added a statement to the current one.",
+			attributes: map[string]string{v1beta1.AttributePolicy: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "arn"}]}`},
+			current:    `{"Version": "2012-10-17", "Statement": [{"Effect": "Deny", "Action": "sqs:SendMessage", "Resource": "arn"}]}`,
+			want:       map[string]string{v1beta1.AttributePolicy: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "arn"}]}`},
+		},
+		"BucketStatement": {
+			reason:     "Statements Buckets added to the current policy should be kept.",
+			attributes: map[string]string{v1beta1.AttributePolicy: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sqs:SendMessage", "Resource": "arn"}]}`},
+			current:    `{"Version": "2012-10-17", "Statement": [{"Effect": "Deny", "Action": "sqs:SendMessage", "Resource": "arn"}, ` + bucketStatement + `]}`,
+			want:       map[string]string{v1beta1.AttributePolicy: `{"Statement":[{"Action":"sqs:SendMessage","Effect":"Allow","Resource":"arn"},{"Action":"sqs:SendMessage","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Resource":"arn","Sid":"S3BucketNotification0123"}],"Version":"2012-10-17"}`},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := KeepBucketStatements(tc.attributes, tc.current); err != nil {
+				t.Fatalf("KeepBucketStatements(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.attributes); diff != "" {
+				t.Errorf("\n%s\nKeepBucketStatements(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
 func TestGenerateQueueAttributes(t *testing.T) {
 	cases := map[string]struct {
 		in  v1beta1.QueueParameters
@@ -208,6 +306,14 @@ func TestGenerateQueueAttributes(t *testing.T) {
 				v1beta1.AttributeKmsMasterKeyID: kmsMasterKeyID,
 			},
 		},
+		"PolicyBody": {
+			in: v1beta1.QueueParameters{
+				PolicyBody: policyBody(),
+			},
+			out: map[string]string{
+				v1beta1.AttributePolicy: `{"Statement":[{"Action":"sqs:SendMessage","Effect":"Allow","Resource":"arn"}],"Version":"2012-10-17"}`,
+			},
+		},
 		"EmptyInput": {
 			in:  v1beta1.QueueParameters{},
 			out: nil,
@@ -216,7 +322,10 @@ func TestGenerateQueueAttributes(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := GenerateQueueAttributes(&tc.in)
+			r, err := GenerateQueueAttributes(&tc.in)
+			if err != nil {
+				t.Fatalf("GenerateQueueAttributes(...): %s", err)
+			}
 			if diff := cmp.Diff(r, tc.out); diff != "" {
 				t.Errorf("GenerateQueueAttributes(...): -want, +got:\n%s", diff)
 			}
@@ -251,7 +360,10 @@ func TestGenerateCreateAttributes(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			r := GenerateCreateAttributes(&tc.in)
+			r, err := GenerateCreateAttributes(&tc.in)
+			if err != nil {
+				t.Fatalf("GenerateCreateAttributes(...): %s", err)
+			}
 			if diff := cmp.Diff(r, tc.out); diff != "" {
 				t.Errorf("GenerateCreateAttributes(...): -want, +got:\n%s", diff)
 			}
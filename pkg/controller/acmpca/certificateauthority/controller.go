@@ -41,6 +41,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/acmpca/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/acmpca"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -68,9 +69,9 @@ func SetupCertificateAuthority(mgr ctrl.Manager, l logging.Logger, rl workqueue.
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1beta1.CertificateAuthority{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.CertificateAuthorityGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient}),
+			drift.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient}),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
 
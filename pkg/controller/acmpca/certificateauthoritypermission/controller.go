@@ -41,6 +41,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/acmpca/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/acmpca"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -60,9 +61,9 @@ func SetupCertificateAuthorityPermission(mgr ctrl.Manager, l logging.Logger, rl
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1beta1.CertificateAuthorityPermission{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.CertificateAuthorityPermissionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewCAPermissionClient}),
+			drift.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewCAPermissionClient}),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
@@ -35,6 +35,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupAPI adds a controller that reconciles API.
@@ -54,9 +55,9 @@ func SetupAPI(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.API{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.APIGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -35,6 +35,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupAPIMapping adds a controller that reconciles APIMapping.
@@ -55,9 +56,9 @@ func SetupAPIMapping(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.APIMapping{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.APIMappingGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -35,6 +35,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupDomainName adds a controller that reconciles DomainName.
@@ -54,9 +55,9 @@ func SetupDomainName(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.DomainName{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DomainNameGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -32,6 +32,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/athena/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupWorkGroup adds a controller that reconciles WorkGroup.
@@ -52,9 +53,10 @@ func SetupWorkGroup(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateLi
 			RateLimiter: ratelimiter.NewController(limiter),
 		}).
 		For(&svcapitypes.WorkGroup{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.WorkGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -19,11 +19,55 @@ package controller
 import (
 	"time"
 
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
 
+	acmv1beta1 "github.com/crossplane/provider-aws/apis/acm/v1beta1"
+	acmpcav1beta1 "github.com/crossplane/provider-aws/apis/acmpca/v1beta1"
+	apigatewayv2v1alpha1 "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	athenav1alpha1 "github.com/crossplane/provider-aws/apis/athena/v1alpha1"
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	cachev1beta1 "github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	cloudfrontv1alpha1 "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	cloudwatchlogsv1alpha1 "github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
+	databasev1beta1 "github.com/crossplane/provider-aws/apis/database/v1beta1"
+	docdbv1alpha1 "github.com/crossplane/provider-aws/apis/docdb/v1alpha1"
+	dynamodbv1alpha1 "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+	ec2manualv1alpha1 "github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	ec2v1alpha1 "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	ecrv1beta1 "github.com/crossplane/provider-aws/apis/ecr/v1beta1"
+	efsv1alpha1 "github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+	eksmanualv1alpha1 "github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
+	eksv1alpha1 "github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	eksv1beta1 "github.com/crossplane/provider-aws/apis/eks/v1beta1"
+	elasticloadbalancingv1alpha1 "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
+	elbv2v1alpha1 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	gluev1alpha1 "github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	iotv1alpha1 "github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	kafkav1alpha1 "github.com/crossplane/provider-aws/apis/kafka/v1alpha1"
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	lambdav1alpha1 "github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	mqv1alpha1 "github.com/crossplane/provider-aws/apis/mq/v1alpha1"
+	notificationv1alpha1 "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	ramv1alpha1 "github.com/crossplane/provider-aws/apis/ram/v1alpha1"
+	rdsv1alpha1 "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	redshiftv1alpha1 "github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
+	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+	route53resolverv1alpha1 "github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	s3v1alpha3 "github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	secretsmanagerv1alpha1 "github.com/crossplane/provider-aws/apis/secretsmanager/v1alpha1"
+	servicediscoveryv1alpha1 "github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	sfnv1alpha1 "github.com/crossplane/provider-aws/apis/sfn/v1alpha1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	transferv1alpha1 "github.com/crossplane/provider-aws/apis/transfer/v1alpha1"
 	"github.com/crossplane/provider-aws/pkg/controller/acm"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthority"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthoritypermission"
@@ -60,12 +104,17 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/address"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/instance"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/internetgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/keypair"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/launchtemplate"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/launchtemplateversion"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/managedprefixlist"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/natgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/networkacl"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/networkaclentry"
 	ec2route "github.com/crossplane/provider-aws/pkg/controller/ec2/route"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/routetable"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/securitygroup"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/securitygrouprule"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/subnet"
 	transitgateway "github.com/crossplane/provider-aws/pkg/controller/ec2/transitgateway"
 	transitgatewayroute "github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayroute"
@@ -129,7 +178,10 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverendpoint"
 	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverrule"
 	"github.com/crossplane/provider-aws/pkg/controller/s3"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/accesspoint"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/accesspointpolicy"
 	"github.com/crossplane/provider-aws/pkg/controller/s3/bucketpolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/object"
 	"github.com/crossplane/provider-aws/pkg/controller/secretsmanager/secret"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/httpnamespace"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/privatednsnamespace"
@@ -141,129 +193,157 @@ import (
 	transferuser "github.com/crossplane/provider-aws/pkg/controller/transfer/user"
 )
 
-// Setup creates all AWS controllers with the supplied logger and adds them to
-// the supplied manager.
-func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
-	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.RateLimiter, time.Duration) error{
-		cache.SetupReplicationGroup,
-		cachesubnetgroup.SetupCacheSubnetGroup,
-		cluster.SetupCacheCluster,
-		database.SetupRDSInstance,
-		docdbinstance.SetupDBInstance,
-		docdbcluster.SetupDBCluster,
-		docdbclusterparametergroup.SetupDBClusterParameterGroup,
-		docdbsubnetgroup.SetupDBSubnetGroup,
-		eks.SetupCluster,
-		eksaddon.SetupAddon,
-		identityproviderconfig.SetupIdentityProviderConfig,
-		elb.SetupELB,
-		elbattachment.SetupELBAttachment,
-		nodegroup.SetupNodeGroup,
-		s3.SetupBucket,
-		bucketpolicy.SetupBucketPolicy,
-		accesskey.SetupAccessKey,
-		user.SetupUser,
-		group.SetupGroup,
-		policy.SetupPolicy,
-		role.SetupRole,
-		groupusermembership.SetupGroupUserMembership,
-		userpolicyattachment.SetupUserPolicyAttachment,
-		grouppolicyattachment.SetupGroupPolicyAttachment,
-		rolepolicyattachment.SetupRolePolicyAttachment,
-		vpc.SetupVPC,
-		subnet.SetupSubnet,
-		securitygroup.SetupSecurityGroup,
-		internetgateway.SetupInternetGateway,
-		launchtemplate.SetupLaunchTemplate,
-		launchtemplateversion.SetupLaunchTemplateVersion,
-		natgateway.SetupNatGateway,
-		routetable.SetupRouteTable,
-		dbsubnetgroup.SetupDBSubnetGroup,
-		certificateauthority.SetupCertificateAuthority,
-		certificateauthoritypermission.SetupCertificateAuthorityPermission,
-		acm.SetupCertificate,
-		resourcerecordset.SetupResourceRecordSet,
-		hostedzone.SetupHostedZone,
-		secret.SetupSecret,
-		snstopic.SetupSNSTopic,
-		snssubscription.SetupSubscription,
-		queue.SetupQueue,
-		redshift.SetupCluster,
-		address.SetupAddress,
-		repository.SetupRepository,
-		repositorypolicy.SetupRepositoryPolicy,
-		api.SetupAPI,
-		stage.SetupStage,
-		route.SetupRoute,
-		authorizer.SetupAuthorizer,
-		integration.SetupIntegration,
-		deployment.SetupDeployment,
-		domainname.SetupDomainName,
-		integrationresponse.SetupIntegrationResponse,
-		model.SetupModel,
-		apimapping.SetupAPIMapping,
-		routeresponse.SetupRouteResponse,
-		vpclink.SetupVPCLink,
-		fargateprofile.SetupFargateProfile,
-		activity.SetupActivity,
-		statemachine.SetupStateMachine,
-		table.SetupTable,
-		backup.SetupBackup,
-		globaltable.SetupGlobalTable,
-		key.SetupKey,
-		alias.SetupAlias,
-		filesystem.SetupFileSystem,
-		dbcluster.SetupDBCluster,
-		dbclusterparametergroup.SetupDBClusterParameterGroup,
-		dbinstance.SetupDBInstance,
-		dbparametergroup.SetupDBParameterGroup,
-		globalcluster.SetupGlobalCluster,
-		vpccidrblock.SetupVPCCIDRBlock,
-		privatednsnamespace.SetupPrivateDNSNamespace,
-		publicdnsnamespace.SetupPublicDNSNamespace,
-		httpnamespace.SetupHTTPNamespace,
-		function.SetupFunction,
-		openidconnectprovider.SetupOpenIDConnectProvider,
-		distribution.SetupDistribution,
-		cachepolicy.SetupCachePolicy,
-		cloudfrontorginaccessidentity.SetupCloudFrontOriginAccessIdentity,
-		resolverendpoint.SetupResolverEndpoint,
-		resolverrule.SetupResolverRule,
-		vpcpeeringconnection.SetupVPCPeeringConnection,
-		vpcendpoint.SetupVPCEndpoint,
-		kafkacluster.SetupCluster,
-		efsmounttarget.SetupMountTarget,
-		transferserver.SetupServer,
-		transferuser.SetupUser,
-		instance.SetupInstance,
-		gluejob.SetupJob,
-		gluesecurityconfiguration.SetupSecurityConfiguration,
-		glueconnection.SetupConnection,
-		glueDatabase.SetupDatabase,
-		gluecrawler.SetupCrawler,
-		glueclassifier.SetupClassifier,
-		mqbroker.SetupBroker,
-		mquser.SetupUser,
-		cwloggroup.SetupLogGroup,
-		volume.SetupVolume,
-		transitgateway.SetupTransitGateway,
-		transitgatewayvpcattachment.SetupTransitGatewayVPCAttachment,
-		thing.SetupThing,
-		iotpolicy.SetupPolicy,
-		ec2route.SetupRoute,
-		athenaworkgroup.SetupWorkGroup,
-		resourceshare.SetupResourceShare,
-		kafkaconfiguration.SetupConfiguration,
-		listener.SetupListener,
-		loadbalancer.SetupLoadBalancer,
-		targetgroup.SetupTargetGroup,
-		transitgatewayroute.SetupTransitGatewayRoute,
-		transitgatewayroutetable.SetupTransitGatewayRouteTable,
+// Setup creates the AWS controllers the supplied filter enables with the
+// supplied logger and poll intervals and adds them to the supplied manager.
+// Controllers of kinds whose CustomResourceDefinitions are not installed are
+// skipped.
+func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, p *PollIntervals, f *Filter) error {
+	for _, c := range []struct {
+		kind  string
+		setup func(ctrl.Manager, logging.Logger, workqueue.RateLimiter, time.Duration) error
+	}{
+		{cachev1beta1.ReplicationGroupGroupKind, cache.SetupReplicationGroup},
+		{cachev1alpha1.CacheSubnetGroupGroupKind, cachesubnetgroup.SetupCacheSubnetGroup},
+		{cachev1alpha1.CacheClusterGroupKind, cluster.SetupCacheCluster},
+		{databasev1beta1.RDSInstanceGroupKind, database.SetupRDSInstance},
+		{docdbv1alpha1.DBInstanceGroupKind, docdbinstance.SetupDBInstance},
+		{docdbv1alpha1.DBClusterGroupKind, docdbcluster.SetupDBCluster},
+		{docdbv1alpha1.DBClusterParameterGroupGroupKind, docdbclusterparametergroup.SetupDBClusterParameterGroup},
+		{docdbv1alpha1.DBSubnetGroupGroupKind, docdbsubnetgroup.SetupDBSubnetGroup},
+		{eksv1beta1.ClusterGroupKind, eks.SetupCluster},
+		{eksv1alpha1.AddonGroupKind, eksaddon.SetupAddon},
+		{eksmanualv1alpha1.IdentityProviderConfigGroupKind, identityproviderconfig.SetupIdentityProviderConfig},
+		{elasticloadbalancingv1alpha1.ELBGroupKind, elb.SetupELB},
+		{elasticloadbalancingv1alpha1.ELBAttachmentGroupKind, elbattachment.SetupELBAttachment},
+		{eksmanualv1alpha1.NodeGroupGroupKind, nodegroup.SetupNodeGroup},
+		{s3v1beta1.BucketGroupKind, s3.SetupBucket},
+		{s3v1alpha3.BucketPolicyGroupKind, bucketpolicy.SetupBucketPolicy},
+		{s3v1alpha3.ObjectGroupKind, object.SetupObject},
+		{s3v1alpha3.AccessPointGroupKind, accesspoint.SetupAccessPoint},
+		{s3v1alpha3.AccessPointPolicyGroupKind, accesspointpolicy.SetupAccessPointPolicy},
+		{iamv1beta1.AccessKeyGroupKind, accesskey.SetupAccessKey},
+		{iamv1beta1.UserGroupKind, user.SetupUser},
+		{iamv1beta1.GroupGroupKind, group.SetupGroup},
+		{iamv1beta1.PolicyGroupKind, policy.SetupPolicy},
+		{iamv1beta1.RoleGroupKind, role.SetupRole},
+		{iamv1beta1.GroupUserMembershipGroupKind, groupusermembership.SetupGroupUserMembership},
+		{iamv1beta1.UserPolicyAttachmentGroupKind, userpolicyattachment.SetupUserPolicyAttachment},
+		{iamv1beta1.GroupPolicyAttachmentGroupKind, grouppolicyattachment.SetupGroupPolicyAttachment},
+		{iamv1beta1.RolePolicyAttachmentGroupKind, rolepolicyattachment.SetupRolePolicyAttachment},
+		{ec2v1beta1.VPCGroupKind, vpc.SetupVPC},
+		{ec2v1beta1.SubnetGroupKind, subnet.SetupSubnet},
+		{ec2v1beta1.SecurityGroupGroupKind, securitygroup.SetupSecurityGroup},
+		{ec2v1beta1.InternetGatewayGroupKind, internetgateway.SetupInternetGateway},
+		{ec2v1alpha1.LaunchTemplateGroupKind, launchtemplate.SetupLaunchTemplate},
+		{ec2v1alpha1.LaunchTemplateVersionGroupKind, launchtemplateversion.SetupLaunchTemplateVersion},
+		{ec2v1beta1.NATGatewayGroupKind, natgateway.SetupNatGateway},
+		{ec2v1beta1.RouteTableGroupKind, routetable.SetupRouteTable},
+		{databasev1beta1.DBSubnetGroupGroupKind, dbsubnetgroup.SetupDBSubnetGroup},
+		{acmpcav1beta1.CertificateAuthorityGroupKind, certificateauthority.SetupCertificateAuthority},
+		{acmpcav1beta1.CertificateAuthorityPermissionGroupKind, certificateauthoritypermission.SetupCertificateAuthorityPermission},
+		{acmv1beta1.CertificateGroupKind, acm.SetupCertificate},
+		{route53v1alpha1.ResourceRecordSetGroupKind, resourcerecordset.SetupResourceRecordSet},
+		{route53v1alpha1.HostedZoneGroupKind, hostedzone.SetupHostedZone},
+		{secretsmanagerv1alpha1.SecretGroupKind, secret.SetupSecret},
+		{notificationv1alpha1.SNSTopicGroupKind, snstopic.SetupSNSTopic},
+		{notificationv1alpha1.SNSSubscriptionGroupKind, snssubscription.SetupSubscription},
+		{sqsv1beta1.QueueGroupKind, queue.SetupQueue},
+		{redshiftv1alpha1.ClusterGroupKind, redshift.SetupCluster},
+		{ec2v1beta1.AddressGroupKind, address.SetupAddress},
+		{ecrv1beta1.RepositoryGroupKind, repository.SetupRepository},
+		{ecrv1beta1.RepositoryPolicyGroupKind, repositorypolicy.SetupRepositoryPolicy},
+		{apigatewayv2v1alpha1.APIGroupKind, api.SetupAPI},
+		{apigatewayv2v1alpha1.StageGroupKind, stage.SetupStage},
+		{apigatewayv2v1alpha1.RouteGroupKind, route.SetupRoute},
+		{apigatewayv2v1alpha1.AuthorizerGroupKind, authorizer.SetupAuthorizer},
+		{apigatewayv2v1alpha1.IntegrationGroupKind, integration.SetupIntegration},
+		{apigatewayv2v1alpha1.DeploymentGroupKind, deployment.SetupDeployment},
+		{apigatewayv2v1alpha1.DomainNameGroupKind, domainname.SetupDomainName},
+		{apigatewayv2v1alpha1.IntegrationResponseGroupKind, integrationresponse.SetupIntegrationResponse},
+		{apigatewayv2v1alpha1.ModelGroupKind, model.SetupModel},
+		{apigatewayv2v1alpha1.APIMappingGroupKind, apimapping.SetupAPIMapping},
+		{apigatewayv2v1alpha1.RouteResponseGroupKind, routeresponse.SetupRouteResponse},
+		{apigatewayv2v1alpha1.VPCLinkGroupKind, vpclink.SetupVPCLink},
+		{eksv1beta1.FargateProfileGroupKind, fargateprofile.SetupFargateProfile},
+		{sfnv1alpha1.ActivityGroupKind, activity.SetupActivity},
+		{sfnv1alpha1.StateMachineGroupKind, statemachine.SetupStateMachine},
+		{dynamodbv1alpha1.TableGroupKind, table.SetupTable},
+		{dynamodbv1alpha1.BackupGroupKind, backup.SetupBackup},
+		{dynamodbv1alpha1.GlobalTableGroupKind, globaltable.SetupGlobalTable},
+		{kmsv1alpha1.KeyGroupKind, key.SetupKey},
+		{kmsv1alpha1.AliasGroupKind, alias.SetupAlias},
+		{efsv1alpha1.FileSystemGroupKind, filesystem.SetupFileSystem},
+		{rdsv1alpha1.DBClusterGroupKind, dbcluster.SetupDBCluster},
+		{rdsv1alpha1.DBClusterParameterGroupGroupKind, dbclusterparametergroup.SetupDBClusterParameterGroup},
+		{rdsv1alpha1.DBInstanceGroupKind, dbinstance.SetupDBInstance},
+		{rdsv1alpha1.DBParameterGroupGroupKind, dbparametergroup.SetupDBParameterGroup},
+		{rdsv1alpha1.GlobalClusterGroupKind, globalcluster.SetupGlobalCluster},
+		{ec2v1beta1.VPCCIDRBlockGroupKind, vpccidrblock.SetupVPCCIDRBlock},
+		{servicediscoveryv1alpha1.PrivateDNSNamespaceGroupKind, privatednsnamespace.SetupPrivateDNSNamespace},
+		{servicediscoveryv1alpha1.PublicDNSNamespaceGroupKind, publicdnsnamespace.SetupPublicDNSNamespace},
+		{servicediscoveryv1alpha1.HTTPNamespaceGroupKind, httpnamespace.SetupHTTPNamespace},
+		{lambdav1alpha1.FunctionGroupKind, function.SetupFunction},
+		{iamv1beta1.OpenIDConnectProviderGroupKind, openidconnectprovider.SetupOpenIDConnectProvider},
+		{cloudfrontv1alpha1.DistributionGroupKind, distribution.SetupDistribution},
+		{cloudfrontv1alpha1.CachePolicyGroupKind, cachepolicy.SetupCachePolicy},
+		{cloudfrontv1alpha1.CloudFrontOriginAccessIdentityGroupKind, cloudfrontorginaccessidentity.SetupCloudFrontOriginAccessIdentity},
+		{route53resolverv1alpha1.ResolverEndpointGroupKind, resolverendpoint.SetupResolverEndpoint},
+		{route53resolverv1alpha1.ResolverRuleGroupKind, resolverrule.SetupResolverRule},
+		{ec2v1alpha1.VPCPeeringConnectionGroupKind, vpcpeeringconnection.SetupVPCPeeringConnection},
+		{ec2v1alpha1.VPCEndpointGroupKind, vpcendpoint.SetupVPCEndpoint},
+		{kafkav1alpha1.ClusterGroupKind, kafkacluster.SetupCluster},
+		{efsv1alpha1.MountTargetGroupKind, efsmounttarget.SetupMountTarget},
+		{transferv1alpha1.ServerGroupKind, transferserver.SetupServer},
+		{transferv1alpha1.UserGroupKind, transferuser.SetupUser},
+		{ec2manualv1alpha1.InstanceGroupKind, instance.SetupInstance},
+		{ec2manualv1alpha1.SecurityGroupRuleGroupKind, securitygrouprule.SetupSecurityGroupRule},
+		{ec2manualv1alpha1.NetworkACLGroupKind, networkacl.SetupNetworkACL},
+		{ec2manualv1alpha1.NetworkACLEntryGroupKind, networkaclentry.SetupNetworkACLEntry},
+		{ec2manualv1alpha1.KeyPairGroupKind, keypair.SetupKeyPair},
+		{ec2manualv1alpha1.ManagedPrefixListGroupKind, managedprefixlist.SetupManagedPrefixList},
+		{gluev1alpha1.JobGroupKind, gluejob.SetupJob},
+		{gluev1alpha1.SecurityConfigurationGroupKind, gluesecurityconfiguration.SetupSecurityConfiguration},
+		{gluev1alpha1.ConnectionGroupKind, glueconnection.SetupConnection},
+		{gluev1alpha1.DatabaseGroupKind, glueDatabase.SetupDatabase},
+		{gluev1alpha1.CrawlerGroupKind, gluecrawler.SetupCrawler},
+		{gluev1alpha1.ClassifierGroupKind, glueclassifier.SetupClassifier},
+		{mqv1alpha1.BrokerGroupKind, mqbroker.SetupBroker},
+		{mqv1alpha1.UserGroupKind, mquser.SetupUser},
+		{cloudwatchlogsv1alpha1.LogGroupGroupKind, cwloggroup.SetupLogGroup},
+		{ec2v1alpha1.VolumeGroupKind, volume.SetupVolume},
+		{ec2v1alpha1.TransitGatewayGroupKind, transitgateway.SetupTransitGateway},
+		{ec2v1alpha1.TransitGatewayVPCAttachmentGroupKind, transitgatewayvpcattachment.SetupTransitGatewayVPCAttachment},
+		{iotv1alpha1.ThingGroupKind, thing.SetupThing},
+		{iotv1alpha1.PolicyGroupKind, iotpolicy.SetupPolicy},
+		{ec2v1alpha1.RouteGroupKind, ec2route.SetupRoute},
+		{athenav1alpha1.WorkGroupGroupKind, athenaworkgroup.SetupWorkGroup},
+		{ramv1alpha1.ResourceShareGroupKind, resourceshare.SetupResourceShare},
+		{kafkav1alpha1.ConfigurationGroupKind, kafkaconfiguration.SetupConfiguration},
+		{elbv2v1alpha1.ListenerGroupKind, listener.SetupListener},
+		{elbv2v1alpha1.LoadBalancerGroupKind, loadbalancer.SetupLoadBalancer},
+		{elbv2v1alpha1.TargetGroupGroupKind, targetgroup.SetupTargetGroup},
+		{ec2v1alpha1.TransitGatewayRouteGroupKind, transitgatewayroute.SetupTransitGatewayRoute},
+		{ec2v1alpha1.TransitGatewayRouteTableGroupKind, transitgatewayroutetable.SetupTransitGatewayRouteTable},
 	} {
-		if err := setup(mgr, l, rl, poll); err != nil {
+		gk := schema.ParseGroupKind(c.kind)
+		if !f.Enabled(gk) {
+			l.Debug("Skipping disabled controller", "kind", c.kind)
+			continue
+		}
+		if _, err := mgr.GetRESTMapper().RESTMapping(gk); err != nil {
+			if meta.IsNoMatchError(err) {
+				l.Info("Skipping controller of a kind whose CustomResourceDefinition is not installed", "kind", c.kind)
+				continue
+			}
+			return errors.Wrapf(err, "cannot get REST mapping of %s", c.kind)
+		}
+		if err := c.setup(mgr, l, rl, p.For(gk)); err != nil {
 			return err
 		}
 	}
 
-	return config.Setup(mgr, l, rl)
+	if err := config.Setup(mgr, l, rl); err != nil {
+		return err
+	}
+	return config.SetupHealthCheck(mgr, l, rl, config.DefaultHealthCheckInterval)
 }
@@ -39,6 +39,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // Error strings.
@@ -60,9 +61,9 @@ func SetupCacheSubnetGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.Rate
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1alpha1.CacheSubnetGroup{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CacheSubnetGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
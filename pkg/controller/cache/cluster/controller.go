@@ -41,6 +41,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // Error strings.
@@ -63,10 +64,10 @@ func SetupCacheCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1alpha1.CacheCluster{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.CacheClusterGroupVersionKind),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -43,6 +43,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/cache/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // Error strings.
@@ -67,9 +68,9 @@ func SetupReplicationGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.Rate
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1beta1.ReplicationGroup{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.ReplicationGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
@@ -225,8 +226,9 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[t.Key] = t.Value
 	}
-	for k, v := range resource.GetExternalTags(mg) {
-		tagMap[k] = v
+	tagMap, err := awsclient.MergeDefaultTags(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
 	}
 	cr.Spec.ForProvider.Tags = make([]v1beta1.Tag, len(tagMap))
 	i := 0
@@ -34,6 +34,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupCachePolicy adds a controller that reconciles CachePolicy.
@@ -45,9 +46,9 @@ func SetupCachePolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.CachePolicy{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.CachePolicyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{
+			drift.WithExternalConnecter(&connector{
 				kube: mgr.GetClient(),
 				opts: []option{
 					func(e *external) {
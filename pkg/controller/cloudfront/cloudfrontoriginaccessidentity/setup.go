@@ -35,6 +35,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupCloudFrontOriginAccessIdentity adds a controller that reconciles CloudFrontOriginAccessIdentity .
@@ -46,9 +47,9 @@ func SetupCloudFrontOriginAccessIdentity(mgr ctrl.Manager, l logging.Logger, rl
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.CloudFrontOriginAccessIdentity{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.CloudFrontOriginAccessIdentityGroupVersionKind),
-			managed.WithExternalConnecter(&connector{
+			drift.WithExternalConnecter(&connector{
 				kube: mgr.GetClient(),
 				opts: []option{
 					func(e *external) {
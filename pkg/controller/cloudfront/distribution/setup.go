@@ -38,6 +38,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // TODO: Aren't these defined as an API constant somewhere in aws-sdk-go?
@@ -55,9 +56,9 @@ func SetupDistribution(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.Distribution{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DistributionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{
+			drift.WithExternalConnecter(&connector{
 				kube: mgr.GetClient(),
 				opts: []option{
 					func(e *external) {
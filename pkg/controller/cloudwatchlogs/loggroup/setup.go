@@ -34,6 +34,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -62,10 +63,10 @@ func SetupLogGroup(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateLim
 			RateLimiter: ratelimiter.NewController(limiter),
 		}).
 		For(&svcapitypes.LogGroup{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.LogGroupGroupVersionKind),
 			managed.WithInitializers(),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -0,0 +1,204 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// DefaultHealthCheckInterval is how often the credentials of a
+	// ProviderConfig are validated.
+	DefaultHealthCheckInterval = 10 * time.Minute
+
+	healthCheckTimeout = 1 * time.Minute
+
+	errGetPC        = "cannot get ProviderConfig"
+	errUpdateStatus = "cannot update ProviderConfig status"
+)
+
+// Event reasons.
+const (
+	reasonHealthCheck event.Reason = "HealthCheck"
+)
+
+// An IdentityFn returns the identity that the supplied ProviderConfig
+// authenticates to AWS as.
+type IdentityFn func(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (*sts.GetCallerIdentityOutput, error)
+
+// GetCallerIdentity returns the identity of the supplied ProviderConfig by
+// calling STS GetCallerIdentity in the default region of the ProviderConfig,
+// or the global region if it has none.
+func GetCallerIdentity(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) (*sts.GetCallerIdentityOutput, error) {
+	region := awsclient.GlobalRegion
+	if pc.Spec.DefaultRegion != nil {
+		region = *pc.Spec.DefaultRegion
+	}
+	return awsclient.GetCallerIdentity(ctx, c, pc, region)
+}
+
+// SetupHealthCheck adds a controller that periodically validates the
+// credentials of ProviderConfigs and reports the result in their status.
+func SetupHealthCheck(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, interval time.Duration) error {
+	name := "providerconfig-health/" + strings.ToLower(v1beta1.ProviderConfigGroupKind)
+
+	r := NewHealthCheckReconciler(mgr.GetClient(), GetCallerIdentity, interval,
+		WithHealthCheckLogger(l.WithValues("controller", name)),
+		WithHealthCheckRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		// Status updates must not trigger a health check, since every check
+		// updates the status. Checks are repeated every interval instead.
+		For(&v1beta1.ProviderConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
+
+// A HealthCheckReconcilerOption configures a HealthCheckReconciler.
+type HealthCheckReconcilerOption func(*HealthCheckReconciler)
+
+// WithHealthCheckLogger specifies how the HealthCheckReconciler should log
+// messages.
+func WithHealthCheckLogger(l logging.Logger) HealthCheckReconcilerOption {
+	return func(r *HealthCheckReconciler) {
+		r.log = l
+	}
+}
+
+// WithHealthCheckRecorder specifies how the HealthCheckReconciler should
+// record events.
+func WithHealthCheckRecorder(er event.Recorder) HealthCheckReconcilerOption {
+	return func(r *HealthCheckReconciler) {
+		r.record = er
+	}
+}
+
+// A HealthCheckReconciler validates the credentials of ProviderConfigs by
+// resolving the identity they authenticate as. The result is reported as the
+// Ready condition of the ProviderConfig, along with the account ID and ARN of
+// the identity.
+type HealthCheckReconciler struct {
+	client   client.Client
+	identity IdentityFn
+	interval time.Duration
+
+	log    logging.Logger
+	record event.Recorder
+}
+
+// NewHealthCheckReconciler returns a HealthCheckReconciler that validates
+// the credentials of ProviderConfigs every interval.
+func NewHealthCheckReconciler(c client.Client, fn IdentityFn, interval time.Duration, o ...HealthCheckReconcilerOption) *HealthCheckReconciler {
+	r := &HealthCheckReconciler{
+		client:   c,
+		identity: fn,
+		interval: interval,
+		log:      logging.NewNopLogger(),
+		record:   event.NewNopRecorder(),
+	}
+	for _, ro := range o {
+		ro(r)
+	}
+	return r
+}
+
+// Reconcile a ProviderConfig by validating its credentials.
+func (r *HealthCheckReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.client.Get(ctx, req.NamespacedName, pc); err != nil {
+		// In case object is not found, most likely the object was deleted and
+		// then disappeared while the event was in the processing queue. The
+		// configs built for it must not be reused by a ProviderConfig that is
+		// created with the same name.
+		log.Debug(errGetPC, "error", err)
+		if kerrors.IsNotFound(err) {
+			awsclient.InvalidateConfigCache(req.Name)
+		}
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+
+	if meta.WasDeleted(pc) {
+		awsclient.InvalidateConfigCache(pc.GetName())
+		return reconcile.Result{Requeue: false}, nil
+	}
+
+	observed := pc.Status.DeepCopy()
+
+	id, err := r.identity(ctx, r.client, pc)
+	if err != nil {
+		log.Debug("Credentials are not valid", "error", err)
+		msg := healthCheckMessage(err)
+		r.record.Event(pc, event.Warning(reasonHealthCheck, errors.New(msg)))
+		pc.Status.AccountID = nil
+		pc.Status.ARN = nil
+		pc.Status.SetConditions(xpv1.Unavailable().WithMessage(msg))
+	} else {
+		pc.Status.AccountID = id.Account
+		pc.Status.ARN = id.Arn
+		pc.Status.SetConditions(xpv1.Available())
+	}
+
+	if equality.Semantic.DeepEqual(observed, &pc.Status) {
+		return reconcile.Result{RequeueAfter: r.interval}, nil
+	}
+	return reconcile.Result{RequeueAfter: r.interval}, errors.Wrap(r.client.Status().Update(ctx, pc), errUpdateStatus)
+}
+
+// requestIDRe matches the ID of an AWS API request in an error message.
+var requestIDRe = regexp.MustCompile(`RequestID: [^,]*, `)
+
+// healthCheckMessage returns the message of the supplied error without the
+// ID of the AWS API request that failed. Every request has a different ID,
+// so the status of a ProviderConfig whose credentials keep failing the same
+// way would otherwise change with every health check.
+func healthCheckMessage(err error) string {
+	return requestIDRe.ReplaceAllString(err.Error(), "")
+}
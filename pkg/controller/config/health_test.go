@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	errInvalidTokenWithRequestID = "operation error STS: GetCallerIdentity, https response error StatusCode: 403, RequestID: 0cb4b4b3-2e6b-4e5c-8c27-8b1c3b5e0f42, api error InvalidClientTokenId: The security token included in the request is invalid."
+	errInvalidToken              = "operation error STS: GetCallerIdentity, https response error StatusCode: 403, api error InvalidClientTokenId: The security token included in the request is invalid."
+)
+
+var (
+	errBoom  = errors.New("boom")
+	interval = 10 * time.Minute
+)
+
+func TestHealthCheckReconcile(t *testing.T) {
+	type args struct {
+		kube     client.Client
+		identity IdentityFn
+	}
+
+	type want struct {
+		result reconcile.Result
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NotFound": {
+			reason: "We should not return an error if the ProviderConfig was not found.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+				},
+			},
+			want: want{
+				result: reconcile.Result{},
+			},
+		},
+		"GetError": {
+			reason: "We should return any error encountered while getting the ProviderConfig.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+			},
+			want: want{
+				result: reconcile.Result{},
+				err:    errors.Wrap(errBoom, errGetPC),
+			},
+		},
+		"InvalidCredentials": {
+			reason: "We should report the ProviderConfig as unavailable if its credentials are not valid.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(nil, func(o client.Object) error {
+						pc := o.(*v1beta1.ProviderConfig)
+						want := &v1beta1.ProviderConfig{}
+						want.Status.SetConditions(xpv1.Unavailable().WithMessage(errBoom.Error()))
+						if diff := cmp.Diff(want.Status, pc.Status, test.EquateConditions()); diff != "" {
+							t.Errorf("-want, +got:\n%s", diff)
+						}
+						return nil
+					}),
+				},
+				identity: func(_ context.Context, _ client.Client, _ *v1beta1.ProviderConfig) (*sts.GetCallerIdentityOutput, error) {
+					return nil, errBoom
+				},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+			},
+		},
+		"RequestIDStripped": {
+			reason: "We should not include the ID of the failed AWS API request in the status of the ProviderConfig.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(nil, func(o client.Object) error {
+						pc := o.(*v1beta1.ProviderConfig)
+						want := &v1beta1.ProviderConfig{}
+						want.Status.SetConditions(xpv1.Unavailable().WithMessage(errInvalidToken))
+						if diff := cmp.Diff(want.Status, pc.Status, test.EquateConditions()); diff != "" {
+							t.Errorf("-want, +got:\n%s", diff)
+						}
+						return nil
+					}),
+				},
+				identity: func(_ context.Context, _ client.Client, _ *v1beta1.ProviderConfig) (*sts.GetCallerIdentityOutput, error) {
+					return nil, errors.New(errInvalidTokenWithRequestID)
+				},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+			},
+		},
+		"UnchangedStatus": {
+			reason: "We should not update the status of the ProviderConfig if the health check fails the same way again.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*v1beta1.ProviderConfig).Status.SetConditions(xpv1.Unavailable().WithMessage(errInvalidToken))
+						return nil
+					},
+					MockStatusUpdate: test.NewMockStatusUpdateFn(nil, func(o client.Object) error {
+						t.Errorf("the status should not be updated")
+						return nil
+					}),
+				},
+				identity: func(_ context.Context, _ client.Client, _ *v1beta1.ProviderConfig) (*sts.GetCallerIdentityOutput, error) {
+					return nil, errors.New(errInvalidTokenWithRequestID)
+				},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+			},
+		},
+		"ValidCredentials": {
+			reason: "We should report the ProviderConfig as available along with its identity if its credentials are valid.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(nil, func(o client.Object) error {
+						pc := o.(*v1beta1.ProviderConfig)
+						want := &v1beta1.ProviderConfig{}
+						want.Status.AccountID = aws.String("123456789012")
+						want.Status.ARN = aws.String("arn:aws:iam::123456789012:user/crossplane")
+						want.Status.SetConditions(xpv1.Available())
+						if diff := cmp.Diff(want.Status, pc.Status, test.EquateConditions()); diff != "" {
+							t.Errorf("-want, +got:\n%s", diff)
+						}
+						return nil
+					}),
+				},
+				identity: func(_ context.Context, _ client.Client, _ *v1beta1.ProviderConfig) (*sts.GetCallerIdentityOutput, error) {
+					return &sts.GetCallerIdentityOutput{
+						Account: aws.String("123456789012"),
+						Arn:     aws.String("arn:aws:iam::123456789012:user/crossplane"),
+					}, nil
+				},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+			},
+		},
+		"UpdateStatusError": {
+			reason: "We should return any error encountered while updating the status of the ProviderConfig.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(errBoom),
+				},
+				identity: func(_ context.Context, _ client.Client, _ *v1beta1.ProviderConfig) (*sts.GetCallerIdentityOutput, error) {
+					return &sts.GetCallerIdentityOutput{}, nil
+				},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: interval},
+				err:    errors.Wrap(errBoom, errUpdateStatus),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewHealthCheckReconciler(tc.args.kube, tc.args.identity, interval)
+			got, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
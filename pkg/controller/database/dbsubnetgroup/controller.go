@@ -43,6 +43,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/database/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	dbsg "github.com/crossplane/provider-aws/pkg/clients/dbsubnetgroup"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -67,9 +68,9 @@ func SetupDBSubnetGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLim
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1beta1.DBSubnetGroup{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.DBSubnetGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: dbsg.NewClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: dbsg.NewClient}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
@@ -43,6 +43,7 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/docdb/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	svcutils "github.com/crossplane/provider-aws/pkg/controller/docdb"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -62,9 +63,9 @@ func SetupDBCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter
 		WithOptions(controller.Options{
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DBClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithPollInterval(poll),
@@ -329,5 +330,10 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.Spec.ForProvider.Tags = svcutils.AddExternalTags(mg, cr.Spec.ForProvider.Tags)
+	defaultTags, err := awsclient.GetDefaultTags(ctx, t.kube, mg)
+	if err != nil {
+		return err
+	}
+	cr.Spec.ForProvider.Tags = svcutils.AddDefaultTags(cr.Spec.ForProvider.Tags, defaultTags)
 	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
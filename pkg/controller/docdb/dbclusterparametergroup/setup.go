@@ -37,6 +37,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/docdb"
 
@@ -67,9 +68,9 @@ func SetupDBClusterParameterGroup(mgr ctrl.Manager, l logging.Logger, rl workque
 		WithOptions(controller.Options{
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DBClusterParameterGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithPollInterval(poll),
@@ -276,5 +277,10 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.Spec.ForProvider.Tags = svcutils.AddExternalTags(mg, cr.Spec.ForProvider.Tags)
+	defaultTags, err := awsclient.GetDefaultTags(ctx, t.kube, mg)
+	if err != nil {
+		return err
+	}
+	cr.Spec.ForProvider.Tags = svcutils.AddDefaultTags(cr.Spec.ForProvider.Tags, defaultTags)
 	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
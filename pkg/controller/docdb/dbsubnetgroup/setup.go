@@ -35,6 +35,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/docdb"
 
@@ -62,9 +63,9 @@ func SetupDBSubnetGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLim
 		WithOptions(controller.Options{
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DBSubnetGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithPollInterval(poll),
@@ -188,5 +189,10 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.Spec.ForProvider.Tags = svcutils.AddExternalTags(mg, cr.Spec.ForProvider.Tags)
+	defaultTags, err := awsclient.GetDefaultTags(ctx, t.kube, mg)
+	if err != nil {
+		return err
+	}
+	cr.Spec.ForProvider.Tags = svcutils.AddDefaultTags(cr.Spec.ForProvider.Tags, defaultTags)
 	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
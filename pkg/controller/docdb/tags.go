@@ -144,6 +144,29 @@ func AddExternalTags(mg resource.Managed, spec []*svcapitypes.Tag) []*svcapitype
 	return tags
 }
 
+// AddDefaultTags to spec if they don't exist
+func AddDefaultTags(spec []*svcapitypes.Tag, defaultTags map[string]string) []*svcapitypes.Tag {
+	tagMap := make(map[string]struct{}, len(spec))
+	for _, t := range spec {
+		tagMap[awsclient.StringValue(t.Key)] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(defaultTags))
+	for k := range defaultTags {
+		if _, exists := tagMap[k]; !exists {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	tags := spec
+	for _, k := range keys {
+		tags = append(tags, &svcapitypes.Tag{Key: awsclient.String(k), Value: awsclient.String(defaultTags[k])})
+	}
+
+	return tags
+}
+
 // GetExternalTags is a wrapper around resource.GetExternalTags to return a sorted array instead of a map
 func GetExternalTags(mg resource.Managed) []*svcapitypes.Tag {
 	externalTags := []*svcapitypes.Tag{}
@@ -0,0 +1,157 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package drift lets individual managed resources configure how their
+// external resources are checked for drift.
+package drift
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Annotation keys.
+const (
+	// AnnotationKeyPollInterval is the key of the annotation that overrides
+	// how often a managed resource is checked for drift, e.g. 10m.
+	AnnotationKeyPollInterval = "aws.crossplane.io/poll-interval"
+
+	// AnnotationKeyObserveOnly is the key of the annotation that, when set to
+	// true, stops a managed resource from creating, updating or deleting its
+	// external resource. Its external resource is still observed, and it is
+	// orphaned when the managed resource is deleted.
+	AnnotationKeyObserveOnly = "aws.crossplane.io/observe-only"
+)
+
+const (
+	errObserveOnlyCreate = "cannot create the external resource of an observe-only managed resource"
+)
+
+// ObserveOnly returns true if the supplied managed resource must not change
+// its external resource.
+func ObserveOnly(mg resource.Managed) bool {
+	return mg.GetAnnotations()[AnnotationKeyObserveOnly] == "true"
+}
+
+// PollInterval returns the poll interval the supplied managed resource
+// overrides, if any.
+func PollInterval(mg resource.Managed) (time.Duration, bool) {
+	v, ok := mg.GetAnnotations()[AnnotationKeyPollInterval]
+	if !ok {
+		return 0, false
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return 0, false
+	}
+	return d, true
+}
+
+// NewReconciler returns a managed.Reconciler for the supplied kind that
+// polls managed resources at the interval they override, if any.
+func NewReconciler(m ctrl.Manager, of resource.ManagedKind, o ...managed.ReconcilerOption) reconcile.Reconciler {
+	gvk := schema.GroupVersionKind(of)
+	return &Reconciler{
+		client: m.GetClient(),
+		newManaged: func() resource.Managed {
+			return resource.MustCreateObject(gvk, m.GetScheme()).(resource.Managed)
+		},
+		managed: managed.NewReconciler(m, of, o...),
+	}
+}
+
+// A Reconciler wraps a managed.Reconciler to requeue the managed resources
+// that override their poll interval at that interval.
+type Reconciler struct {
+	client     client.Client
+	newManaged func() resource.Managed
+	managed    reconcile.Reconciler
+}
+
+// Reconcile a managed resource.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	result, err := r.managed.Reconcile(ctx, req)
+	// The managed reconciler requeues after an interval only when it polls
+	// a managed resource.
+	if err != nil || result.RequeueAfter == 0 {
+		return result, err
+	}
+	mg := r.newManaged()
+	if err := r.client.Get(ctx, req.NamespacedName, mg); err != nil {
+		return result, nil
+	}
+	if d, ok := PollInterval(mg); ok {
+		result.RequeueAfter = d
+	}
+	return result, nil
+}
+
+// WithExternalConnecter specifies how the Reconciler should connect to the
+// API used to sync and delete external resources. The external resources of
+// observe-only managed resources are never changed.
+func WithExternalConnecter(c managed.ExternalConnecter) managed.ReconcilerOption {
+	return managed.WithExternalConnecter(&connecter{ExternalConnecter: c})
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	ec, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil || !ObserveOnly(mg) {
+		return ec, err
+	}
+	return &observeOnlyExternal{ExternalClient: ec}, nil
+}
+
+// An observeOnlyExternal observes external resources without changing them.
+type observeOnlyExternal struct {
+	managed.ExternalClient
+}
+
+func (e *observeOnlyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	// Reporting that the external resource does not exist lets the managed
+	// resource be deleted without deleting its external resource.
+	if meta.WasDeleted(mg) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	o, err := e.ExternalClient.Observe(ctx, mg)
+	o.ResourceUpToDate = true
+	return o, err
+}
+
+func (e *observeOnlyExternal) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, errors.New(errObserveOnlyCreate)
+}
+
+func (e *observeOnlyExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *observeOnlyExternal) Delete(_ context.Context, _ resource.Managed) error {
+	return nil
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var errBoom = errors.New("boom")
+
+func withAnnotations(a map[string]string) test.ObjectFn {
+	return func(o client.Object) error {
+		o.SetAnnotations(a)
+		return nil
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	type args struct {
+		result reconcile.Result
+		err    error
+		kube   client.Client
+	}
+	type want struct {
+		result reconcile.Result
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"PollIntervalAnnotation": {
+			reason: "A poll should be requeued at the interval the managed resource overrides.",
+			args: args{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, withAnnotations(map[string]string{AnnotationKeyPollInterval: "10m"})),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: 10 * time.Minute}},
+		},
+		"InvalidPollIntervalAnnotation": {
+			reason: "An invalid poll interval should be ignored.",
+			args: args{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, withAnnotations(map[string]string{AnnotationKeyPollInterval: "often"})),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: time.Minute}},
+		},
+		"NoPoll": {
+			reason: "Results that are not polls should not be changed.",
+			args: args{
+				result: reconcile.Result{Requeue: true},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, withAnnotations(map[string]string{AnnotationKeyPollInterval: "10m"})),
+				},
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
+		"ReconcileError": {
+			reason: "Errors of the managed reconciler should be returned unchanged.",
+			args: args{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				err:    errBoom,
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, withAnnotations(map[string]string{AnnotationKeyPollInterval: "10m"})),
+				},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: time.Minute}, err: errBoom},
+		},
+		"GetError": {
+			reason: "The default poll interval should be used if the managed resource cannot be read.",
+			args: args{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: time.Minute}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &Reconciler{
+				client:     tc.args.kube,
+				newManaged: func() resource.Managed { return &fake.Managed{} },
+				managed: reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					return tc.args.result, tc.args.err
+				}),
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nr.Reconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveOnly(t *testing.T) {
+	calls := map[string]int{}
+	ec := managed.ExternalClientFns{
+		ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+			calls["Observe"]++
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false}, nil
+		},
+		CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+			calls["Create"]++
+			return managed.ExternalCreation{}, nil
+		},
+		UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+			calls["Update"]++
+			return managed.ExternalUpdate{}, nil
+		},
+		DeleteFn: func(_ context.Context, _ resource.Managed) error {
+			calls["Delete"]++
+			return nil
+		},
+	}
+	c := &connecter{ExternalConnecter: managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return ec, nil
+	})}
+
+	type want struct {
+		obs       managed.ExternalObservation
+		createErr bool
+		calls     map[string]int
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"NotObserveOnly": {
+			reason: "The external resources of other managed resources should be changed.",
+			mg:     &fake.Managed{},
+			want: want{
+				obs:   managed.ExternalObservation{ResourceExists: true},
+				calls: map[string]int{"Observe": 1, "Create": 1, "Update": 1, "Delete": 1},
+			},
+		},
+		"ObserveOnly": {
+			reason: "The external resources of observe-only managed resources should be observed, reported as up to date and never changed.",
+			mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{AnnotationKeyObserveOnly: "true"},
+			}},
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				createErr: true,
+				calls:     map[string]int{"Observe": 1},
+			},
+		},
+		"ObserveOnlyDeleted": {
+			reason: "The external resources of deleted observe-only managed resources should be orphaned.",
+			mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{
+				Annotations:       map[string]string{AnnotationKeyObserveOnly: "true"},
+				DeletionTimestamp: &metav1.Time{Time: time.Now()},
+			}},
+			want: want{
+				obs:       managed.ExternalObservation{ResourceExists: false},
+				createErr: true,
+				calls:     map[string]int{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls = map[string]int{}
+			ctx := context.Background()
+			e, err := c.Connect(ctx, tc.mg)
+			if err != nil {
+				t.Fatalf("c.Connect(...): %s", err)
+			}
+			obs, err := e.Observe(ctx, tc.mg)
+			if err != nil {
+				t.Fatalf("e.Observe(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			_, err = e.Create(ctx, tc.mg)
+			if diff := cmp.Diff(tc.want.createErr, err != nil); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			_, _ = e.Update(ctx, tc.mg)
+			_ = e.Delete(ctx, tc.mg)
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\nExternalClient calls: -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
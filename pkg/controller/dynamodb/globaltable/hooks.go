@@ -39,6 +39,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupGlobalTable adds a controller that reconciles GlobalTable.
@@ -62,9 +63,9 @@ func SetupGlobalTable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.GlobalTable{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.GlobalTableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
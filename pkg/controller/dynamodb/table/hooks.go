@@ -42,6 +42,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupTable adds a controller that reconciles Table.
@@ -66,9 +67,9 @@ func SetupTable(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, po
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.Table{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.TableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(
 				managed.NewNameAsExternalName(mgr.GetClient()),
 				managed.NewDefaultProviderConfig(mgr.GetClient()),
@@ -143,8 +144,9 @@ func (e *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	for k, v := range resource.GetExternalTags(cr) {
-		tagMap[k] = v
+	tagMap, err := aws.MergeDefaultTags(ctx, e.kube, mg, tagMap)
+	if err != nil {
+		return err
 	}
 	tags := make([]*svcapitypes.Tag, 0)
 	for k, v := range tagMap {
@@ -42,6 +42,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -65,9 +66,9 @@ func SetupAddress(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1beta1.Address{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.AddressGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
 			managed.WithCreationGracePeriod(3*time.Minute),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
@@ -233,8 +234,9 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[t.Key] = t.Value
 	}
-	for k, v := range resource.GetExternalTags(mgd) {
-		tagMap[k] = v
+	tagMap, err := awsclient.MergeDefaultTags(ctx, t.kube, mgd, tagMap)
+	if err != nil {
+		return err
 	}
 	cr.Spec.ForProvider.Tags = make([]v1beta1.Tag, len(tagMap))
 	i := 0
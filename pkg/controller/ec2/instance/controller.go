@@ -26,6 +26,7 @@ import (
 	types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,6 +43,7 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -55,6 +57,8 @@ const (
 	errModifyInstanceAttributes = "failed to modify the Instance resource attributes"
 	errCreateTags               = "failed to create tags for the Instance resource"
 	errDelete                   = "failed to delete the Instance resource"
+	errStart                    = "failed to start the Instance resource"
+	errStop                     = "failed to stop the Instance resource"
 )
 
 // SetupInstance adds a controller that reconciles Instances.
@@ -66,9 +70,9 @@ func SetupInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.Instance{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.InstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewInstanceClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewInstanceClient}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
@@ -137,56 +141,12 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	// update the CRD spec for any new values from provider
 	current := cr.Spec.ForProvider.DeepCopy()
 
-	o := awsec2.DescribeInstanceAttributeOutput{}
-
-	for _, input := range []types.InstanceAttributeName{
-		types.InstanceAttributeNameDisableApiTermination,
-		types.InstanceAttributeNameEbsOptimized,
-		types.InstanceAttributeNameInstanceInitiatedShutdownBehavior,
-		types.InstanceAttributeNameInstanceType,
-		types.InstanceAttributeNameKernel,
-		types.InstanceAttributeNameRamdisk,
-		types.InstanceAttributeNameUserData,
-	} {
-		r, err := e.client.DescribeInstanceAttribute(ctx, &awsec2.DescribeInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			Attribute:  input,
-		})
-
-		if err != nil {
-			return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
-		}
-
-		if r.DisableApiTermination != nil {
-			o.DisableApiTermination = r.DisableApiTermination
-		}
-
-		if r.EbsOptimized != nil {
-			o.EbsOptimized = r.EbsOptimized
-		}
-
-		if r.InstanceInitiatedShutdownBehavior != nil {
-			o.InstanceInitiatedShutdownBehavior = r.InstanceInitiatedShutdownBehavior
-		}
-
-		if r.InstanceType != nil {
-			o.InstanceType = r.InstanceType
-		}
-
-		if r.KernelId != nil {
-			o.KernelId = r.KernelId
-		}
-
-		if r.RamdiskId != nil {
-			o.RamdiskId = r.RamdiskId
-		}
-
-		if r.UserData != nil {
-			o.UserData = r.UserData
-		}
+	o, err := e.describeInstanceAttributes(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
 	}
 
-	ec2.LateInitializeInstance(&cr.Spec.ForProvider, &observed, &o)
+	ec2.LateInitializeInstance(&cr.Spec.ForProvider, &observed, o)
 
 	if !cmp.Equal(current, &cr.Spec.ForProvider) {
 		if err := e.kube.Update(ctx, cr); err != nil {
@@ -197,13 +157,31 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	observation := ec2.GenerateInstanceObservation(observed)
 	condition := ec2.GenerateInstanceCondition(observation)
 
+	state := types.InstanceStateName(observation.State)
+	target := ec2.PowerStateTarget(cr.Spec.ForProvider.PowerState, isRestartPending(cr))
+	upToDate := ec2.IsInstanceUpToDate(cr.Spec.ForProvider, observed, *o) && ec2.IsPowerStateUpToDate(target, state)
+
+	// the power state of an instance is managed once it is set, or once the
+	// instance has been stopped to be modified.
+	managesPowerState := cr.Spec.ForProvider.PowerState != "" || cr.GetCondition(svcapitypes.TypePowerState).Status != corev1.ConditionUnknown
+	if managesPowerState && upToDate && (target == "" || state == target) {
+		cr.SetConditions(svcapitypes.PowerStateReached())
+	}
+
 	switch condition {
 	case ec2.Creating:
 		cr.SetConditions(xpv1.Creating())
 	case ec2.Available:
 		cr.SetConditions(xpv1.Available())
 	case ec2.Deleting:
-		cr.SetConditions(xpv1.Deleting())
+		switch {
+		case !managesPowerState:
+			cr.SetConditions(xpv1.Deleting())
+		case state == types.InstanceStateNameStopped && target == types.InstanceStateNameStopped:
+			cr.SetConditions(xpv1.Available())
+		default:
+			cr.SetConditions(xpv1.Unavailable())
+		}
 	case ec2.Deleted:
 		// Terminated instances remain visible on API calls for a time before
 		// being automatically deleted. Rather than having the delete command
@@ -218,7 +196,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        ec2.IsInstanceUpToDate(cr.Spec.ForProvider, observed, o),
+		ResourceUpToDate:        upToDate,
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -284,54 +262,129 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if cr.Spec.ForProvider.KernelID != nil {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			Kernel: &types.AttributeValue{
-				Value: cr.Spec.ForProvider.KernelID,
-			},
-		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
+	if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+		Resources: []string{meta.GetExternalName(cr)},
+		Tags:      svcapitypes.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
 
-		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
+	return managed.ExternalUpdate{}, e.updatePowerState(ctx, cr)
+}
+
+// updatePowerState stops the instance to modify the attributes that can only
+// be modified while it is stopped, and stops or starts it to reach its desired
+// power state.
+func (e *external) updatePowerState(ctx context.Context, cr *svcapitypes.Instance) error {
+	state := types.InstanceStateName(cr.Status.AtProvider.State)
+	if state != types.InstanceStateNameRunning && state != types.InstanceStateNameStopped {
+		// the instance cannot be stopped, modified or started while it is
+		// transitioning between states.
+		return nil
+	}
+
+	id := meta.GetExternalName(cr)
+	o, err := e.describeInstanceAttributes(ctx, id)
+	if err != nil {
+		return err
+	}
+	modifications, err := ec2.GenerateStoppedModifyInstanceAttributeInputs(id, cr.Spec.ForProvider, *o)
+	if err != nil {
+		return errors.Wrap(err, errModifyInstanceAttributes)
+	}
+
+	if len(modifications) > 0 {
+		if state == types.InstanceStateNameRunning {
+			if _, err := e.client.StopInstances(ctx, &awsec2.StopInstancesInput{InstanceIds: []string{id}}); err != nil {
+				return awsclient.Wrap(err, errStop)
+			}
+			cr.SetConditions(svcapitypes.StoppingToModify())
+			return nil
+		}
+		for _, input := range modifications {
+			if _, err := e.client.ModifyInstanceAttribute(ctx, input); err != nil {
+				return awsclient.Wrap(err, errModifyInstanceAttributes)
+			}
 		}
 	}
 
-	if cr.Spec.ForProvider.RAMDiskID != nil {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			Ramdisk: &types.AttributeValue{
-				Value: cr.Spec.ForProvider.RAMDiskID,
-			},
+	switch target := ec2.PowerStateTarget(cr.Spec.ForProvider.PowerState, isRestartPending(cr)); {
+	case target == types.InstanceStateNameRunning && state == types.InstanceStateNameStopped:
+		if _, err := e.client.StartInstances(ctx, &awsec2.StartInstancesInput{InstanceIds: []string{id}}); err != nil {
+			return awsclient.Wrap(err, errStart)
 		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
+		cr.SetConditions(svcapitypes.Starting())
+	case target == types.InstanceStateNameStopped && state == types.InstanceStateNameRunning:
+		if _, err := e.client.StopInstances(ctx, &awsec2.StopInstancesInput{
+			InstanceIds: []string{id},
+			Hibernate:   aws.Bool(cr.Spec.ForProvider.PowerState == svcapitypes.PowerStateHibernated),
+		}); err != nil {
+			return awsclient.Wrap(err, errStop)
+		}
+		cr.SetConditions(svcapitypes.Stopping())
+	}
+	return nil
+}
+
+// describeInstanceAttributes returns the attributes of the instance that are
+// not part of the DescribeInstances response.
+func (e *external) describeInstanceAttributes(ctx context.Context, id string) (*awsec2.DescribeInstanceAttributeOutput, error) {
+	o := awsec2.DescribeInstanceAttributeOutput{}
+
+	for _, input := range []types.InstanceAttributeName{
+		types.InstanceAttributeNameDisableApiTermination,
+		types.InstanceAttributeNameEbsOptimized,
+		types.InstanceAttributeNameInstanceInitiatedShutdownBehavior,
+		types.InstanceAttributeNameInstanceType,
+		types.InstanceAttributeNameKernel,
+		types.InstanceAttributeNameRamdisk,
+		types.InstanceAttributeNameUserData,
+	} {
+		r, err := e.client.DescribeInstanceAttribute(ctx, &awsec2.DescribeInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			Attribute:  input,
+		})
 
 		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
+			return nil, awsclient.Wrap(err, errDescribe)
 		}
-	}
 
-	if cr.Spec.ForProvider.UserData != nil {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			UserData: &types.BlobAttributeValue{
-				Value: []byte(*cr.Spec.ForProvider.UserData),
-			},
+		if r.DisableApiTermination != nil {
+			o.DisableApiTermination = r.DisableApiTermination
 		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
 
-		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
+		if r.EbsOptimized != nil {
+			o.EbsOptimized = r.EbsOptimized
+		}
+
+		if r.InstanceInitiatedShutdownBehavior != nil {
+			o.InstanceInitiatedShutdownBehavior = r.InstanceInitiatedShutdownBehavior
+		}
+
+		if r.InstanceType != nil {
+			o.InstanceType = r.InstanceType
+		}
+
+		if r.KernelId != nil {
+			o.KernelId = r.KernelId
+		}
+
+		if r.RamdiskId != nil {
+			o.RamdiskId = r.RamdiskId
+		}
+
+		if r.UserData != nil {
+			o.UserData = r.UserData
 		}
 	}
 
-	_, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
-		Resources: []string{meta.GetExternalName(cr)},
-		Tags:      svcapitypes.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
-	})
+	return &o, nil
+}
 
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+// isRestartPending returns true if the instance has been stopped to be
+// modified, and should be started again once it is.
+func isRestartPending(cr *svcapitypes.Instance) bool {
+	return cr.GetCondition(svcapitypes.TypePowerState).Reason == svcapitypes.ReasonStoppingToModify
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -362,8 +415,9 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[t.Key] = t.Value
 	}
-	for k, v := range resource.GetExternalTags(mgd) {
-		tagMap[k] = v
+	tagMap, err := awsclient.MergeDefaultTags(ctx, t.kube, mgd, tagMap)
+	if err != nil {
+		return err
 	}
 	cr.Spec.ForProvider.Tags = make([]svcapitypes.Tag, len(tagMap))
 	i := 0
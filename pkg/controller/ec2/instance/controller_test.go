@@ -34,6 +34,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go/document"
 
 	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
@@ -152,6 +153,106 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"StoppedPowerState": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(ctx context.Context, input *awsec2.DescribeInstancesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+						return &awsec2.DescribeInstancesOutput{
+							Reservations: []types.Reservation{{
+								Instances: []types.Instance{
+									{
+										InstanceId:   &instanceID,
+										InstanceType: types.InstanceTypeM1Small,
+										State: &types.InstanceState{
+											Name: types.InstanceStateNameStopped,
+										},
+									},
+								},
+							}},
+						}, nil
+					},
+					MockDescribeInstanceAttribute: func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+						return &awsec2.DescribeInstanceAttributeOutput{
+							InstanceId: &instanceID,
+							InstanceType: &types.AttributeValue{
+								Value: aws.String(string(types.InstanceTypeM1Small)),
+							},
+						}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM1Small),
+					PowerState:   manualv1alpha1.PowerStateStopped,
+				}), withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM1Small),
+					PowerState:   manualv1alpha1.PowerStateStopped,
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceID:   &instanceID,
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        "stopped",
+				}), withExternalName(instanceID),
+					withConditions(xpv1.Available(), manualv1alpha1.PowerStateReached())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"StoppedToModify": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(ctx context.Context, input *awsec2.DescribeInstancesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+						return &awsec2.DescribeInstancesOutput{
+							Reservations: []types.Reservation{{
+								Instances: []types.Instance{
+									{
+										InstanceId:   &instanceID,
+										InstanceType: types.InstanceTypeM1Small,
+										State: &types.InstanceState{
+											Name: types.InstanceStateNameStopped,
+										},
+									},
+								},
+							}},
+						}, nil
+					},
+					MockDescribeInstanceAttribute: func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+						return &awsec2.DescribeInstanceAttributeOutput{
+							InstanceId: &instanceID,
+							InstanceType: &types.AttributeValue{
+								Value: aws.String(string(types.InstanceTypeM1Small)),
+							},
+						}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withExternalName(instanceID), withConditions(manualv1alpha1.StoppingToModify())),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceID:   &instanceID,
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        "stopped",
+				}), withExternalName(instanceID),
+					withConditions(xpv1.Unavailable(), manualv1alpha1.StoppingToModify())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"MultipleInstances": {
 			args: args{
 				kube: &test.MockClient{
@@ -384,6 +485,131 @@ func TestUpdate(t *testing.T) {
 				cr: instance(withSpec(manualv1alpha1.InstanceParameters{})),
 			},
 		},
+		"StopToModify": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockDescribeInstanceAttribute: func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+						return &awsec2.DescribeInstanceAttributeOutput{
+							InstanceType: &types.AttributeValue{Value: aws.String(string(types.InstanceTypeM1Small))},
+						}, nil
+					},
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						if diff := cmp.Diff([]string{instanceID}, input.InstanceIds); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.StopInstancesOutput{}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameRunning),
+				}), withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameRunning),
+				}), withExternalName(instanceID), withConditions(manualv1alpha1.StoppingToModify())),
+			},
+		},
+		"ModifyAndStart": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockDescribeInstanceAttribute: func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+						return &awsec2.DescribeInstanceAttributeOutput{
+							InstanceType: &types.AttributeValue{Value: aws.String(string(types.InstanceTypeM1Small))},
+						}, nil
+					},
+					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
+						if diff := cmp.Diff(&types.AttributeValue{Value: aws.String(string(types.InstanceTypeM5Large))}, input.InstanceType, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ModifyInstanceAttributeOutput{}, nil
+					},
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						return &awsec2.StartInstancesOutput{}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameStopped),
+				}), withExternalName(instanceID), withConditions(manualv1alpha1.StoppingToModify())),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameStopped),
+				}), withExternalName(instanceID), withConditions(manualv1alpha1.Starting())),
+			},
+		},
+		"Hibernate": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockDescribeInstanceAttribute: func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+						return &awsec2.DescribeInstanceAttributeOutput{}, nil
+					},
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						if diff := cmp.Diff(aws.Bool(true), input.Hibernate); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.StopInstancesOutput{}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					PowerState: manualv1alpha1.PowerStateHibernated,
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameRunning),
+				}), withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					PowerState: manualv1alpha1.PowerStateHibernated,
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameRunning),
+				}), withExternalName(instanceID), withConditions(manualv1alpha1.Stopping())),
+			},
+		},
+		"StartFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockDescribeInstanceAttribute: func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+						return &awsec2.DescribeInstanceAttributeOutput{}, nil
+					},
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					PowerState: manualv1alpha1.PowerStateRunning,
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameStopped),
+				}), withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					PowerState: manualv1alpha1.PowerStateRunning,
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameStopped),
+				}), withExternalName(instanceID)),
+				err: awsclient.Wrap(errBoom, errStart),
+			},
+		},
 		"ModifyFailed": {
 			args: args{
 				instance: &fake.MockInstanceClient{
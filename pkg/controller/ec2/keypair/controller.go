@@ -0,0 +1,243 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keypair
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a KeyPair resource"
+
+	errDescribe          = "failed to describe KeyPair"
+	errMultipleItems     = "retrieved multiple KeyPairs for the given key name"
+	errCreate            = "failed to create the KeyPair resource"
+	errImport            = "failed to import the KeyPair resource"
+	errGetPublicKey      = "cannot get the public key Secret of the KeyPair"
+	errEmptyPublicKey    = "the public key Secret of the KeyPair has no value for the given key"
+	errNoConnectionRef   = "writeConnectionSecretToRef must be set to create a KeyPair without a publicKeySecretRef, since its private key can't be retrieved later"
+	errDelete            = "failed to delete the KeyPair resource"
+	errCreateTags        = "failed to create tags for the KeyPair resource"
+	errDeleteTags        = "failed to delete tags for the KeyPair resource"
+	errKeyPairIDNotFound = "cannot update the tags of the KeyPair, since the KeyPairID is not present"
+)
+
+// SetupKeyPair adds a controller that reconciles KeyPairs.
+func SetupKeyPair(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.KeyPairGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.KeyPair{}).
+		Complete(drift.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.KeyPairGroupVersionKind),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewKeyPairClient}),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.KeyPairClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.KeyPairClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	response, err := e.client.DescribeKeyPairs(ctx, &awsec2.DescribeKeyPairsInput{
+		KeyNames: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsKeyPairNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.KeyPairs) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	observed := response.KeyPairs[0]
+	cr.Status.AtProvider = ec2.GenerateKeyPairObservation(observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsKeyPairUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+// Create imports the public key of the referenced Secret if there is one, or
+// has AWS create the key pair and publishes its private key otherwise.
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	if ref := cr.Spec.ForProvider.PublicKeySecretRef; ref != nil {
+		publicKey, err := e.getPublicKey(ctx, ref)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		_, err = e.client.ImportKeyPair(ctx, &awsec2.ImportKeyPairInput{
+			KeyName:           aws.String(meta.GetExternalName(cr)),
+			PublicKeyMaterial: publicKey,
+			TagSpecifications: ec2.GenerateKeyPairTagSpecifications(cr.Spec.ForProvider),
+		})
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errImport)
+	}
+
+	// The private key of a KeyPair AWS generates is only returned once.
+	if cr.GetWriteConnectionSecretToReference() == nil {
+		return managed.ExternalCreation{}, errors.New(errNoConnectionRef)
+	}
+	result, err := e.client.CreateKeyPair(ctx, &awsec2.CreateKeyPairInput{
+		KeyName:           aws.String(meta.GetExternalName(cr)),
+		KeyType:           awsec2types.KeyType(aws.ToString(cr.Spec.ForProvider.KeyType)),
+		TagSpecifications: ec2.GenerateKeyPairTagSpecifications(cr.Spec.ForProvider),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			manualv1alpha1.KeyPairPrivateKey: []byte(aws.ToString(result.KeyMaterial)),
+		},
+	}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	response, err := e.client.DescribeKeyPairs(ctx, &awsec2.DescribeKeyPairsInput{
+		KeyNames: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	if len(response.KeyPairs) == 0 || response.KeyPairs[0].KeyPairId == nil {
+		return managed.ExternalUpdate{}, errors.New(errKeyPairIDNotFound)
+	}
+	observed := response.KeyPairs[0]
+
+	addTags, removeTags := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{aws.ToString(observed.KeyPairId)},
+			Tags:      addTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{aws.ToString(observed.KeyPairId)},
+			Tags:      removeTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.KeyPair)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteKeyPair(ctx, &awsec2.DeleteKeyPairInput{
+		KeyName: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsKeyPairNotFoundErr, err), errDelete)
+}
+
+func (e *external) getPublicKey(ctx context.Context, ref *xpv1.SecretKeySelector) ([]byte, error) {
+	nn := types.NamespacedName{
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, nn, s); err != nil {
+		return nil, errors.Wrap(err, errGetPublicKey)
+	}
+	if len(s.Data[ref.Key]) == 0 {
+		return nil, errors.New(errEmptyPublicKey)
+	}
+	return s.Data[ref.Key], nil
+}
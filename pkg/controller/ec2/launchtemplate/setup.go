@@ -22,6 +22,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupLaunchTemplate adds a controller that reconciles LaunchTemplate.
@@ -42,10 +43,11 @@ func SetupLaunchTemplate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLi
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.LaunchTemplate{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.LaunchTemplateGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -102,13 +104,13 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 		}
 	}
 
-	tagMap := map[string]string{}
-	tagMap["Name"] = cr.Name
+	tagMap := map[string]string{"Name": cr.Name}
 	for _, t := range launchTemplateTags.Tags {
 		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	for k, v := range resource.GetExternalTags(mgd) {
-		tagMap[k] = v
+	tagMap, err := aws.MergeDefaultTags(ctx, t.kube, mgd, tagMap)
+	if err != nil {
+		return err
 	}
 	launchTemplateTags.Tags = make([]*svcapitypes.Tag, len(tagMap))
 	launchTemplateTags.ResourceType = aws.String("launch-template")
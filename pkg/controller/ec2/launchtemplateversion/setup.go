@@ -20,6 +20,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupLaunchTemplateVersion adds a controller that reconciles LaunchTemplateVersion.
@@ -40,9 +41,9 @@ func SetupLaunchTemplateVersion(mgr ctrl.Manager, l logging.Logger, rl workqueue
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.LaunchTemplateVersion{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			cpresource.ManagedKind(svcapitypes.LaunchTemplateVersionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -0,0 +1,301 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedprefixlist
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a ManagedPrefixList resource"
+
+	errDescribe      = "failed to describe ManagedPrefixList"
+	errMultipleItems = "retrieved multiple ManagedPrefixLists for the given prefix list ID"
+	errGetEntries    = "failed to get the entries of the ManagedPrefixList"
+	errInvalidSpec   = "invalid entries of the ManagedPrefixList"
+	errCreate        = "failed to create the ManagedPrefixList resource"
+	errModify        = "failed to modify the ManagedPrefixList resource"
+	errDelete        = "failed to delete the ManagedPrefixList resource"
+	errCreateTags    = "failed to create tags for the ManagedPrefixList resource"
+	errDeleteTags    = "failed to delete tags for the ManagedPrefixList resource"
+)
+
+// SetupManagedPrefixList adds a controller that reconciles ManagedPrefixLists.
+func SetupManagedPrefixList(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.ManagedPrefixListGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.ManagedPrefixList{}).
+		Complete(drift.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.ManagedPrefixListGroupVersionKind),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewManagedPrefixListClient}),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.ManagedPrefixListClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.ManagedPrefixListClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(ec2.IsManagedPrefixListNotFoundErr, err)
+	}
+	if observed.State == awsec2types.PrefixListStateDeleteComplete {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = ec2.GenerateManagedPrefixListObservation(observed)
+
+	switch observed.State { //nolint:exhaustive
+	case awsec2types.PrefixListStateCreateInProgress:
+		cr.SetConditions(xpv1.Creating())
+	case awsec2types.PrefixListStateDeleteInProgress:
+		cr.SetConditions(xpv1.Deleting())
+	case awsec2types.PrefixListStateCreateFailed,
+		awsec2types.PrefixListStateModifyFailed,
+		awsec2types.PrefixListStateRestoreFailed,
+		awsec2types.PrefixListStateDeleteFailed:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(aws.ToString(observed.StateMessage)))
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	// A prefix list cannot be modified while an operation is in progress, so
+	// we report it as up to date until the operation completes.
+	if ec2.IsManagedPrefixListInProgress(observed) {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	entries, err := e.getEntries(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsManagedPrefixListUpToDate(cr.Spec.ForProvider, observed, entries),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	if err := ec2.ValidateManagedPrefixListEntries(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errInvalidSpec)
+	}
+
+	result, err := e.client.CreateManagedPrefixList(ctx, ec2.GenerateCreateManagedPrefixListInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.ToString(result.PrefixList.PrefixListId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+// Update applies at most one modification of the prefix list per call, since
+// AWS does not allow to change its size and its entries at once and rejects
+// any modification while the previous one is in progress. The size is grown
+// before entries are added and shrunk after they are removed.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	if err := ec2.ValidateManagedPrefixListEntries(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errInvalidSpec)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if err := e.updateTags(ctx, cr, observed); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	entries, err := e.getEntries(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	diff := ec2.DiffManagedPrefixListEntries(cr.Spec.ForProvider.Entries, entries)
+
+	input := &awsec2.ModifyManagedPrefixListInput{
+		PrefixListId: aws.String(meta.GetExternalName(cr)),
+	}
+	if cr.Spec.ForProvider.PrefixListName != aws.ToString(observed.PrefixListName) {
+		input.PrefixListName = aws.String(cr.Spec.ForProvider.PrefixListName)
+	}
+
+	maxEntries := aws.ToInt32(observed.MaxEntries)
+	switch {
+	case cr.Spec.ForProvider.MaxEntries > maxEntries:
+		input.MaxEntries = aws.Int32(cr.Spec.ForProvider.MaxEntries)
+	case !diff.IsEmpty():
+		// The modification only applies if the prefix list is still at the
+		// version the diff was computed from.
+		input.CurrentVersion = observed.Version
+		input.AddEntries = diff.Add
+		input.RemoveEntries = diff.Remove
+	case cr.Spec.ForProvider.MaxEntries < maxEntries:
+		input.MaxEntries = aws.Int32(cr.Spec.ForProvider.MaxEntries)
+	case input.PrefixListName == nil:
+		return managed.ExternalUpdate{}, nil
+	}
+
+	_, err = e.client.ModifyManagedPrefixList(ctx, input)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	if cr.Status.AtProvider.State == string(awsec2types.PrefixListStateDeleteInProgress) {
+		return nil
+	}
+
+	_, err := e.client.DeleteManagedPrefixList(ctx, &awsec2.DeleteManagedPrefixListInput{
+		PrefixListId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsManagedPrefixListNotFoundErr, err), errDelete)
+}
+
+func (e *external) describe(ctx context.Context, id string) (awsec2types.ManagedPrefixList, error) {
+	response, err := e.client.DescribeManagedPrefixLists(ctx, &awsec2.DescribeManagedPrefixListsInput{
+		PrefixListIds: []string{id},
+	})
+	if err != nil {
+		return awsec2types.ManagedPrefixList{}, awsclient.Wrap(err, errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.PrefixLists) != 1 {
+		return awsec2types.ManagedPrefixList{}, errors.New(errMultipleItems)
+	}
+	return response.PrefixLists[0], nil
+}
+
+func (e *external) getEntries(ctx context.Context, id string) ([]awsec2types.PrefixListEntry, error) {
+	var entries []awsec2types.PrefixListEntry
+	input := &awsec2.GetManagedPrefixListEntriesInput{
+		PrefixListId: aws.String(id),
+	}
+	for {
+		response, err := e.client.GetManagedPrefixListEntries(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, errGetEntries)
+		}
+		entries = append(entries, response.Entries...)
+		if aws.ToString(response.NextToken) == "" {
+			return entries, nil
+		}
+		input.NextToken = response.NextToken
+	}
+}
+
+func (e *external) updateTags(ctx context.Context, cr *manualv1alpha1.ManagedPrefixList, observed awsec2types.ManagedPrefixList) error {
+	addTags, removeTags := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      addTags,
+		}); err != nil {
+			return awsclient.Wrap(err, errCreateTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      removeTags,
+		}); err != nil {
+			return awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	return nil
+}
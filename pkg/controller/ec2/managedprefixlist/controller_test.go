@@ -0,0 +1,555 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedprefixlist
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	prefixListID   = "pl-1"
+	prefixListName = "corporate"
+	version        = int64(3)
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.ManagedPrefixListClient
+	cr     *manualv1alpha1.ManagedPrefixList
+}
+
+type prefixListModifier func(*manualv1alpha1.ManagedPrefixList)
+
+func withExternalName(name string) prefixListModifier {
+	return func(r *manualv1alpha1.ManagedPrefixList) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p manualv1alpha1.ManagedPrefixListParameters) prefixListModifier {
+	return func(r *manualv1alpha1.ManagedPrefixList) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.ManagedPrefixListObservation) prefixListModifier {
+	return func(r *manualv1alpha1.ManagedPrefixList) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) prefixListModifier {
+	return func(r *manualv1alpha1.ManagedPrefixList) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func prefixList(m ...prefixListModifier) *manualv1alpha1.ManagedPrefixList {
+	cr := &manualv1alpha1.ManagedPrefixList{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func entry(cidr string) manualv1alpha1.PrefixListEntry {
+	return manualv1alpha1.PrefixListEntry{CIDR: cidr}
+}
+
+func params(maxEntries int32, entries ...manualv1alpha1.PrefixListEntry) manualv1alpha1.ManagedPrefixListParameters {
+	return manualv1alpha1.ManagedPrefixListParameters{
+		Region:         "us-east-1",
+		PrefixListName: prefixListName,
+		AddressFamily:  "IPv4",
+		MaxEntries:     maxEntries,
+		Entries:        entries,
+	}
+}
+
+func observedPrefixList(state awsec2types.PrefixListState, maxEntries int32) awsec2types.ManagedPrefixList {
+	return awsec2types.ManagedPrefixList{
+		PrefixListId:   aws.String(prefixListID),
+		PrefixListName: aws.String(prefixListName),
+		AddressFamily:  aws.String("IPv4"),
+		MaxEntries:     aws.Int32(maxEntries),
+		State:          state,
+		Version:        aws.Int64(version),
+	}
+}
+
+func describe(pl awsec2types.ManagedPrefixList) func(ctx context.Context, input *awsec2.DescribeManagedPrefixListsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeManagedPrefixListsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+		return &awsec2.DescribeManagedPrefixListsOutput{PrefixLists: []awsec2types.ManagedPrefixList{pl}}, nil
+	}
+}
+
+func getEntries(cidrs ...string) func(ctx context.Context, input *awsec2.GetManagedPrefixListEntriesInput, opts []func(*awsec2.Options)) (*awsec2.GetManagedPrefixListEntriesOutput, error) {
+	return func(ctx context.Context, input *awsec2.GetManagedPrefixListEntriesInput, opts []func(*awsec2.Options)) (*awsec2.GetManagedPrefixListEntriesOutput, error) {
+		out := &awsec2.GetManagedPrefixListEntriesOutput{}
+		for _, c := range cidrs {
+			out.Entries = append(out.Entries, awsec2types.PrefixListEntry{Cidr: aws.String(c)})
+		}
+		return out, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.ManagedPrefixList
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observation := func(state awsec2types.PrefixListState) manualv1alpha1.ManagedPrefixListObservation {
+		return manualv1alpha1.ManagedPrefixListObservation{
+			PrefixListID: prefixListID,
+			State:        string(state),
+			Version:      version,
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(observedPrefixList(awsec2types.PrefixListStateCreateComplete, 2)),
+					MockGetEntries: getEntries("10.0.0.0/8"),
+				},
+				cr: prefixList(withExternalName(prefixListID), withSpec(params(2, entry("10.0.0.0/8")))),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID), withSpec(params(2, entry("10.0.0.0/8"))),
+					withStatus(observation(awsec2types.PrefixListStateCreateComplete)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"EntriesChanged": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(observedPrefixList(awsec2types.PrefixListStateModifyComplete, 2)),
+					MockGetEntries: getEntries("10.0.0.0/8"),
+				},
+				cr: prefixList(withExternalName(prefixListID), withSpec(params(2, entry("192.168.0.0/16")))),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID), withSpec(params(2, entry("192.168.0.0/16"))),
+					withStatus(observation(awsec2types.PrefixListStateModifyComplete)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ModifyInProgress": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe: describe(observedPrefixList(awsec2types.PrefixListStateModifyInProgress, 2)),
+				},
+				cr: prefixList(withExternalName(prefixListID), withSpec(params(2, entry("192.168.0.0/16")))),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID), withSpec(params(2, entry("192.168.0.0/16"))),
+					withStatus(observation(awsec2types.PrefixListStateModifyInProgress)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: prefixList(withSpec(params(2))),
+			},
+			want: want{
+				cr: prefixList(withSpec(params(2))),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeManagedPrefixListsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.ManagedPrefixListNotFound}
+					},
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+		},
+		"DeleteComplete": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe: describe(observedPrefixList(awsec2types.PrefixListStateDeleteComplete, 2)),
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeManagedPrefixListsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr:  prefixList(withExternalName(prefixListID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"GetEntriesFail": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe: describe(observedPrefixList(awsec2types.PrefixListStateCreateComplete, 2)),
+					MockGetEntries: func(ctx context.Context, input *awsec2.GetManagedPrefixListEntriesInput, opts []func(*awsec2.Options)) (*awsec2.GetManagedPrefixListEntriesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID),
+					withStatus(observation(awsec2types.PrefixListStateCreateComplete)),
+					withConditions(xpv1.Available())),
+				err: awsclient.Wrap(errBoom, errGetEntries),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.ManagedPrefixList
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.CreateManagedPrefixListOutput, error) {
+						want := []awsec2types.AddPrefixListEntry{{Cidr: aws.String("10.0.0.0/8")}}
+						if diff := cmp.Diff(want, input.Entries, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateManagedPrefixListOutput{
+							PrefixList: &awsec2types.ManagedPrefixList{PrefixListId: aws.String(prefixListID)},
+						}, nil
+					},
+				},
+				cr: prefixList(withSpec(params(2, entry("10.0.0.0/8")))),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID), withSpec(params(2, entry("10.0.0.0/8"))),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"TooManyEntries": {
+			args: args{
+				cr: prefixList(withSpec(params(1, entry("10.0.0.0/8"), entry("192.168.0.0/16")))),
+			},
+			want: want{
+				cr: prefixList(withSpec(params(1, entry("10.0.0.0/8"), entry("192.168.0.0/16"))),
+					withConditions(xpv1.Creating())),
+				err: errors.Wrap(ec2.ValidateManagedPrefixListEntries(params(1, entry("10.0.0.0/8"), entry("192.168.0.0/16"))), errInvalidSpec),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.CreateManagedPrefixListOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: prefixList(withSpec(params(2))),
+			},
+			want: want{
+				cr:  prefixList(withSpec(params(2)), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		modify *awsec2.ModifyManagedPrefixListInput
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		observed awsec2types.ManagedPrefixList
+		entries  []string
+		want
+	}{
+		"GrowBeforeAddingEntries": {
+			args: args{
+				cr: prefixList(withExternalName(prefixListID), withSpec(params(3, entry("10.0.0.0/8"), entry("192.168.0.0/16"), entry("172.16.0.0/12")))),
+			},
+			observed: observedPrefixList(awsec2types.PrefixListStateCreateComplete, 1),
+			entries:  []string{"10.0.0.0/8"},
+			want: want{
+				modify: &awsec2.ModifyManagedPrefixListInput{
+					PrefixListId: aws.String(prefixListID),
+					MaxEntries:   aws.Int32(3),
+				},
+			},
+		},
+		"ModifyEntriesAtObservedVersion": {
+			args: args{
+				cr: prefixList(withExternalName(prefixListID), withSpec(params(2, entry("192.168.0.0/16")))),
+			},
+			observed: observedPrefixList(awsec2types.PrefixListStateCreateComplete, 2),
+			entries:  []string{"10.0.0.0/8"},
+			want: want{
+				modify: &awsec2.ModifyManagedPrefixListInput{
+					PrefixListId:   aws.String(prefixListID),
+					CurrentVersion: aws.Int64(version),
+					AddEntries:     []awsec2types.AddPrefixListEntry{{Cidr: aws.String("192.168.0.0/16")}},
+					RemoveEntries:  []awsec2types.RemovePrefixListEntry{{Cidr: aws.String("10.0.0.0/8")}},
+				},
+			},
+		},
+		"ShrinkAfterRemovingEntries": {
+			args: args{
+				cr: prefixList(withExternalName(prefixListID), withSpec(params(1, entry("10.0.0.0/8")))),
+			},
+			observed: observedPrefixList(awsec2types.PrefixListStateModifyComplete, 5),
+			entries:  []string{"10.0.0.0/8"},
+			want: want{
+				modify: &awsec2.ModifyManagedPrefixListInput{
+					PrefixListId: aws.String(prefixListID),
+					MaxEntries:   aws.Int32(1),
+				},
+			},
+		},
+		"Rename": {
+			args: args{
+				cr: prefixList(withExternalName(prefixListID), withSpec(params(2, entry("10.0.0.0/8")))),
+			},
+			observed: func() awsec2types.ManagedPrefixList {
+				pl := observedPrefixList(awsec2types.PrefixListStateCreateComplete, 2)
+				pl.PrefixListName = aws.String("old")
+				return pl
+			}(),
+			entries: []string{"10.0.0.0/8"},
+			want: want{
+				modify: &awsec2.ModifyManagedPrefixListInput{
+					PrefixListId:   aws.String(prefixListID),
+					PrefixListName: aws.String(prefixListName),
+				},
+			},
+		},
+		"TooManyEntries": {
+			args: args{
+				cr: prefixList(withExternalName(prefixListID), withSpec(params(1, entry("10.0.0.0/8"), entry("192.168.0.0/16")))),
+			},
+			want: want{
+				err: errors.Wrap(ec2.ValidateManagedPrefixListEntries(params(1, entry("10.0.0.0/8"), entry("192.168.0.0/16"))), errInvalidSpec),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(observedPrefixList(awsec2types.PrefixListStateCreateComplete, 2)),
+					MockGetEntries: getEntries(),
+					MockModify: func(ctx context.Context, input *awsec2.ModifyManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.ModifyManagedPrefixListOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: prefixList(withExternalName(prefixListID), withSpec(params(2, entry("10.0.0.0/8")))),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var modify *awsec2.ModifyManagedPrefixListInput
+			client := tc.client
+			if client == nil {
+				client = &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(tc.observed),
+					MockGetEntries: getEntries(tc.entries...),
+					MockModify: func(ctx context.Context, input *awsec2.ModifyManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.ModifyManagedPrefixListOutput, error) {
+						modify = input
+						return &awsec2.ModifyManagedPrefixListOutput{}, nil
+					},
+				}
+			}
+			e := &external{client: client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modify, modify, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.ManagedPrefixList
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.DeleteManagedPrefixListOutput, error) {
+						return &awsec2.DeleteManagedPrefixListOutput{}, nil
+					},
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteInProgress": {
+			args: args{
+				cr: prefixList(withExternalName(prefixListID),
+					withStatus(manualv1alpha1.ManagedPrefixListObservation{State: string(awsec2types.PrefixListStateDeleteInProgress)})),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID),
+					withStatus(manualv1alpha1.ManagedPrefixListObservation{State: string(awsec2types.PrefixListStateDeleteInProgress)}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.DeleteManagedPrefixListOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.ManagedPrefixListNotFound}
+					},
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.DeleteManagedPrefixListOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr:  prefixList(withExternalName(prefixListID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
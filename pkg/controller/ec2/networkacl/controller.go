@@ -0,0 +1,359 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a NetworkACL resource"
+
+	errDescribe           = "failed to describe NetworkACL"
+	errMultipleItems      = "retrieved multiple NetworkACLs for the given networkAclId"
+	errCreate             = "failed to create the NetworkACL resource"
+	errUpdate             = "failed to update the NetworkACL"
+	errUpdateNotFound     = "cannot update the NetworkACL, since the NetworkACLID is not present"
+	errDelete             = "failed to delete the NetworkACL resource"
+	errCreateEntry        = "failed to create an entry in the NetworkACL resource"
+	errReplaceEntry       = "failed to replace an entry in the NetworkACL resource"
+	errDeleteEntry        = "failed to delete an entry in the NetworkACL resource"
+	errAssociateSubnet    = "failed to associate subnet to the NetworkACL resource"
+	errDisassociateSubnet = "failed to disassociate subnet from the NetworkACL resource"
+	errNoSubnetACL        = "cannot find the current network ACL association of subnet"
+	errNoDefaultACL       = "cannot find the default network ACL of the VPC"
+	errCreateTags         = "failed to create tags for the NetworkACL resource"
+	errDeleteTags         = "failed to delete tags for the NetworkACL resource"
+)
+
+// SetupNetworkACL adds a controller that reconciles NetworkACLs.
+func SetupNetworkACL(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.NetworkACLGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.NetworkACL{}).
+		Complete(drift.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.NetworkACLGroupVersionKind),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewNetworkACLClient}),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.NetworkACLClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.NetworkACLClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	response, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.NetworkAcls) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	observed := response.NetworkAcls[0]
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeNetworkACL(&cr.Spec.ForProvider, &observed)
+
+	cr.Status.AtProvider = ec2.GenerateNetworkACLObservation(observed)
+	cr.SetConditions(xpv1.Available())
+
+	upToDate, err := ec2.IsNetworkACLUpToDate(cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	input := &awsec2.CreateNetworkAclInput{
+		VpcId: cr.Spec.ForProvider.VPCID,
+	}
+	if len(cr.Spec.ForProvider.Tags) != 0 {
+		input.TagSpecifications = []awsec2types.TagSpecification{{
+			ResourceType: awsec2types.ResourceTypeNetworkAcl,
+			Tags:         manualv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+		}}
+	}
+	result, err := e.client.CreateNetworkAcl(ctx, input)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(result.NetworkAcl.NetworkAclId))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	response, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	if len(response.NetworkAcls) == 0 {
+		return managed.ExternalUpdate{}, errors.New(errUpdateNotFound)
+	}
+	acl := response.NetworkAcls[0]
+
+	if err := e.reconcileTags(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, acl.Tags); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if cr.Spec.ForProvider.Ingress != nil {
+		if err := e.reconcileEntries(ctx, meta.GetExternalName(cr), false, cr.Spec.ForProvider.Ingress, acl.Entries); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	if cr.Spec.ForProvider.Egress != nil {
+		if err := e.reconcileEntries(ctx, meta.GetExternalName(cr), true, cr.Spec.ForProvider.Egress, acl.Entries); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	associate, disassociate := ec2.DiffNetworkACLSubnets(cr.Spec.ForProvider.SubnetIDs, acl.Associations)
+	if err := e.associateSubnets(ctx, meta.GetExternalName(cr), associate); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	ids := make([]string, len(disassociate))
+	for i, a := range disassociate {
+		ids[i] = aws.ToString(a.NetworkAclAssociationId)
+	}
+	return managed.ExternalUpdate{}, e.disassociateSubnets(ctx, aws.ToString(acl.VpcId), ids)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACL)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	// a network ACL cannot be deleted while it is associated with subnets.
+	ids := make([]string, len(cr.Status.AtProvider.Associations))
+	for i, a := range cr.Status.AtProvider.Associations {
+		ids[i] = a.AssociationID
+	}
+	if err := e.disassociateSubnets(ctx, aws.ToString(cr.Spec.ForProvider.VPCID), ids); err != nil {
+		return err
+	}
+
+	_, err := e.client.DeleteNetworkAcl(ctx, &awsec2.DeleteNetworkAclInput{
+		NetworkAclId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDelete)
+}
+
+func (e *external) reconcileTags(ctx context.Context, aclID string, desired []manualv1alpha1.Tag, observed []awsec2types.Tag) error {
+	addTags, removeTags := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(desired), observed)
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{aclID},
+			Tags:      addTags,
+		}); err != nil {
+			return awsclient.Wrap(err, errCreateTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{aclID},
+			Tags:      removeTags,
+		}); err != nil {
+			return awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	return nil
+}
+
+// reconcileEntries converges the entries of one direction of the network
+// ACL. Undesired entries are deleted first so that their rule numbers and
+// the per-ACL entry quota are freed before new entries are created.
+func (e *external) reconcileEntries(ctx context.Context, aclID string, egress bool, desired []manualv1alpha1.NetworkACLRule, observed []awsec2types.NetworkAclEntry) error {
+	diff, err := ec2.DiffNetworkACLEntries(desired, observed, egress)
+	if err != nil {
+		return awsclient.Wrap(err, errUpdate)
+	}
+	for _, n := range diff.Delete {
+		_, err := e.client.DeleteNetworkAclEntry(ctx, &awsec2.DeleteNetworkAclEntryInput{
+			NetworkAclId: aws.String(aclID),
+			Egress:       aws.Bool(egress),
+			RuleNumber:   aws.Int32(n),
+		})
+		if resource.Ignore(ec2.IsNetworkACLEntryNotFoundErr, err) != nil {
+			return awsclient.Wrap(err, errDeleteEntry)
+		}
+	}
+	for _, r := range diff.Replace {
+		if _, err := e.client.ReplaceNetworkAclEntry(ctx, ec2.GenerateReplaceNetworkACLEntryInput(aclID, egress, r)); err != nil {
+			return awsclient.Wrap(err, errReplaceEntry)
+		}
+	}
+	for _, r := range diff.Create {
+		if _, err := e.client.CreateNetworkAclEntry(ctx, ec2.GenerateCreateNetworkACLEntryInput(aclID, egress, r)); err != nil {
+			return awsclient.Wrap(err, errCreateEntry)
+		}
+	}
+	return nil
+}
+
+// associateSubnets moves the given subnets from their current network ACL to
+// the network ACL with the given ID.
+func (e *external) associateSubnets(ctx context.Context, aclID string, subnetIDs []string) error {
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+	response, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2types.Filter{{
+			Name:   aws.String("association.subnet-id"),
+			Values: subnetIDs,
+		}},
+	})
+	if err != nil {
+		return awsclient.Wrap(err, errAssociateSubnet)
+	}
+	associations := map[string]string{}
+	for _, acl := range response.NetworkAcls {
+		for _, a := range acl.Associations {
+			associations[aws.ToString(a.SubnetId)] = aws.ToString(a.NetworkAclAssociationId)
+		}
+	}
+	for _, s := range subnetIDs {
+		id, ok := associations[s]
+		if !ok {
+			return errors.Errorf("%s %s", errNoSubnetACL, s)
+		}
+		if _, err := e.client.ReplaceNetworkAclAssociation(ctx, &awsec2.ReplaceNetworkAclAssociationInput{
+			AssociationId: aws.String(id),
+			NetworkAclId:  aws.String(aclID),
+		}); err != nil {
+			return awsclient.Wrap(err, errAssociateSubnet)
+		}
+	}
+	return nil
+}
+
+// disassociateSubnets moves the subnets of the given associations back to
+// the default network ACL of the VPC, since every subnet has to be
+// associated with a network ACL.
+func (e *external) disassociateSubnets(ctx context.Context, vpcID string, associationIDs []string) error {
+	if len(associationIDs) == 0 {
+		return nil
+	}
+	response, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2types.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+			{Name: aws.String("default"), Values: []string{"true"}},
+		},
+	})
+	if err != nil {
+		return awsclient.Wrap(err, errDisassociateSubnet)
+	}
+	if len(response.NetworkAcls) == 0 {
+		return errors.New(errNoDefaultACL)
+	}
+	for _, id := range associationIDs {
+		_, err := e.client.ReplaceNetworkAclAssociation(ctx, &awsec2.ReplaceNetworkAclAssociationInput{
+			AssociationId: aws.String(id),
+			NetworkAclId:  response.NetworkAcls[0].NetworkAclId,
+		})
+		if resource.Ignore(ec2.IsAssociationIDNotFoundErr, err) != nil {
+			return awsclient.Wrap(err, errDisassociateSubnet)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,527 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	aclID               = "acl-1"
+	defaultACLID        = "acl-default"
+	vpcID               = "vpc-1"
+	subnetID            = "subnet-1"
+	associationID       = "aclassoc-1"
+	ownerID             = "123456789012"
+	cidr                = "10.0.0.0/8"
+	port80        int32 = 80
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.NetworkACLClient
+	cr     *manualv1alpha1.NetworkACL
+}
+
+type aclModifier func(*manualv1alpha1.NetworkACL)
+
+func withExternalName(name string) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p manualv1alpha1.NetworkACLParameters) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.NetworkACLObservation) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) aclModifier {
+	return func(r *manualv1alpha1.NetworkACL) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func networkACL(m ...aclModifier) *manualv1alpha1.NetworkACL {
+	cr := &manualv1alpha1.NetworkACL{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func rule(n int32) manualv1alpha1.NetworkACLRule {
+	return manualv1alpha1.NetworkACLRule{
+		RuleNumber: n,
+		Protocol:   "tcp",
+		RuleAction: manualv1alpha1.NetworkACLRuleActionAllow,
+		CIDRBlock:  aws.String(cidr),
+		FromPort:   &port80,
+		ToPort:     &port80,
+	}
+}
+
+func entry(n int32, egress bool) awsec2types.NetworkAclEntry {
+	return awsec2types.NetworkAclEntry{
+		RuleNumber: aws.Int32(n),
+		Egress:     aws.Bool(egress),
+		Protocol:   aws.String("6"),
+		RuleAction: awsec2types.RuleActionAllow,
+		CidrBlock:  aws.String(cidr),
+		PortRange:  &awsec2types.PortRange{From: &port80, To: &port80},
+	}
+}
+
+func params() manualv1alpha1.NetworkACLParameters {
+	return manualv1alpha1.NetworkACLParameters{
+		VPCID:     aws.String(vpcID),
+		SubnetIDs: []string{subnetID},
+		Ingress:   []manualv1alpha1.NetworkACLRule{rule(100)},
+	}
+}
+
+func observedACL() awsec2types.NetworkAcl {
+	return awsec2types.NetworkAcl{
+		NetworkAclId: aws.String(aclID),
+		OwnerId:      aws.String(ownerID),
+		VpcId:        aws.String(vpcID),
+		IsDefault:    aws.Bool(false),
+		Associations: []awsec2types.NetworkAclAssociation{{
+			NetworkAclAssociationId: aws.String(associationID),
+			NetworkAclId:            aws.String(aclID),
+			SubnetId:                aws.String(subnetID),
+		}},
+		Entries: []awsec2types.NetworkAclEntry{
+			entry(100, false),
+			{RuleNumber: aws.Int32(ec2.DefaultNetworkACLRuleNumber), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: awsec2types.RuleActionDeny},
+		},
+	}
+}
+
+func observation() manualv1alpha1.NetworkACLObservation {
+	return manualv1alpha1.NetworkACLObservation{
+		NetworkACLID: aclID,
+		OwnerID:      ownerID,
+		Associations: []manualv1alpha1.NetworkACLAssociation{{
+			AssociationID: associationID,
+			SubnetID:      subnetID,
+		}},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.NetworkACL
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{observedACL()}}, nil
+					},
+				},
+				cr: networkACL(withSpec(params()), withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withSpec(params()), withExternalName(aclID),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSubnets": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{observedACL()}}, nil
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:     aws.String(vpcID),
+					SubnetIDs: []string{subnetID},
+				}), withExternalName(aclID),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"EntryChanged": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						acl := observedACL()
+						acl.Entries[0].RuleAction = awsec2types.RuleActionDeny
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{acl}}, nil
+					},
+				},
+				cr: networkACL(withSpec(params()), withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withSpec(params()), withExternalName(aclID),
+					withStatus(observation()),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLIDNotFound}
+					},
+				},
+				cr: networkACL(withSpec(params()), withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withSpec(params()), withExternalName(aclID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACL(withSpec(params()), withExternalName(aclID)),
+			},
+			want: want{
+				cr:  networkACL(withSpec(params()), withExternalName(aclID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.NetworkACL
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclOutput, error) {
+						return &awsec2.CreateNetworkAclOutput{NetworkAcl: &awsec2types.NetworkAcl{NetworkAclId: aws.String(aclID)}}, nil
+					},
+				},
+				cr: networkACL(withSpec(params())),
+			},
+			want: want{
+				cr: networkACL(withSpec(params()), withExternalName(aclID),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACL(withSpec(params())),
+			},
+			want: want{
+				cr:  networkACL(withSpec(params()), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	// describe answers lookups by ID with the given network ACL, lookups of
+	// the associations of subnet-2 with the default network ACL of the VPC,
+	// and lookups of the default network ACL with that ACL.
+	describe := func(acl awsec2types.NetworkAcl) func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+		return func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+			if len(input.NetworkAclIds) != 0 {
+				return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{acl}}, nil
+			}
+			return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{
+				NetworkAclId: aws.String(defaultACLID),
+				Associations: []awsec2types.NetworkAclAssociation{{
+					NetworkAclAssociationId: aws.String("aclassoc-2"),
+					SubnetId:                aws.String("subnet-2"),
+				}},
+			}}}, nil
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"EntriesAndSubnets": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: describe(func() awsec2types.NetworkAcl {
+						acl := observedACL()
+						acl.Entries = append(acl.Entries, entry(50, false), entry(100, true))
+						acl.Entries[0].RuleAction = awsec2types.RuleActionDeny
+						return acl
+					}()),
+					MockDeleteEntry: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						if diff := cmp.Diff(int32(50), aws.ToInt32(input.RuleNumber)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if aws.ToBool(input.Egress) {
+							t.Errorf("unmanaged egress entry %d deleted", aws.ToInt32(input.RuleNumber))
+						}
+						return &awsec2.DeleteNetworkAclEntryOutput{}, nil
+					},
+					MockReplaceEntry: func(ctx context.Context, input *awsec2.ReplaceNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclEntryOutput, error) {
+						if diff := cmp.Diff(int32(100), aws.ToInt32(input.RuleNumber)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ReplaceNetworkAclEntryOutput{}, nil
+					},
+					MockCreateEntry: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						if diff := cmp.Diff(int32(200), aws.ToInt32(input.RuleNumber)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateNetworkAclEntryOutput{}, nil
+					},
+					MockReplaceAssociation: func(ctx context.Context, input *awsec2.ReplaceNetworkAclAssociationInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclAssociationOutput, error) {
+						want := map[string]string{"aclassoc-2": aclID, associationID: defaultACLID}
+						if diff := cmp.Diff(want[aws.ToString(input.AssociationId)], aws.ToString(input.NetworkAclId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ReplaceNetworkAclAssociationOutput{}, nil
+					},
+				},
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:     aws.String(vpcID),
+					SubnetIDs: []string{"subnet-2"},
+					Ingress:   []manualv1alpha1.NetworkACLRule{rule(100), rule(200)},
+				}), withExternalName(aclID)),
+			},
+		},
+		"DuplicateRuleNumber": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: describe(observedACL()),
+				},
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:     aws.String(vpcID),
+					SubnetIDs: []string{subnetID},
+					Ingress:   []manualv1alpha1.NetworkACLRule{rule(100), rule(100)},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errors.Errorf("duplicate network ACL rule number: %d", 100), errUpdate),
+			},
+		},
+		"CreateEntryFail": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: describe(observedACL()),
+					MockCreateEntry: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACL(withSpec(manualv1alpha1.NetworkACLParameters{
+					VPCID:     aws.String(vpcID),
+					SubnetIDs: []string{subnetID},
+					Ingress:   []manualv1alpha1.NetworkACLRule{rule(100), rule(200)},
+				}), withExternalName(aclID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errCreateEntry),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.NetworkACL
+		err error
+	}
+
+	defaultACL := func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+		return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{NetworkAclId: aws.String(defaultACLID)}}}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: defaultACL,
+					MockReplaceAssociation: func(ctx context.Context, input *awsec2.ReplaceNetworkAclAssociationInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclAssociationOutput, error) {
+						if diff := cmp.Diff(defaultACLID, aws.ToString(input.NetworkAclId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ReplaceNetworkAclAssociationOutput{}, nil
+					},
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclOutput, error) {
+						return &awsec2.DeleteNetworkAclOutput{}, nil
+					},
+				},
+				cr: networkACL(withSpec(params()), withExternalName(aclID), withStatus(observation())),
+			},
+			want: want{
+				cr: networkACL(withSpec(params()), withExternalName(aclID), withStatus(observation()),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLIDNotFound}
+					},
+				},
+				cr: networkACL(withSpec(params()), withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withSpec(params()), withExternalName(aclID),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"DisassociateFail": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: defaultACL,
+					MockReplaceAssociation: func(ctx context.Context, input *awsec2.ReplaceNetworkAclAssociationInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclAssociationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACL(withSpec(params()), withExternalName(aclID), withStatus(observation())),
+			},
+			want: want{
+				cr: networkACL(withSpec(params()), withExternalName(aclID), withStatus(observation()),
+					withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDisassociateSubnet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkaclentry
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a NetworkACLEntry resource"
+
+	errDescribe      = "failed to describe the NetworkACL of the NetworkACLEntry"
+	errMultipleItems = "retrieved multiple NetworkACLs for the given networkAclId"
+	errCreate        = "failed to create the NetworkACLEntry resource"
+	errReplace       = "failed to replace the NetworkACLEntry resource"
+	errDelete        = "failed to delete the NetworkACLEntry resource"
+)
+
+// SetupNetworkACLEntry adds a controller that reconciles NetworkACLEntries.
+func SetupNetworkACLEntry(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(manualv1alpha1.NetworkACLEntryGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&manualv1alpha1.NetworkACLEntry{}).
+		Complete(drift.NewReconciler(mgr,
+			resource.ManagedKind(manualv1alpha1.NetworkACLEntryGroupVersionKind),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewNetworkACLEntryClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.NetworkACLEntryClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.NetworkACLEntry)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.NetworkACLEntryClient
+}
+
+// Observe looks the entry up by its network ACL, direction and rule number,
+// since network ACL entries have no ID of their own.
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACLEntry)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	response, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []string{aws.ToString(cr.Spec.ForProvider.NetworkACLID)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.NetworkAcls) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	entry := ec2.FindNetworkACLEntry(response.NetworkAcls[0].Entries, cr.Spec.ForProvider.Egress, cr.Spec.ForProvider.RuleNumber)
+	if entry == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsNetworkACLRuleUpToDate(cr.Spec.ForProvider.NetworkACLRule, *entry),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACLEntry)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateNetworkAclEntry(ctx, ec2.GenerateCreateNetworkACLEntryInput(
+		aws.ToString(cr.Spec.ForProvider.NetworkACLID), cr.Spec.ForProvider.Egress, cr.Spec.ForProvider.NetworkACLRule))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACLEntry)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.ReplaceNetworkAclEntry(ctx, ec2.GenerateReplaceNetworkACLEntryInput(
+		aws.ToString(cr.Spec.ForProvider.NetworkACLID), cr.Spec.ForProvider.Egress, cr.Spec.ForProvider.NetworkACLRule))
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errReplace)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*manualv1alpha1.NetworkACLEntry)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteNetworkAclEntry(ctx, &awsec2.DeleteNetworkAclEntryInput{
+		NetworkAclId: cr.Spec.ForProvider.NetworkACLID,
+		Egress:       aws.Bool(cr.Spec.ForProvider.Egress),
+		RuleNumber:   aws.Int32(cr.Spec.ForProvider.RuleNumber),
+	})
+	if ec2.IsNetworkACLNotFoundErr(err) || ec2.IsNetworkACLEntryNotFoundErr(err) {
+		return nil
+	}
+	return awsclient.Wrap(err, errDelete)
+}
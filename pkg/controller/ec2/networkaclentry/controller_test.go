@@ -0,0 +1,375 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkaclentry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	aclID        = "acl-1"
+	cidr         = "10.0.0.0/8"
+	port80 int32 = 80
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.NetworkACLEntryClient
+	cr     *manualv1alpha1.NetworkACLEntry
+}
+
+type entryModifier func(*manualv1alpha1.NetworkACLEntry)
+
+func withEgress() entryModifier {
+	return func(r *manualv1alpha1.NetworkACLEntry) { r.Spec.ForProvider.Egress = true }
+}
+
+func withConditions(c ...xpv1.Condition) entryModifier {
+	return func(r *manualv1alpha1.NetworkACLEntry) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func networkACLEntry(m ...entryModifier) *manualv1alpha1.NetworkACLEntry {
+	cr := &manualv1alpha1.NetworkACLEntry{
+		Spec: manualv1alpha1.NetworkACLEntrySpec{
+			ForProvider: manualv1alpha1.NetworkACLEntryParameters{
+				NetworkACLID: aws.String(aclID),
+				NetworkACLRule: manualv1alpha1.NetworkACLRule{
+					RuleNumber: 100,
+					Protocol:   "tcp",
+					RuleAction: manualv1alpha1.NetworkACLRuleActionAllow,
+					CIDRBlock:  aws.String(cidr),
+					FromPort:   &port80,
+					ToPort:     &port80,
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(entries ...awsec2types.NetworkAclEntry) func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+		return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{
+			NetworkAclId: aws.String(aclID),
+			Entries:      entries,
+		}}}, nil
+	}
+}
+
+func entry(egress bool, action awsec2types.RuleAction) awsec2types.NetworkAclEntry {
+	return awsec2types.NetworkAclEntry{
+		RuleNumber: aws.Int32(100),
+		Egress:     aws.Bool(egress),
+		Protocol:   aws.String("6"),
+		RuleAction: action,
+		CidrBlock:  aws.String(cidr),
+		PortRange:  &awsec2types.PortRange{From: &port80, To: &port80},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.NetworkACLEntry
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: describe(entry(false, awsec2types.RuleActionAllow)),
+				},
+				cr: networkACLEntry(),
+			},
+			want: want{
+				cr: networkACLEntry(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: describe(entry(false, awsec2types.RuleActionDeny)),
+				},
+				cr: networkACLEntry(),
+			},
+			want: want{
+				cr: networkACLEntry(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"OtherDirection": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: describe(entry(false, awsec2types.RuleActionAllow)),
+				},
+				cr: networkACLEntry(withEgress()),
+			},
+			want: want{
+				cr: networkACLEntry(withEgress()),
+			},
+		},
+		"ACLNotFound": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLIDNotFound}
+					},
+				},
+				cr: networkACLEntry(),
+			},
+			want: want{
+				cr: networkACLEntry(),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACLEntry(),
+			},
+			want: want{
+				cr:  networkACLEntry(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.NetworkACLEntry
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockCreateEntry: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						if diff := cmp.Diff("6", aws.ToString(input.Protocol)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateNetworkAclEntryOutput{}, nil
+					},
+				},
+				cr: networkACLEntry(),
+			},
+			want: want{
+				cr: networkACLEntry(withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockCreateEntry: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACLEntry(),
+			},
+			want: want{
+				cr:  networkACLEntry(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockReplaceEntry: func(ctx context.Context, input *awsec2.ReplaceNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclEntryOutput, error) {
+						return &awsec2.ReplaceNetworkAclEntryOutput{}, nil
+					},
+				},
+				cr: networkACLEntry(),
+			},
+		},
+		"ReplaceFail": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockReplaceEntry: func(ctx context.Context, input *awsec2.ReplaceNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACLEntry(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errReplace),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.NetworkACLEntry
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDeleteEntry: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						return &awsec2.DeleteNetworkAclEntryOutput{}, nil
+					},
+				},
+				cr: networkACLEntry(),
+			},
+			want: want{
+				cr: networkACLEntry(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDeleteEntry: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLEntryNotFound}
+					},
+				},
+				cr: networkACLEntry(),
+			},
+			want: want{
+				cr: networkACLEntry(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDeleteEntry: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACLEntry(),
+			},
+			want: want{
+				cr:  networkACLEntry(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -43,9 +44,9 @@ func SetupRoute(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, po
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.Route{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			cpresource.ManagedKind(svcapitypes.RouteGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -58,6 +59,7 @@ func preCreate(_ context.Context, cr *svcapitypes.Route, obj *svcsdk.CreateRoute
 	obj.RouteTableId = cr.Spec.ForProvider.RouteTableID
 	obj.InstanceId = cr.Spec.ForProvider.InstanceID
 	obj.GatewayId = cr.Spec.ForProvider.GatewayID
+	obj.DestinationPrefixListId = cr.Spec.ForProvider.DestinationPrefixListID
 	return nil
 }
 
@@ -90,10 +92,11 @@ func (e *external) observer(ctx context.Context, mg cpresource.Managed) (managed
 
 func preDelete(_ context.Context, cr *svcapitypes.Route, obj *svcsdk.DeleteRouteInput) (bool, error) {
 	obj.RouteTableId = cr.Spec.ForProvider.RouteTableID
+	obj.DestinationPrefixListId = cr.Spec.ForProvider.DestinationPrefixListID
 	return false, nil
 }
 
-// findRouteByDestination returns the route corresponding to the specified IPv4/IPv6 destination.
+// findRouteByDestination returns the route corresponding to the specified IPv4 or prefix list destination.
 // Returns NotFoundError if no route is found.
 func (e *external) findRouteByDestination(ctx context.Context, cr *svcapitypes.Route) (*svcsdk.Route, error) {
 
@@ -111,10 +114,17 @@ func (e *external) findRouteByDestination(ctx context.Context, cr *svcapitypes.R
 	}
 
 	for _, route := range response.RouteTables[0].Routes {
-		if awsclients.StringValue(route.Origin) == svcsdk.RouteOriginCreateRoute {
-			if awsclients.CIDRBlocksEqual(awsclients.StringValue(route.DestinationCidrBlock), *cr.Spec.ForProvider.DestinationCIDRBlock) {
+		if awsclients.StringValue(route.Origin) != svcsdk.RouteOriginCreateRoute {
+			continue
+		}
+		if cr.Spec.ForProvider.DestinationPrefixListID != nil {
+			if awsclients.StringValue(route.DestinationPrefixListId) == *cr.Spec.ForProvider.DestinationPrefixListID {
 				return route, nil
 			}
+			continue
+		}
+		if awsclients.CIDRBlocksEqual(awsclients.StringValue(route.DestinationCidrBlock), awsclients.StringValue(cr.Spec.ForProvider.DestinationCIDRBlock)) {
+			return route, nil
 		}
 
 	}
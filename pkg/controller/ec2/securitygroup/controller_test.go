@@ -363,6 +363,45 @@ func TestUpdate(t *testing.T) {
 					})),
 			},
 		},
+		"PreserveUnownedRules": {
+			// revoking rules would call the unset revoke mocks and panic.
+			args: args{
+				sg: &fake.MockSecurityGroupClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupsOutput, error) {
+						return &awsec2.DescribeSecurityGroupsOutput{
+							SecurityGroups: []awsec2types.SecurityGroup{{
+								IpPermissions:       sgPermissions(port100, cidr),
+								IpPermissionsEgress: sgPermissions(port100, cidr),
+							}},
+						}, nil
+					},
+					MockAuthorizeIngress: func(ctx context.Context, input *awsec2.AuthorizeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.AuthorizeSecurityGroupIngressOutput, error) {
+						return &awsec2.AuthorizeSecurityGroupIngressOutput{}, nil
+					},
+					MockAuthorizeEgress: func(ctx context.Context, input *awsec2.AuthorizeSecurityGroupEgressInput, opts []func(*awsec2.Options)) (*awsec2.AuthorizeSecurityGroupEgressOutput, error) {
+						return &awsec2.AuthorizeSecurityGroupEgressOutput{}, nil
+					},
+				},
+				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+					Ingress:              specPermissions(),
+					Egress:               specPermissions(),
+					PreserveUnownedRules: aws.Bool(true),
+				}),
+					withStatus(v1beta1.SecurityGroupObservation{
+						SecurityGroupID: sgID,
+					})),
+			},
+			want: want{
+				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+					Ingress:              specPermissions(),
+					Egress:               specPermissions(),
+					PreserveUnownedRules: aws.Bool(true),
+				}),
+					withStatus(v1beta1.SecurityGroupObservation{
+						SecurityGroupID: sgID,
+					})),
+			},
+		},
 		"IngressFail": {
 			args: args{
 				sg: &fake.MockSecurityGroupClient{
@@ -0,0 +1,471 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitygrouprule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	ruleID        = "sgr-1"
+	sgID          = "sg-1"
+	port80  int32 = 80
+	cidr          = "10.0.0.0/8"
+	ownerID       = "123456789012"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.SecurityGroupRuleClient
+	cr     *manualv1alpha1.SecurityGroupRule
+}
+
+type ruleModifier func(*manualv1alpha1.SecurityGroupRule)
+
+func withExternalName(name string) ruleModifier {
+	return func(r *manualv1alpha1.SecurityGroupRule) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p manualv1alpha1.SecurityGroupRuleParameters) ruleModifier {
+	return func(r *manualv1alpha1.SecurityGroupRule) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.SecurityGroupRuleObservation) ruleModifier {
+	return func(r *manualv1alpha1.SecurityGroupRule) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) ruleModifier {
+	return func(r *manualv1alpha1.SecurityGroupRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func rule(m ...ruleModifier) *manualv1alpha1.SecurityGroupRule {
+	cr := &manualv1alpha1.SecurityGroupRule{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*manualv1alpha1.SecurityGroupRuleParameters)) manualv1alpha1.SecurityGroupRuleParameters {
+	p := manualv1alpha1.SecurityGroupRuleParameters{
+		Type:            manualv1alpha1.SecurityGroupRuleTypeIngress,
+		SecurityGroupID: aws.String(sgID),
+		IPProtocol:      "tcp",
+		FromPort:        &port80,
+		ToPort:          &port80,
+		CIDRIP:          aws.String(cidr),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func observedRule() awsec2types.SecurityGroupRule {
+	return awsec2types.SecurityGroupRule{
+		SecurityGroupRuleId: aws.String(ruleID),
+		GroupId:             aws.String(sgID),
+		GroupOwnerId:        aws.String(ownerID),
+		IpProtocol:          aws.String("tcp"),
+		FromPort:            &port80,
+		ToPort:              &port80,
+		CidrIpv4:            aws.String(cidr),
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.SecurityGroupRule
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupRulesOutput, error) {
+						return &awsec2.DescribeSecurityGroupRulesOutput{
+							SecurityGroupRules: []awsec2types.SecurityGroupRule{observedRule()},
+						}, nil
+					},
+				},
+				cr: rule(withSpec(params()), withExternalName(ruleID)),
+			},
+			want: want{
+				cr: rule(withSpec(params()), withExternalName(ruleID),
+					withStatus(manualv1alpha1.SecurityGroupRuleObservation{
+						SecurityGroupRuleID: ruleID,
+						GroupOwnerID:        ownerID,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitDescription": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupRulesOutput, error) {
+						r := observedRule()
+						r.Description = aws.String("http")
+						return &awsec2.DescribeSecurityGroupRulesOutput{
+							SecurityGroupRules: []awsec2types.SecurityGroupRule{r},
+						}, nil
+					},
+				},
+				cr: rule(withSpec(params()), withExternalName(ruleID)),
+			},
+			want: want{
+				cr: rule(withSpec(params(func(p *manualv1alpha1.SecurityGroupRuleParameters) {
+					p.Description = aws.String("http")
+				})), withExternalName(ruleID),
+					withStatus(manualv1alpha1.SecurityGroupRuleObservation{
+						SecurityGroupRuleID: ruleID,
+						GroupOwnerID:        ownerID,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupRulesOutput, error) {
+						r := observedRule()
+						r.CidrIpv4 = aws.String("192.168.0.0/16")
+						return &awsec2.DescribeSecurityGroupRulesOutput{
+							SecurityGroupRules: []awsec2types.SecurityGroupRule{r},
+						}, nil
+					},
+				},
+				cr: rule(withSpec(params()), withExternalName(ruleID)),
+			},
+			want: want{
+				cr: rule(withSpec(params()), withExternalName(ruleID),
+					withStatus(manualv1alpha1.SecurityGroupRuleObservation{
+						SecurityGroupRuleID: ruleID,
+						GroupOwnerID:        ownerID,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupRulesOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.InvalidSecurityGroupRuleIDNotFound}
+					},
+				},
+				cr: rule(withSpec(params()), withExternalName(ruleID)),
+			},
+			want: want{
+				cr: rule(withSpec(params()), withExternalName(ruleID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupRulesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(withSpec(params()), withExternalName(ruleID)),
+			},
+			want: want{
+				cr:  rule(withSpec(params()), withExternalName(ruleID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.SecurityGroupRule
+		result managed.ExternalCreation
+		err    error
+	}
+
+	egress := func(p *manualv1alpha1.SecurityGroupRuleParameters) {
+		p.Type = manualv1alpha1.SecurityGroupRuleTypeEgress
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Ingress": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockAuthorizeIngress: func(ctx context.Context, input *awsec2.AuthorizeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.AuthorizeSecurityGroupIngressOutput, error) {
+						return &awsec2.AuthorizeSecurityGroupIngressOutput{
+							SecurityGroupRules: []awsec2types.SecurityGroupRule{observedRule()},
+						}, nil
+					},
+				},
+				cr: rule(withSpec(params())),
+			},
+			want: want{
+				cr: rule(withSpec(params()), withExternalName(ruleID),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"Egress": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockAuthorizeEgress: func(ctx context.Context, input *awsec2.AuthorizeSecurityGroupEgressInput, opts []func(*awsec2.Options)) (*awsec2.AuthorizeSecurityGroupEgressOutput, error) {
+						return &awsec2.AuthorizeSecurityGroupEgressOutput{
+							SecurityGroupRules: []awsec2types.SecurityGroupRule{observedRule()},
+						}, nil
+					},
+				},
+				cr: rule(withSpec(params(egress))),
+			},
+			want: want{
+				cr: rule(withSpec(params(egress)), withExternalName(ruleID),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"NoRuleID": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockAuthorizeIngress: func(ctx context.Context, input *awsec2.AuthorizeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.AuthorizeSecurityGroupIngressOutput, error) {
+						return &awsec2.AuthorizeSecurityGroupIngressOutput{}, nil
+					},
+				},
+				cr: rule(withSpec(params())),
+			},
+			want: want{
+				cr:  rule(withSpec(params()), withConditions(xpv1.Creating())),
+				err: errors.New(errNoRuleID),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockAuthorizeIngress: func(ctx context.Context, input *awsec2.AuthorizeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.AuthorizeSecurityGroupIngressOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(withSpec(params())),
+			},
+			want: want{
+				cr:  rule(withSpec(params()), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.SecurityGroupRule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockModify: func(ctx context.Context, input *awsec2.ModifySecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.ModifySecurityGroupRulesOutput, error) {
+						if diff := cmp.Diff(ruleID, aws.ToString(input.SecurityGroupRules[0].SecurityGroupRuleId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ModifySecurityGroupRulesOutput{}, nil
+					},
+				},
+				cr: rule(withSpec(params()), withExternalName(ruleID)),
+			},
+			want: want{
+				cr: rule(withSpec(params()), withExternalName(ruleID)),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockModify: func(ctx context.Context, input *awsec2.ModifySecurityGroupRulesInput, opts []func(*awsec2.Options)) (*awsec2.ModifySecurityGroupRulesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(withSpec(params()), withExternalName(ruleID)),
+			},
+			want: want{
+				cr:  rule(withSpec(params()), withExternalName(ruleID)),
+				err: awsclient.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.SecurityGroupRule
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockRevokeIngress: func(ctx context.Context, input *awsec2.RevokeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.RevokeSecurityGroupIngressOutput, error) {
+						return &awsec2.RevokeSecurityGroupIngressOutput{}, nil
+					},
+				},
+				cr: rule(withSpec(params()), withExternalName(ruleID)),
+			},
+			want: want{
+				cr: rule(withSpec(params()), withExternalName(ruleID),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockRevokeIngress: func(ctx context.Context, input *awsec2.RevokeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.RevokeSecurityGroupIngressOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.InvalidSecurityGroupRuleIDNotFound}
+					},
+				},
+				cr: rule(withSpec(params()), withExternalName(ruleID)),
+			},
+			want: want{
+				cr: rule(withSpec(params()), withExternalName(ruleID),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockSecurityGroupRuleClient{
+					MockRevokeIngress: func(ctx context.Context, input *awsec2.RevokeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.RevokeSecurityGroupIngressOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(withSpec(params()), withExternalName(ruleID)),
+			},
+			want: want{
+				cr: rule(withSpec(params()), withExternalName(ruleID),
+					withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
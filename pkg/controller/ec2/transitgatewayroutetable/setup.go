@@ -19,6 +19,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -49,9 +50,9 @@ func SetupTransitGatewayRouteTable(mgr ctrl.Manager, l logging.Logger, rl workqu
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.TransitGatewayRouteTable{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			cpresource.ManagedKind(svcapitypes.TransitGatewayRouteTableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
@@ -150,13 +151,13 @@ func (t *tagger) Initialize(ctx context.Context, mgd cpresource.Managed) error {
 		}
 	}
 
-	tagMap := map[string]string{}
-	tagMap["Name"] = cr.Name
+	tagMap := map[string]string{"Name": cr.Name}
 	for _, t := range transitGatewayRouteTableTags.Tags {
 		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	for k, v := range cpresource.GetExternalTags(mgd) {
-		tagMap[k] = v
+	tagMap, err := aws.MergeDefaultTags(ctx, t.kube, mgd, tagMap)
+	if err != nil {
+		return err
 	}
 	transitGatewayRouteTableTags.Tags = make([]*svcapitypes.Tag, len(tagMap))
 	transitGatewayRouteTableTags.ResourceType = aws.String("transit-gateway-route-table")
@@ -2,6 +2,7 @@ package transitgatewayvpcattachment
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,10 +15,12 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -47,9 +50,10 @@ func SetupTransitGatewayVPCAttachment(mgr ctrl.Manager, l logging.Logger, rl wor
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.TransitGatewayVPCAttachment{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.TransitGatewayVPCAttachmentGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -134,19 +138,34 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	if !ok {
 		return errors.New(errUnexpectedObject)
 	}
-	added := false
 	tagMap := map[string]string{}
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[awsclients.StringValue(t.Key)] = awsclients.StringValue(t.Value)
 	}
-	for k, v := range resource.GetExternalTags(mgd) {
-		if tagMap[k] != v {
-			cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, svcapitypes.Tag{Key: awsclients.String(k), Value: awsclients.String(v)})
-			added = true
-		}
+	recorded := cr.GetAnnotations()[awsclients.AnnotationKeyDefaultTags]
+	tags, err := awsclients.MergeDefaultTags(ctx, t.kube, mgd, tagMap)
+	if err != nil {
+		return err
 	}
-	if !added {
+	if cmp.Equal(tags, tagMap) && cr.GetAnnotations()[awsclients.AnnotationKeyDefaultTags] == recorded {
 		return nil
 	}
+	// Existing tags keep their order, new tags are appended.
+	merged := make([]svcapitypes.Tag, 0, len(tags))
+	for _, t := range cr.Spec.ForProvider.Tags {
+		if v, ok := tags[awsclients.StringValue(t.Key)]; ok {
+			merged = append(merged, svcapitypes.Tag{Key: t.Key, Value: awsclients.String(v)})
+			delete(tags, awsclients.StringValue(t.Key))
+		}
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		merged = append(merged, svcapitypes.Tag{Key: awsclients.String(k), Value: awsclients.String(tags[k])})
+	}
+	cr.Spec.ForProvider.Tags = merged
 	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
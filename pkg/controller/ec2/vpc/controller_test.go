@@ -36,6 +36,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
@@ -68,6 +69,10 @@ func withTags(tagMaps ...map[string]string) vpcModifier {
 	return func(r *v1beta1.VPC) { r.Spec.ForProvider.Tags = tagList }
 }
 
+func withProviderConfig(name string) vpcModifier {
+	return func(r *v1beta1.VPC) { r.SetProviderConfigReference(&xpv1.Reference{Name: name}) }
+}
+
 func withExternalName(name string) vpcModifier {
 	return func(r *v1beta1.VPC) { meta.SetExternalName(r, name) }
 }
@@ -464,6 +469,32 @@ func TestInitialize(t *testing.T) {
 				cr: vpc(withTags(resource.GetExternalTags(vpc()), map[string]string{"foo": "bar"})),
 			},
 		},
+		"DefaultTags": {
+			args: args{
+				cr: vpc(withProviderConfig("pc"), withTags(map[string]string{"foo": "bar"})),
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						obj.(*awsv1beta1.ProviderConfig).Spec.DefaultTags = map[string]string{"foo": "default", "team": "platform"}
+						return nil
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+			},
+			want: want{
+				cr: vpc(withProviderConfig("pc"), withTags(resource.GetExternalTags(vpc(withProviderConfig("pc"))), map[string]string{"foo": "bar", "team": "platform"}), func(v *v1beta1.VPC) {
+					meta.AddAnnotations(v, map[string]string{awsclient.AnnotationKeyDefaultTags: `{"team":"platform"}`})
+				}),
+			},
+		},
+		"GetProviderConfigFailed": {
+			args: args{
+				cr:   vpc(withProviderConfig("pc")),
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, "cannot get referenced ProviderConfig"),
+			},
+		},
 		"UpdateFailed": {
 			args: args{
 				cr:   vpc(),
@@ -27,6 +27,8 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupVPCEndpoint adds a controller that reconciles VPCEndpoint.
@@ -40,9 +42,9 @@ func SetupVPCEndpoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimit
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.VPCEndpoint{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			cpresource.ManagedKind(svcapitypes.VPCEndpointGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
@@ -159,16 +161,16 @@ sgCompare:
 	}
 
 	// Check policyDocument
-	defaultPolicyEndpoint := aws.String("{\"Statement\":[{\"Action\":\"*\",\"Effect\": \"Allow\",\"Principal\":\"*\",\"Resource\":\"*\"}]}")
-	defaultPolicyGateway := aws.String("{\"Version\":\"2008-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":\"*\",\"Action\":\"*\",\"Resource\":\"*\"}]}")
+	defaultPolicyEndpoint := "{\"Statement\":[{\"Action\":\"*\",\"Effect\": \"Allow\",\"Principal\":\"*\",\"Resource\":\"*\"}]}"
+	defaultPolicyGateway := "{\"Version\":\"2008-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":\"*\",\"Action\":\"*\",\"Resource\":\"*\"}]}"
 	declaredPolicy := cr.Spec.ForProvider.PolicyDocument
 	upstreamPolicy := obj.VpcEndpoints[0].PolicyDocument
 
 	// If no declared policy, we expect the result to be equivalent to the default policy
 	if aws.StringValue(declaredPolicy) == "" {
-		return awsclients.IsPolicyUpToDate(upstreamPolicy, defaultPolicyEndpoint) || awsclients.IsPolicyUpToDate(upstreamPolicy, defaultPolicyGateway), nil
+		return policyclient.Equal(aws.StringValue(upstreamPolicy), defaultPolicyEndpoint) || policyclient.Equal(aws.StringValue(upstreamPolicy), defaultPolicyGateway), nil
 	}
-	return awsclients.IsPolicyUpToDate(upstreamPolicy, declaredPolicy), nil
+	return policyclient.Equal(aws.StringValue(upstreamPolicy), aws.StringValue(declaredPolicy)), nil
 }
 
 // preUpdate adds the mutable fields into the update request input
@@ -297,10 +299,11 @@ func formatModifyVpcEndpointInput(obj *svcsdk.ModifyVpcEndpointInput) {
 // ([]*string) "base", "subtract", and returns a "result" list
 // of string pointers where "result" = "base" - "subtract".
 // Comparisons of the underlying string is done
-//  Example:
-//  "base": ["a", "b", "g", "x"]
-//  "subtract": ["b", "x", "y"]
-//  "result": ["a", "g"]
+//
+//	Example:
+//	"base": ["a", "b", "g", "x"]
+//	"subtract": ["b", "x", "y"]
+//	"result": ["a", "g"]
 func listSubtractFromStringPtr(base, subtract []*string) []*string {
 	result := []*string{}
 
@@ -362,13 +365,13 @@ func (t *tagger) Initialize(ctx context.Context, mgd cpresource.Managed) error {
 		}
 	}
 
-	tagMap := map[string]string{}
-	tagMap["Name"] = cr.Name
+	tagMap := map[string]string{"Name": cr.Name}
 	for _, t := range vpcEndpointTags.Tags {
 		tagMap[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	for k, v := range cpresource.GetExternalTags(mgd) {
-		tagMap[k] = v
+	tagMap, err := awsclients.MergeDefaultTags(ctx, t.kube, mgd, tagMap)
+	if err != nil {
+		return err
 	}
 	vpcEndpointTags.Tags = make([]*svcapitypes.Tag, len(tagMap))
 	vpcEndpointTags.ResourceType = aws.String("vpc-endpoint")
@@ -18,6 +18,7 @@ package repository
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -41,6 +42,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/ecr/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/ecr"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -70,9 +72,9 @@ func SetupRepository(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimite
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1beta1.Repository{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RepositoryGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
@@ -248,20 +250,35 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	if !ok {
 		return errors.New(errUnexpectedObject)
 	}
-	added := false
 	tagMap := map[string]string{}
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[t.Key] = t.Value
 	}
-	for k, v := range resource.GetExternalTags(mgd) {
-		if tagMap[k] != v {
-			cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, v1beta1.Tag{Key: k, Value: v})
-			added = true
-		}
+	recorded := cr.GetAnnotations()[awsclient.AnnotationKeyDefaultTags]
+	tags, err := awsclient.MergeDefaultTags(ctx, t.kube, mgd, tagMap)
+	if err != nil {
+		return err
 	}
-	if !added {
+	if cmp.Equal(tags, tagMap) && cr.GetAnnotations()[awsclient.AnnotationKeyDefaultTags] == recorded {
 		return nil
 	}
+	// Existing tags keep their order, new tags are appended.
+	merged := make([]v1beta1.Tag, 0, len(tags))
+	for _, t := range cr.Spec.ForProvider.Tags {
+		if v, ok := tags[t.Key]; ok {
+			merged = append(merged, v1beta1.Tag{Key: t.Key, Value: v})
+			delete(tags, t.Key)
+		}
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		merged = append(merged, v1beta1.Tag{Key: k, Value: tags[k]})
+	}
+	cr.Spec.ForProvider.Tags = merged
 	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
 
@@ -20,6 +20,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awsecr "github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -38,6 +39,8 @@ import (
 	"github.com/crossplane/provider-aws/apis/ecr/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	ecr "github.com/crossplane/provider-aws/pkg/clients/ecr"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -58,9 +61,9 @@ func SetupRepositoryPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.Rate
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1beta1.RepositoryPolicy{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RepositoryPolicyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -116,7 +119,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        awsclient.IsPolicyUpToDate(&policyData, response.PolicyText),
+		ResourceUpToDate:        policyclient.Equal(policyData, aws.ToString(response.PolicyText)),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
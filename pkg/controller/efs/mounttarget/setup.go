@@ -19,6 +19,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/efs/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupMountTarget adds a controller that reconciles MountTarget.
@@ -38,10 +39,11 @@ func SetupMountTarget(mgr ctrl.Manager, l logging.Logger, limiter workqueue.Rate
 			RateLimiter: ratelimiter.NewController(limiter),
 		}).
 		For(&svcapitypes.MountTarget{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			cpresource.ManagedKind(svcapitypes.MountTargetGroupVersionKind),
 			managed.WithInitializers(),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
@@ -38,6 +38,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/eks/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -65,9 +66,9 @@ func SetupAddon(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, po
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1alpha1.Addon{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.AddonGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
@@ -215,10 +216,16 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	if !ok {
 		return errors.New(errNotEKSCluster)
 	}
-	if cr.Spec.ForProvider.Tags == nil {
-		cr.Spec.ForProvider.Tags = map[string]*string{}
+	tagMap := make(map[string]string, len(cr.Spec.ForProvider.Tags))
+	for k, v := range cr.Spec.ForProvider.Tags {
+		tagMap[k] = awsclients.StringValue(v)
 	}
-	for k, v := range resource.GetExternalTags(mg) {
+	tags, err := awsclients.MergeDefaultTags(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
+	}
+	cr.Spec.ForProvider.Tags = make(map[string]*string, len(tags))
+	for k, v := range tags {
 		cr.Spec.ForProvider.Tags[k] = awsclients.String(v)
 	}
 	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
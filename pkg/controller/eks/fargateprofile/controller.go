@@ -40,6 +40,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -61,9 +62,9 @@ func SetupFargateProfile(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLi
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1beta1.FargateProfile{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.FargateProfileGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
@@ -189,18 +190,14 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	if !ok {
 		return errors.New(errNotEKSFargateProfile)
 	}
-	changed := false
-	if cr.Spec.ForProvider.Tags == nil {
-		cr.Spec.ForProvider.Tags = map[string]string{}
-	}
-	for k, v := range resource.GetExternalTags(mg) {
-		if cr.Spec.ForProvider.Tags[k] != v {
-			cr.Spec.ForProvider.Tags[k] = v
-			changed = true
-		}
+	recorded := cr.GetAnnotations()[awsclient.AnnotationKeyDefaultTags]
+	tags, err := awsclient.MergeDefaultTags(ctx, t.kube, mg, cr.Spec.ForProvider.Tags)
+	if err != nil {
+		return err
 	}
-	if !changed {
+	if cmp.Equal(cr.Spec.ForProvider.Tags, tags) && cr.GetAnnotations()[awsclient.AnnotationKeyDefaultTags] == recorded {
 		return nil
 	}
+	cr.Spec.ForProvider.Tags = tags
 	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
@@ -40,6 +40,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -64,9 +65,9 @@ func SetupNodeGroup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&manualv1alpha1.NodeGroup{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(manualv1alpha1.NodeGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
@@ -208,11 +209,10 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	if !ok {
 		return errors.New(errNotEKSNodeGroup)
 	}
-	if cr.Spec.ForProvider.Tags == nil {
-		cr.Spec.ForProvider.Tags = map[string]string{}
-	}
-	for k, v := range resource.GetExternalTags(mg) {
-		cr.Spec.ForProvider.Tags[k] = v
+	tags, err := awsclient.MergeDefaultTags(ctx, t.kube, mg, cr.Spec.ForProvider.Tags)
+	if err != nil {
+		return err
 	}
+	cr.Spec.ForProvider.Tags = tags
 	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
@@ -41,6 +41,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticloadbalancing/elb"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -65,9 +66,9 @@ func SetupELB(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1alpha1.ELB{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.ELBGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elb.NewClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elb.NewClient}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
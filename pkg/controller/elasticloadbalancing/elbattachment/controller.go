@@ -40,6 +40,7 @@ import (
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticloadbalancing/elb"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -60,9 +61,9 @@ func SetupELBAttachment(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLim
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1alpha1.ELBAttachment{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.ELBAttachmentGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elb.NewClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elb.NewClient}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
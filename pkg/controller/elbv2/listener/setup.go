@@ -18,6 +18,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupListener adds a controller that reconciles Listener.
@@ -38,9 +39,9 @@ func SetupListener(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.Listener{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.ListenerGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// groupSuffix is the suffix of the API groups of this provider.
+const groupSuffix = ".aws.crossplane.io"
+
+type kindPattern struct {
+	group string
+	kind  string
+}
+
+func (p kindPattern) matches(gk schema.GroupKind) bool {
+	// Patterns are validated when they are parsed, so matching cannot fail.
+	g, _ := path.Match(p.group, strings.ToLower(gk.Group))
+	k, _ := path.Match(p.kind, strings.ToLower(gk.Kind))
+	return g && k
+}
+
+// A Filter determines which controllers are set up by the kind they
+// reconcile. A nil Filter enables all controllers.
+type Filter struct {
+	enable  []kindPattern
+	disable []kindPattern
+}
+
+// NewFilter returns a Filter that enables the controllers of the kinds that
+// match any of the supplied enable patterns, or of all kinds if there are
+// none, unless they match any of the supplied disable patterns.
+//
+// Patterns are of the form GROUP[/KIND], where both group and kind are globs
+// as understood by path.Match and are matched case-insensitively. A group
+// without a dot is short for the API group of this provider with that name,
+// e.g. ec2 for ec2.aws.crossplane.io, and omitting the kind matches all kinds
+// of the group. Each of the supplied patterns may be a comma separated list
+// of patterns.
+func NewFilter(enable, disable []string) (*Filter, error) {
+	f := &Filter{}
+	var err error
+	if f.enable, err = parsePatterns(enable); err != nil {
+		return nil, err
+	}
+	if f.disable, err = parsePatterns(disable); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func parsePatterns(patterns []string) ([]kindPattern, error) {
+	var parsed []kindPattern
+	for _, list := range patterns {
+		for _, s := range strings.Split(list, ",") {
+			s = strings.ToLower(strings.TrimSpace(s))
+			if s == "" {
+				continue
+			}
+			p := kindPattern{group: s, kind: "*"}
+			if i := strings.Index(s, "/"); i >= 0 {
+				p.group, p.kind = s[:i], s[i+1:]
+			}
+			if p.group != "*" && !strings.Contains(p.group, ".") {
+				p.group += groupSuffix
+			}
+			for _, g := range []string{p.group, p.kind} {
+				if _, err := path.Match(g, ""); g == "" || err != nil {
+					return nil, errors.Errorf("invalid controller pattern %q", s)
+				}
+			}
+			parsed = append(parsed, p)
+		}
+	}
+	return parsed, nil
+}
+
+// Enabled returns true if the controller of the supplied kind is enabled.
+func (f *Filter) Enabled(gk schema.GroupKind) bool {
+	if f == nil {
+		return true
+	}
+	enabled := len(f.enable) == 0
+	for _, p := range f.enable {
+		if p.matches(gk) {
+			enabled = true
+			break
+		}
+	}
+	if !enabled {
+		return false
+	}
+	for _, p := range f.disable {
+		if p.matches(gk) {
+			return false
+		}
+	}
+	return true
+}
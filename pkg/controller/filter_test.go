@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestFilter(t *testing.T) {
+	vpc := schema.GroupKind{Group: "ec2.aws.crossplane.io", Kind: "VPC"}
+	subnet := schema.GroupKind{Group: "ec2.aws.crossplane.io", Kind: "Subnet"}
+	bucket := schema.GroupKind{Group: "s3.aws.crossplane.io", Kind: "Bucket"}
+	policy := schema.GroupKind{Group: "s3.aws.crossplane.io", Kind: "BucketPolicy"}
+
+	type args struct {
+		enable  []string
+		disable []string
+	}
+	type want struct {
+		enabled map[schema.GroupKind]bool
+		err     bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"NoPatterns": {
+			reason: "All controllers should be enabled if no pattern is supplied.",
+			want: want{
+				enabled: map[schema.GroupKind]bool{vpc: true, subnet: true, bucket: true, policy: true},
+			},
+		},
+		"EnableGroup": {
+			reason: "Only the controllers of the enabled group should be enabled.",
+			args:   args{enable: []string{"s3"}},
+			want: want{
+				enabled: map[schema.GroupKind]bool{vpc: false, subnet: false, bucket: true, policy: true},
+			},
+		},
+		"EnableKinds": {
+			reason: "Kinds should be matched case-insensitively and comma separated patterns should be supported.",
+			args:   args{enable: []string{"ec2/vpc,s3.aws.crossplane.io/Bucket"}},
+			want: want{
+				enabled: map[schema.GroupKind]bool{vpc: true, subnet: false, bucket: true, policy: false},
+			},
+		},
+		"Globs": {
+			reason: "Groups and kinds should be matched as globs.",
+			args:   args{enable: []string{"*/Bucket*"}},
+			want: want{
+				enabled: map[schema.GroupKind]bool{vpc: false, subnet: false, bucket: true, policy: true},
+			},
+		},
+		"Disable": {
+			reason: "Disabled controllers should not be enabled even if they match an enable pattern.",
+			args:   args{enable: []string{"s3", "ec2"}, disable: []string{"ec2/Subnet", "s3/*Policy"}},
+			want: want{
+				enabled: map[schema.GroupKind]bool{vpc: true, subnet: false, bucket: true, policy: false},
+			},
+		},
+		"InvalidPattern": {
+			reason: "Invalid globs should be rejected.",
+			args:   args{disable: []string{"ec2/[vpc"}},
+			want:   want{err: true},
+		},
+		"EmptyKind": {
+			reason: "Patterns with an empty kind should be rejected.",
+			args:   args{enable: []string{"ec2/"}},
+			want:   want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f, err := NewFilter(tc.args.enable, tc.args.disable)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Fatalf("\n%s\nNewFilter(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			for gk, want := range tc.want.enabled {
+				if diff := cmp.Diff(want, f.Enabled(gk)); diff != "" {
+					t.Errorf("\n%s\nEnabled(%s): -want, +got:\n%s", tc.reason, gk, diff)
+				}
+			}
+		})
+	}
+}
@@ -32,6 +32,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/glue/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupClassifier adds a controller that reconciles Classifier.
@@ -52,10 +53,10 @@ func SetupClassifier(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateL
 			RateLimiter: ratelimiter.NewController(limiter),
 		}).
 		For(&svcapitypes.Classifier{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.ClassifierGroupVersionKind),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -32,6 +32,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/glue/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupConnection adds a controller that reconciles Connection.
@@ -52,10 +53,10 @@ func SetupConnection(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateL
 			RateLimiter: ratelimiter.NewController(limiter),
 		}).
 		For(&svcapitypes.Connection{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.ConnectionGroupVersionKind),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
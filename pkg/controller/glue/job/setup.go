@@ -33,6 +33,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/glue/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupJob adds a controller that reconciles Job.
@@ -53,10 +54,10 @@ func SetupJob(mgr ctrl.Manager, l logging.Logger, limiter workqueue.RateLimiter,
 			RateLimiter: ratelimiter.NewController(limiter),
 		}).
 		For(&svcapitypes.Job{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.JobGroupVersionKind),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
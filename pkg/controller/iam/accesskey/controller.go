@@ -40,6 +40,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -60,9 +61,9 @@ func SetupAccessKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1beta1.AccessKey{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.AccessKeyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewAccessClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewAccessClient}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -42,6 +42,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -65,9 +66,9 @@ func SetupOpenIDConnectProvider(mgr ctrl.Manager, l logging.Logger, rl workqueue
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1beta1.OpenIDConnectProvider{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.OpenIDConnectProviderGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewOpenIDConnectProviderClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewOpenIDConnectProviderClient}),
 			managed.WithInitializers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -42,6 +42,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -55,6 +56,7 @@ const (
 	errEmptyPolicy   = "empty IAM Policy received from IAM API"
 	errPolicyVersion = "No version for policy received from IAM API"
 	errUpToDate      = "cannot check if policy is up to date"
+	errDocument      = "cannot serialize the IAM Policy document"
 )
 
 // SetupPolicy adds a controller that reconciles IAM Policy.
@@ -67,9 +69,9 @@ func SetupPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, p
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1beta1.Policy{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.PolicyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewPolicyClient, newSTSClientFn: iam.NewSTSClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewPolicyClient, newSTSClientFn: iam.NewSTSClient}),
 			managed.WithInitializers(),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
@@ -171,6 +173,11 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	doc, err := iam.GeneratePolicyDocument(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errDocument)
+	}
+
 	tags := cr.Spec.ForProvider.Tags
 	inputPolicyTags := make([]awsiamtypes.Tag, len(tags))
 	for i := range tags {
@@ -183,7 +190,7 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	createOutput, err := e.client.CreatePolicy(ctx, &awsiam.CreatePolicyInput{
 		Description:    cr.Spec.ForProvider.Description,
 		Path:           cr.Spec.ForProvider.Path,
-		PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
+		PolicyDocument: aws.String(doc),
 		PolicyName:     aws.String(cr.Spec.ForProvider.Name),
 		Tags:           inputPolicyTags,
 	})
@@ -208,13 +215,18 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	// for an update request when 5 versions already exist.
 	// The new version is set as default.
 
+	doc, err := iam.GeneratePolicyDocument(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errDocument)
+	}
+
 	if err := e.deleteOldestVersion(ctx, meta.GetExternalName(cr)); err != nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 	}
 
-	_, err := e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
+	_, err = e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
 		PolicyArn:      aws.String(meta.GetExternalName(cr)),
-		PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
+		PolicyDocument: aws.String(doc),
 		SetAsDefault:   true,
 	})
 
@@ -18,6 +18,7 @@ package role
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -40,6 +41,8 @@ import (
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -65,9 +68,9 @@ func SetupRole(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, pol
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1beta1.Role{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RoleGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewRoleClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewRoleClient}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithPollInterval(poll),
@@ -145,7 +148,11 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	cr.Status.SetConditions(xpv1.Creating())
 
-	_, err := e.client.CreateRole(ctx, iam.GenerateCreateRoleInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	input, err := iam.GenerateCreateRoleInput(meta.GetExternalName(cr), &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+	_, err = e.client.CreateRole(ctx, input)
 	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
 }
 
@@ -206,9 +213,13 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if patch.AssumeRolePolicyDocument != "" {
+	doc, err := iam.GenerateAssumeRolePolicyDocument(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
+	if doc != "" && !policyclient.Equal(doc, aws.ToString(observed.Role.AssumeRolePolicyDocument)) {
 		_, err = e.client.UpdateAssumeRolePolicy(ctx, &awsiam.UpdateAssumeRolePolicyInput{
-			PolicyDocument: &cr.Spec.ForProvider.AssumeRolePolicyDocument,
+			PolicyDocument: aws.String(doc),
 			RoleName:       aws.String(meta.GetExternalName(cr)),
 		})
 		if err != nil {
@@ -242,19 +253,34 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	if !ok {
 		return errors.New(errUnexpectedObject)
 	}
-	added := false
 	tagMap := map[string]string{}
 	for _, t := range cr.Spec.ForProvider.Tags {
 		tagMap[t.Key] = t.Value
 	}
-	for k, v := range resource.GetExternalTags(mgd) {
-		if p, ok := tagMap[k]; !ok || v != p {
-			cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, v1beta1.Tag{Key: k, Value: v})
-			added = true
-		}
+	recorded := cr.GetAnnotations()[awsclient.AnnotationKeyDefaultTags]
+	tags, err := awsclient.MergeDefaultTags(ctx, t.kube, mgd, tagMap)
+	if err != nil {
+		return err
 	}
-	if !added {
+	if cmp.Equal(tags, tagMap) && cr.GetAnnotations()[awsclient.AnnotationKeyDefaultTags] == recorded {
 		return nil
 	}
+	// Existing tags keep their order, new tags are appended.
+	merged := make([]v1beta1.Tag, 0, len(tags))
+	for _, t := range cr.Spec.ForProvider.Tags {
+		if v, ok := tags[t.Key]; ok {
+			merged = append(merged, v1beta1.Tag{Key: t.Key, Value: v})
+			delete(tags, t.Key)
+		}
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		merged = append(merged, v1beta1.Tag{Key: k, Value: tags[k]})
+	}
+	cr.Spec.ForProvider.Tags = merged
 	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
@@ -35,6 +35,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupPolicy adds a controller that reconciles Policy.
@@ -55,9 +56,9 @@ func SetupPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, p
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.Policy{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.PolicyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
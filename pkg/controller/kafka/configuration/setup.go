@@ -33,6 +33,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/kafka/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupConfiguration adds a controller that reconciles Configuration.
@@ -54,9 +55,9 @@ func SetupConfiguration(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLim
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.Configuration{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.ConfigurationGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
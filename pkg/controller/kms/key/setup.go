@@ -2,6 +2,7 @@ package key
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/kms"
@@ -9,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -21,6 +23,13 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
+)
+
+const (
+	errPolicy           = "cannot serialize key policy"
+	errKubeUpdateFailed = "cannot update Key custom resource"
 )
 
 // SetupKey adds a controller that reconciles Key.
@@ -30,6 +39,7 @@ func SetupKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll
 		func(e *external) {
 			e.preObserve = preObserve
 			e.postObserve = postObserve
+			e.preCreate = preCreate
 			e.postCreate = postCreate
 			u := &updater{client: e.client}
 			e.update = u.update
@@ -46,14 +56,48 @@ func SetupKey(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.Key{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.KeyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
 
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.Key)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	// The external name of an imported Key is its ID rather than the name
+	// of the Key. Its tags are merged once it was observed, so that its
+	// existing tags were late initialized and are not replaced.
+	if cr.Status.AtProvider.KeyID == nil && meta.GetExternalName(cr) != cr.GetName() {
+		return nil
+	}
+	tagMap := map[string]string{}
+	for _, t := range cr.Spec.ForProvider.Tags {
+		tagMap[awsclients.StringValue(t.TagKey)] = awsclients.StringValue(t.TagValue)
+	}
+	tagMap, err := awsclients.MergeDefaultTags(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
+	}
+	cr.Spec.ForProvider.Tags = make([]*svcapitypes.Tag, 0, len(tagMap))
+	for k, v := range tagMap {
+		cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, &svcapitypes.Tag{TagKey: awsclients.String(k), TagValue: awsclients.String(v)})
+	}
+	sort.Slice(cr.Spec.ForProvider.Tags, func(i, j int) bool {
+		return awsclients.StringValue(cr.Spec.ForProvider.Tags[i].TagKey) < awsclients.StringValue(cr.Spec.ForProvider.Tags[j].TagKey)
+	})
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
+
 func preObserve(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.DescribeKeyInput) error {
 	obj.KeyId = awsclients.String(meta.GetExternalName(cr))
 	return nil
@@ -82,6 +126,15 @@ func postObserve(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.DescribeKey
 	return obs, nil
 }
 
+func preCreate(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.CreateKeyInput) error {
+	policy, err := policyclient.Render(cr.Spec.ForProvider.Policy, cr.Spec.ForProvider.PolicyBody)
+	if err != nil {
+		return errors.Wrap(err, errPolicy)
+	}
+	obj.Policy = policy
+	return nil
+}
+
 func postCreate(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.CreateKeyOutput, creation managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
 	if err != nil {
 		return creation, err
@@ -110,10 +163,14 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 	}
 
 	// Policy
+	policy, err := policyclient.Render(cr.Spec.ForProvider.Policy, cr.Spec.ForProvider.PolicyBody)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPolicy)
+	}
 	if _, err := u.client.PutKeyPolicyWithContext(ctx, &svcsdk.PutKeyPolicyInput{
 		KeyId:      awsclients.String(meta.GetExternalName(cr)),
 		PolicyName: awsclients.String("default"),
-		Policy:     cr.Spec.ForProvider.Policy,
+		Policy:     policy,
 	}); err != nil {
 		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
 	}
@@ -220,7 +277,7 @@ type observer struct {
 
 func (o *observer) lateInitialize(in *svcapitypes.KeyParameters, obj *svcsdk.DescribeKeyOutput) error {
 	// Policy
-	if in.Policy == nil {
+	if in.Policy == nil && in.PolicyBody == nil {
 		resPolicy, err := o.client.GetKeyPolicy(&svcsdk.GetKeyPolicyInput{
 			KeyId:      obj.KeyMetadata.KeyId,
 			PolicyName: awsclients.String("default"),
@@ -278,7 +335,11 @@ func (o *observer) isUpToDate(cr *svcapitypes.Key, obj *svcsdk.DescribeKeyOutput
 	if err != nil {
 		return false, awsclients.Wrap(err, "cannot get key policy")
 	}
-	if awsclients.StringValue(cr.Spec.ForProvider.Policy) != awsclients.StringValue(resPolicy.Policy) {
+	policy, err := policyclient.Render(cr.Spec.ForProvider.Policy, cr.Spec.ForProvider.PolicyBody)
+	if err != nil {
+		return false, errors.Wrap(err, errPolicy)
+	}
+	if !policyclient.Equal(awsclients.StringValue(policy), awsclients.StringValue(resPolicy.Policy)) {
 		return false, nil
 	}
 
@@ -23,6 +23,7 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/mq/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/mq"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupBroker adds a controller that reconciles Broker.
@@ -44,10 +45,10 @@ func SetupBroker(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, p
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.Broker{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.BrokerGroupVersionKind),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -24,6 +24,7 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/mq/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/mq"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupUser adds a controller that reconciles User.
@@ -48,10 +49,10 @@ func SetupUser(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, pol
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.User{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.UserGroupVersionKind),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
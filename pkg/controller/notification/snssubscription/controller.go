@@ -41,6 +41,7 @@ import (
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/sns"
 	snsclient "github.com/crossplane/provider-aws/pkg/clients/sns"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -61,9 +62,9 @@ func SetupSubscription(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1alpha1.SNSSubscription{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.SNSSubscriptionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sns.NewSubscriptionClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sns.NewSubscriptionClient}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(),
 			managed.WithConnectionPublishers(),
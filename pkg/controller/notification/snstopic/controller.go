@@ -41,6 +41,7 @@ import (
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/sns"
 	snsclient "github.com/crossplane/provider-aws/pkg/clients/sns"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -49,6 +50,7 @@ const (
 	errCreate           = "failed to create the SNS Topic"
 	errDelete           = "failed to delete the SNS Topic"
 	errUpdate           = "failed to update the SNS Topic"
+	errUpToDate         = "cannot check whether the SNS Topic is up to date"
 )
 
 // SetupSNSTopic adds a controller that reconciles SNSTopic.
@@ -61,9 +63,9 @@ func SetupSNSTopic(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1alpha1.SNSTopic{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha1.SNSTopicGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sns.NewTopicClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sns.NewTopicClient}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(),
 			managed.WithConnectionPublishers(),
@@ -121,9 +123,14 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	// GenerateObservation for SNS Topic
 	cr.Status.AtProvider = snsclient.GenerateTopicObservation(res.Attributes)
 
+	upToDate, err := snsclient.IsSNSTopicUpToDate(cr.Spec.ForProvider, res.Attributes)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDate)
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        snsclient.IsSNSTopicUpToDate(cr.Spec.ForProvider, res.Attributes),
+		ResourceUpToDate:        upToDate,
 		ResourceLateInitialized: !reflect.DeepEqual(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -159,7 +166,10 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	}
 
 	// Update Topic Attributes
-	attrs := snsclient.GetChangedAttributes(cr.Spec.ForProvider, resp.Attributes)
+	attrs, err := snsclient.GetChangedAttributes(cr.Spec.ForProvider, resp.Attributes)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	}
 	for k, v := range attrs {
 		_, err = e.client.SetTopicAttributes(ctx, &awssns.SetTopicAttributesInput{
 			AttributeName:  aws.String(k),
@@ -0,0 +1,79 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type pollOverride struct {
+	patterns []kindPattern
+	interval time.Duration
+}
+
+// PollIntervals determine how often the controller of each kind checks its
+// managed resources for drift.
+type PollIntervals struct {
+	def       time.Duration
+	overrides []pollOverride
+}
+
+// NewPollIntervals returns PollIntervals that poll at the supplied default
+// interval unless overridden. Overrides are of the form PATTERNS=DURATION,
+// e.g. ec2/SecurityGroup=30s, where PATTERNS is a comma separated list of the
+// GROUP[/KIND] patterns understood by NewFilter. The last override that
+// matches a kind applies.
+func NewPollIntervals(def time.Duration, overrides []string) (*PollIntervals, error) {
+	p := &PollIntervals{def: def}
+	for _, s := range overrides {
+		i := strings.LastIndex(s, "=")
+		if i < 0 {
+			return nil, errors.Errorf("invalid poll interval override %q: must be of the form PATTERNS=DURATION", s)
+		}
+		patterns, err := parsePatterns([]string{s[:i]})
+		if err != nil {
+			return nil, err
+		}
+		if len(patterns) == 0 {
+			return nil, errors.Errorf("invalid poll interval override %q: no patterns", s)
+		}
+		d, err := time.ParseDuration(strings.TrimSpace(s[i+1:]))
+		if err != nil || d <= 0 {
+			return nil, errors.Errorf("invalid poll interval override %q: must be a positive duration", s)
+		}
+		p.overrides = append(p.overrides, pollOverride{patterns: patterns, interval: d})
+	}
+	return p, nil
+}
+
+// For returns the poll interval of the controller of the supplied kind.
+func (p *PollIntervals) For(gk schema.GroupKind) time.Duration {
+	d := p.def
+	for _, o := range p.overrides {
+		for _, kp := range o.patterns {
+			if kp.matches(gk) {
+				d = o.interval
+				break
+			}
+		}
+	}
+	return d
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestPollIntervals(t *testing.T) {
+	vpc := schema.GroupKind{Group: "ec2.aws.crossplane.io", Kind: "VPC"}
+	sg := schema.GroupKind{Group: "ec2.aws.crossplane.io", Kind: "SecurityGroup"}
+	bucket := schema.GroupKind{Group: "s3.aws.crossplane.io", Kind: "Bucket"}
+	distribution := schema.GroupKind{Group: "cloudfront.aws.crossplane.io", Kind: "Distribution"}
+
+	type want struct {
+		intervals map[schema.GroupKind]time.Duration
+		err       bool
+	}
+
+	cases := map[string]struct {
+		reason    string
+		overrides []string
+		want      want
+	}{
+		"NoOverrides": {
+			reason: "All controllers should poll at the default interval if no override is supplied.",
+			want: want{
+				intervals: map[schema.GroupKind]time.Duration{vpc: time.Minute, sg: time.Minute, bucket: time.Minute, distribution: time.Minute},
+			},
+		},
+		"Overrides": {
+			reason: "Controllers should poll at the interval of the last override that matches their kind.",
+			overrides: []string{
+				"ec2,s3=5m",
+				"cloudfront=1h",
+				"ec2/SecurityGroup=30s",
+			},
+			want: want{
+				intervals: map[schema.GroupKind]time.Duration{vpc: 5 * time.Minute, sg: 30 * time.Second, bucket: 5 * time.Minute, distribution: time.Hour},
+			},
+		},
+		"NoDuration": {
+			reason:    "Overrides without a duration should be rejected.",
+			overrides: []string{"ec2"},
+			want:      want{err: true},
+		},
+		"InvalidDuration": {
+			reason:    "Overrides with an invalid duration should be rejected.",
+			overrides: []string{"ec2=often"},
+			want:      want{err: true},
+		},
+		"NoPatterns": {
+			reason:    "Overrides without patterns should be rejected.",
+			overrides: []string{"=5m"},
+			want:      want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p, err := NewPollIntervals(time.Minute, tc.overrides)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Fatalf("\n%s\nNewPollIntervals(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			for gk, want := range tc.want.intervals {
+				if diff := cmp.Diff(want, p.For(gk)); diff != "" {
+					t.Errorf("\n%s\nFor(%s): -want, +got:\n%s", tc.reason, gk, diff)
+				}
+			}
+		})
+	}
+}
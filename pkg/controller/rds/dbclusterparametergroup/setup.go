@@ -21,6 +21,7 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupDBClusterParameterGroup adds a controller that reconciles DBClusterParameterGroup.
@@ -43,9 +44,9 @@ func SetupDBClusterParameterGroup(mgr ctrl.Manager, l logging.Logger, rl workque
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.DBClusterParameterGroup{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DBClusterParameterGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -41,6 +41,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/redshift"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -63,9 +64,9 @@ func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter,
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1alpha1.Cluster{}).
-		Complete(managed.NewReconciler(
+		Complete(drift.NewReconciler(
 			mgr, resource.ManagedKind(v1alpha1.ClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: redshift.NewClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: redshift.NewClient}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -22,6 +22,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
 	svcapitypes "github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupResolverEndpoint adds a controller that reconciles ResolverEndpoints
@@ -43,9 +44,9 @@ func SetupResolverEndpoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.Rate
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1alpha1.ResolverEndpoint{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			cpresource.ManagedKind(v1alpha1.ResolverEndpointGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
@@ -22,6 +22,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
 	svcapitypes "github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 // SetupResolverRule adds a controller that reconciles ResolverRule
@@ -43,9 +44,9 @@ func SetupResolverRule(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1alpha1.ResolverRule{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			cpresource.ManagedKind(v1alpha1.ResolverRuleGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
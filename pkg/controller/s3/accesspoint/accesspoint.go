@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspoint
+
+import (
+	"context"
+	"time"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an AccessPoint resource"
+	errCreateSession    = "cannot create a new session"
+	errAccountID        = "cannot determine the account of the AccessPoint"
+	errGet              = "failed to get AccessPoint"
+	errCreate           = "failed to create AccessPoint"
+	errDelete           = "failed to delete AccessPoint"
+)
+
+// ConnectionDetailsKeyARN is the key of the ARN of an AccessPoint in its
+// connection details.
+const ConnectionDetailsKeyARN = "arn"
+
+// SetupAccessPoint adds a controller that reconciles AccessPoints.
+func SetupAccessPoint(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha3.AccessPointGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha3.AccessPoint{}).
+		Complete(drift.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.AccessPointGroupVersionKind),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(),
+				newClientFn: s3.NewAccessPointClient, newCallerIdentityClientFn: s3.NewCallerIdentityClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube                      client.Client
+	newClientFn               func(sess *session.Session) s3.AccessPointClient
+	newCallerIdentityClientFn func(sess *session.Session) s3.CallerIdentityClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha3.AccessPoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{
+		client: c.newClientFn(sess),
+		sts:    c.newCallerIdentityClientFn(sess),
+		region: awsv1.StringValue(sess.Config.Region),
+	}, nil
+}
+
+type external struct {
+	client s3.AccessPointClient
+	sts    s3.CallerIdentityClient
+	region string
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.AccessPoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+
+	// The account is resolved once and recorded in the spec, so that STS is
+	// not called on every reconcile.
+	accountID, err := s3.AccountID(ctx, e.sts, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errAccountID)
+	}
+	cr.Spec.ForProvider.AccountID = awsv1.String(accountID)
+
+	name := meta.GetExternalName(cr)
+	resp, err := e.client.GetAccessPointWithContext(ctx, &s3control.GetAccessPointInput{
+		AccountId: awsv1.String(accountID),
+		Name:      awsv1.String(name),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(s3.IsErrorAccessPointNotFound, err), errGet)
+	}
+
+	s3.LateInitializeAccessPoint(&cr.Spec.ForProvider, resp)
+
+	arn := s3.AccessPointARN(e.region, accountID, name)
+	cr.Status.AtProvider = v1alpha3.AccessPointObservation{
+		ARN:           arn,
+		NetworkOrigin: awsv1.StringValue(resp.NetworkOrigin),
+	}
+	cr.SetConditions(xpv1.Available())
+
+	// All parameters of an access point are immutable, so an existing access
+	// point is always up to date.
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails: managed.ConnectionDetails{
+			ConnectionDetailsKeyARN:                   []byte(arn),
+			xpv1.ResourceCredentialsSecretEndpointKey: []byte(s3.AccessPointEndpoint(e.region, accountID, name)),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.AccessPoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	accountID, err := s3.AccountID(ctx, e.sts, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errAccountID)
+	}
+	_, err = e.client.CreateAccessPointWithContext(ctx, s3.GenerateCreateAccessPointInput(meta.GetExternalName(cr), accountID, cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.AccessPoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	accountID, err := s3.AccountID(ctx, e.sts, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return awsclient.Wrap(err, errAccountID)
+	}
+	_, err = e.client.DeleteAccessPointWithContext(ctx, &s3control.DeleteAccessPointInput{
+		AccountId: awsv1.String(accountID),
+		Name:      awsv1.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(s3.IsErrorAccessPointNotFound, err), errDelete)
+}
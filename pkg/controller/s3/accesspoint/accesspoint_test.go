@@ -0,0 +1,382 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspoint
+
+import (
+	"context"
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	region         = "us-east-1"
+	accountID      = "123456789012"
+	name           = "team-a"
+	bucketName     = "test.s3.crossplane.com"
+	vpcID          = "vpc-1"
+	arn            = "arn:aws:s3:us-east-1:123456789012:accesspoint/team-a"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client s3.AccessPointClient
+	sts    s3.CallerIdentityClient
+	cr     resource.Managed
+}
+
+type accessPointModifier func(*v1alpha3.AccessPoint)
+
+func withConditions(c ...xpv1.Condition) accessPointModifier {
+	return func(r *v1alpha3.AccessPoint) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAccountID(id string) accessPointModifier {
+	return func(r *v1alpha3.AccessPoint) { r.Spec.ForProvider.AccountID = &id }
+}
+
+func withVPC(id string) accessPointModifier {
+	return func(r *v1alpha3.AccessPoint) {
+		r.Spec.ForProvider.VPCConfiguration = &v1alpha3.AccessPointVPCConfiguration{VPCID: &id}
+	}
+}
+
+func withAtProvider(o v1alpha3.AccessPointObservation) accessPointModifier {
+	return func(r *v1alpha3.AccessPoint) { r.Status.AtProvider = o }
+}
+
+func accessPoint(m ...accessPointModifier) *v1alpha3.AccessPoint {
+	cr := &v1alpha3.AccessPoint{
+		Spec: v1alpha3.AccessPointSpec{
+			ForProvider: v1alpha3.AccessPointParameters{
+				BucketName: &bucketName,
+			},
+		},
+	}
+	meta.SetExternalName(cr, name)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func callerIdentity() *fake.MockCallerIdentityClient {
+	return &fake.MockCallerIdentityClient{
+		MockGetCallerIdentity: func(_ awsv1.Context, _ *sts.GetCallerIdentityInput, _ []request.Option) (*sts.GetCallerIdentityOutput, error) {
+			return &sts.GetCallerIdentityOutput{Account: &accountID}, nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPoint: func(_ awsv1.Context, _ *s3control.GetAccessPointInput, _ []request.Option) (*s3control.GetAccessPointOutput, error) {
+						return nil, awserr.New(s3.AccessPointNotFoundErrCode, "", nil)
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(withAccountID(accountID)),
+			},
+		},
+		"AccountIDError": {
+			args: args{
+				sts: &fake.MockCallerIdentityClient{
+					MockGetCallerIdentity: func(_ awsv1.Context, _ *sts.GetCallerIdentityInput, _ []request.Option) (*sts.GetCallerIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(),
+				err: errors.Wrap(errBoom, errAccountID),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPoint: func(_ awsv1.Context, _ *s3control.GetAccessPointInput, _ []request.Option) (*s3control.GetAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(withAccountID(accountID)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPoint: func(_ awsv1.Context, in *s3control.GetAccessPointInput, _ []request.Option) (*s3control.GetAccessPointOutput, error) {
+						if awsv1.StringValue(in.AccountId) != accountID || awsv1.StringValue(in.Name) != name {
+							return nil, errBoom
+						}
+						return &s3control.GetAccessPointOutput{
+							Bucket:           &bucketName,
+							Name:             &name,
+							NetworkOrigin:    awsv1.String(s3control.NetworkOriginVpc),
+							VpcConfiguration: &s3control.VpcConfiguration{VpcId: &vpcID},
+						}, nil
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(
+					withAccountID(accountID),
+					withVPC(vpcID),
+					withAtProvider(v1alpha3.AccessPointObservation{ARN: arn, NetworkOrigin: s3control.NetworkOriginVpc}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						ConnectionDetailsKeyARN:                   []byte(arn),
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte("team-a-123456789012.s3-accesspoint.us-east-1.amazonaws.com"),
+					},
+				},
+			},
+		},
+		"KnownAccountID": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPoint: func(_ awsv1.Context, _ *s3control.GetAccessPointInput, _ []request.Option) (*s3control.GetAccessPointOutput, error) {
+						return &s3control.GetAccessPointOutput{Bucket: &bucketName, Name: &name, NetworkOrigin: awsv1.String(s3control.NetworkOriginInternet)}, nil
+					},
+				},
+				sts: &fake.MockCallerIdentityClient{
+					MockGetCallerIdentity: func(_ awsv1.Context, _ *sts.GetCallerIdentityInput, _ []request.Option) (*sts.GetCallerIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(withAccountID(accountID)),
+			},
+			want: want{
+				cr: accessPoint(
+					withAccountID(accountID),
+					withAtProvider(v1alpha3.AccessPointObservation{ARN: arn, NetworkOrigin: s3control.NetworkOriginInternet}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						ConnectionDetailsKeyARN:                   []byte(arn),
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte("team-a-123456789012.s3-accesspoint.us-east-1.amazonaws.com"),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stsClient := tc.sts
+			if stsClient == nil {
+				stsClient = callerIdentity()
+			}
+			e := &external{client: tc.client, sts: stsClient, region: region}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"VPCAccessPoint": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockCreateAccessPoint: func(_ awsv1.Context, in *s3control.CreateAccessPointInput, _ []request.Option) (*s3control.CreateAccessPointOutput, error) {
+						want := &s3control.CreateAccessPointInput{
+							AccountId:        &accountID,
+							Bucket:           &bucketName,
+							Name:             &name,
+							VpcConfiguration: &s3control.VpcConfiguration{VpcId: &vpcID},
+						}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("CreateAccessPointInput: -want, +got:\n%s", diff)
+						}
+						return &s3control.CreateAccessPointOutput{AccessPointArn: &arn}, nil
+					},
+				},
+				cr: accessPoint(withVPC(vpcID)),
+			},
+			want: want{
+				cr: accessPoint(withVPC(vpcID), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockCreateAccessPoint: func(_ awsv1.Context, _ *s3control.CreateAccessPointInput, _ []request.Option) (*s3control.CreateAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, sts: callerIdentity(), region: region}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Deleted": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockDeleteAccessPoint: func(_ awsv1.Context, _ *s3control.DeleteAccessPointInput, _ []request.Option) (*s3control.DeleteAccessPointOutput, error) {
+						return &s3control.DeleteAccessPointOutput{}, nil
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockDeleteAccessPoint: func(_ awsv1.Context, _ *s3control.DeleteAccessPointInput, _ []request.Option) (*s3control.DeleteAccessPointOutput, error) {
+						return nil, awserr.New(s3.AccessPointNotFoundErrCode, "", nil)
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr: accessPoint(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockDeleteAccessPoint: func(_ awsv1.Context, _ *s3control.DeleteAccessPointInput, _ []request.Option) (*s3control.DeleteAccessPointOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPoint(),
+			},
+			want: want{
+				cr:  accessPoint(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, sts: callerIdentity(), region: region}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspointpolicy
+
+import (
+	"context"
+	"time"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an AccessPointPolicy resource"
+	errCreateSession    = "cannot create a new session"
+	errAccountID        = "cannot determine the account of the access point"
+	errGet              = "failed to get the policy of access point"
+	errPut              = "failed to put the policy of access point"
+	errDelete           = "failed to delete the policy of access point"
+	errFormat           = "failed to format the policy of access point"
+)
+
+// SetupAccessPointPolicy adds a controller that reconciles
+// AccessPointPolicies.
+func SetupAccessPointPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha3.AccessPointPolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha3.AccessPointPolicy{}).
+		Complete(drift.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.AccessPointPolicyGroupVersionKind),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(),
+				newClientFn: s3.NewAccessPointClient, newCallerIdentityClientFn: s3.NewCallerIdentityClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube                      client.Client
+	newClientFn               func(sess *session.Session) s3.AccessPointClient
+	newCallerIdentityClientFn func(sess *session.Session) s3.CallerIdentityClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha3.AccessPointPolicy)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess), sts: c.newCallerIdentityClientFn(sess)}, nil
+}
+
+type external struct {
+	client s3.AccessPointClient
+	sts    s3.CallerIdentityClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.AccessPointPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// The account is resolved once and recorded in the spec, so that STS is
+	// not called on every reconcile.
+	recorded := cr.Spec.ForProvider.AccountID != nil
+	accountID, err := s3.AccountID(ctx, e.sts, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errAccountID)
+	}
+	cr.Spec.ForProvider.AccountID = awsv1.String(accountID)
+
+	resp, err := e.client.GetAccessPointPolicyWithContext(ctx, &s3control.GetAccessPointPolicyInput{
+		AccountId: awsv1.String(accountID),
+		Name:      cr.Spec.ForProvider.AccessPointName,
+	})
+	if s3.IsErrorAccessPointNotFound(err) || s3.IsErrorAccessPointPolicyNotFound(err) {
+		return managed.ExternalObservation{}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGet)
+	}
+
+	policy, err := s3.FormatAccessPointPolicy(cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errFormat)
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        policyclient.Equal(policy, awsv1.StringValue(resp.Policy)),
+		ResourceLateInitialized: !recorded,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.AccessPointPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.put(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.AccessPointPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, e.put(ctx, cr)
+}
+
+func (e *external) put(ctx context.Context, cr *v1alpha3.AccessPointPolicy) error {
+	policy, err := s3.FormatAccessPointPolicy(cr.Spec.ForProvider)
+	if err != nil {
+		return errors.Wrap(err, errFormat)
+	}
+	accountID, err := s3.AccountID(ctx, e.sts, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return awsclient.Wrap(err, errAccountID)
+	}
+	_, err = e.client.PutAccessPointPolicyWithContext(ctx, &s3control.PutAccessPointPolicyInput{
+		AccountId: awsv1.String(accountID),
+		Name:      cr.Spec.ForProvider.AccessPointName,
+		Policy:    awsv1.String(policy),
+	})
+	return awsclient.Wrap(err, errPut)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.AccessPointPolicy)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	accountID, err := s3.AccountID(ctx, e.sts, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return awsclient.Wrap(err, errAccountID)
+	}
+	_, err = e.client.DeleteAccessPointPolicyWithContext(ctx, &s3control.DeleteAccessPointPolicyInput{
+		AccountId: awsv1.String(accountID),
+		Name:      cr.Spec.ForProvider.AccessPointName,
+	})
+	if s3.IsErrorAccessPointNotFound(err) {
+		return nil
+	}
+	return awsclient.Wrap(resource.Ignore(s3.IsErrorAccessPointPolicyNotFound, err), errDelete)
+}
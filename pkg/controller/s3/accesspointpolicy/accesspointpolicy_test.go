@@ -0,0 +1,384 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspointpolicy
+
+import (
+	"context"
+	"testing"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem  resource.Managed
+	accountID       = "123456789012"
+	accessPointName = "team-a"
+	policy          = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:GetObject","Resource":"arn:aws:s3:us-east-1:123456789012:accesspoint/team-a/object/*"}]}`
+	// policy as returned by AWS, with reordered keys and whitespace
+	externalPolicy = `{
+  "Statement": [{"Action": "s3:GetObject", "Effect": "Allow", "Principal": {"AWS": "arn:aws:iam::123456789012:root"}, "Resource": "arn:aws:s3:us-east-1:123456789012:accesspoint/team-a/object/*"}],
+  "Version": "2012-10-17"
+}`
+	otherPolicy = `{"Version":"2012-10-17","Statement":[]}`
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client s3.AccessPointClient
+	sts    s3.CallerIdentityClient
+	cr     resource.Managed
+}
+
+type policyModifier func(*v1alpha3.AccessPointPolicy)
+
+func withConditions(c ...xpv1.Condition) policyModifier {
+	return func(r *v1alpha3.AccessPointPolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAccountID(id string) policyModifier {
+	return func(r *v1alpha3.AccessPointPolicy) { r.Spec.ForProvider.AccountID = &id }
+}
+
+func accessPointPolicy(m ...policyModifier) *v1alpha3.AccessPointPolicy {
+	cr := &v1alpha3.AccessPointPolicy{
+		Spec: v1alpha3.AccessPointPolicySpec{
+			ForProvider: v1alpha3.AccessPointPolicyParameters{
+				AccessPointName: &accessPointName,
+				RawPolicy:       &policy,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func callerIdentity() *fake.MockCallerIdentityClient {
+	return &fake.MockCallerIdentityClient{
+		MockGetCallerIdentity: func(_ awsv1.Context, _ *sts.GetCallerIdentityInput, _ []request.Option) (*sts.GetCallerIdentityOutput, error) {
+			return &sts.GetCallerIdentityOutput{Account: &accountID}, nil
+		},
+	}
+}
+
+func getPolicy(p string, err error) func(awsv1.Context, *s3control.GetAccessPointPolicyInput, []request.Option) (*s3control.GetAccessPointPolicyOutput, error) {
+	return func(_ awsv1.Context, _ *s3control.GetAccessPointPolicyInput, _ []request.Option) (*s3control.GetAccessPointPolicyOutput, error) {
+		if err != nil {
+			return nil, err
+		}
+		return &s3control.GetAccessPointPolicyOutput{Policy: &p}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"PolicyNotFound": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPointPolicy: getPolicy("", awserr.New(s3.AccessPointPolicyNotFoundErrCode, "", nil)),
+				},
+				cr: accessPointPolicy(),
+			},
+			want: want{
+				cr: accessPointPolicy(withAccountID(accountID)),
+			},
+		},
+		"AccessPointNotFound": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPointPolicy: getPolicy("", awserr.New(s3.AccessPointNotFoundErrCode, "", nil)),
+				},
+				cr: accessPointPolicy(),
+			},
+			want: want{
+				cr: accessPointPolicy(withAccountID(accountID)),
+			},
+		},
+		"AccountIDError": {
+			args: args{
+				sts: &fake.MockCallerIdentityClient{
+					MockGetCallerIdentity: func(_ awsv1.Context, _ *sts.GetCallerIdentityInput, _ []request.Option) (*sts.GetCallerIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPointPolicy(),
+			},
+			want: want{
+				cr:  accessPointPolicy(),
+				err: errors.Wrap(errBoom, errAccountID),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPointPolicy: getPolicy("", errBoom),
+				},
+				cr: accessPointPolicy(),
+			},
+			want: want{
+				cr:  accessPointPolicy(withAccountID(accountID)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPointPolicy: getPolicy(externalPolicy, nil),
+				},
+				cr: accessPointPolicy(),
+			},
+			want: want{
+				cr: accessPointPolicy(withAccountID(accountID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPointPolicy: getPolicy(otherPolicy, nil),
+				},
+				cr: accessPointPolicy(),
+			},
+			want: want{
+				cr: accessPointPolicy(withAccountID(accountID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"KnownAccountID": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockGetAccessPointPolicy: getPolicy(externalPolicy, nil),
+				},
+				sts: &fake.MockCallerIdentityClient{
+					MockGetCallerIdentity: func(_ awsv1.Context, _ *sts.GetCallerIdentityInput, _ []request.Option) (*sts.GetCallerIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPointPolicy(withAccountID(accountID)),
+			},
+			want: want{
+				cr: accessPointPolicy(withAccountID(accountID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			stsClient := tc.sts
+			if stsClient == nil {
+				stsClient = callerIdentity()
+			}
+			e := &external{client: tc.client, sts: stsClient}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Created": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockPutAccessPointPolicy: func(_ awsv1.Context, in *s3control.PutAccessPointPolicyInput, _ []request.Option) (*s3control.PutAccessPointPolicyOutput, error) {
+						want := &s3control.PutAccessPointPolicyInput{
+							AccountId: &accountID,
+							Name:      &accessPointName,
+							Policy:    &policy,
+						}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("PutAccessPointPolicyInput: -want, +got:\n%s", diff)
+						}
+						return &s3control.PutAccessPointPolicyOutput{}, nil
+					},
+				},
+				cr: accessPointPolicy(),
+			},
+			want: want{
+				cr: accessPointPolicy(withConditions(xpv1.Creating())),
+			},
+		},
+		"NoPolicy": {
+			args: args{
+				cr: accessPointPolicy(func(r *v1alpha3.AccessPointPolicy) { r.Spec.ForProvider.RawPolicy = nil }),
+			},
+			want: want{
+				cr:  accessPointPolicy(withConditions(xpv1.Creating()), func(r *v1alpha3.AccessPointPolicy) { r.Spec.ForProvider.RawPolicy = nil }),
+				err: errors.Wrap(errors.New("no rawPolicy or policy specified"), errFormat),
+			},
+		},
+		"PutError": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockPutAccessPointPolicy: func(_ awsv1.Context, _ *s3control.PutAccessPointPolicyInput, _ []request.Option) (*s3control.PutAccessPointPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPointPolicy(),
+			},
+			want: want{
+				cr:  accessPointPolicy(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, sts: callerIdentity()}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Deleted": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockDeleteAccessPointPolicy: func(_ awsv1.Context, _ *s3control.DeleteAccessPointPolicyInput, _ []request.Option) (*s3control.DeleteAccessPointPolicyOutput, error) {
+						return &s3control.DeleteAccessPointPolicyOutput{}, nil
+					},
+				},
+				cr: accessPointPolicy(),
+			},
+			want: want{
+				cr: accessPointPolicy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AccessPointDeleted": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockDeleteAccessPointPolicy: func(_ awsv1.Context, _ *s3control.DeleteAccessPointPolicyInput, _ []request.Option) (*s3control.DeleteAccessPointPolicyOutput, error) {
+						return nil, awserr.New(s3.AccessPointNotFoundErrCode, "", nil)
+					},
+				},
+				cr: accessPointPolicy(),
+			},
+			want: want{
+				cr: accessPointPolicy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockAccessPointClient{
+					MockDeleteAccessPointPolicy: func(_ awsv1.Context, _ *s3control.DeleteAccessPointPolicyInput, _ []request.Option) (*s3control.DeleteAccessPointPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPointPolicy(),
+			},
+			want: want{
+				cr:  accessPointPolicy(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, sts: callerIdentity()}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
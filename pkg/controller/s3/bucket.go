@@ -18,10 +18,12 @@ package s3
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	k8serrors "k8s.io/apimachinery/pkg/util/errors"
@@ -41,6 +43,9 @@ import (
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/sns"
+	"github.com/crossplane/provider-aws/pkg/clients/sqs"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 	"github.com/crossplane/provider-aws/pkg/controller/s3/bucket"
 )
 
@@ -51,8 +56,15 @@ const (
 	errCreateOrUpdate   = "cannot create or update"
 	errDelete           = "cannot delete"
 	errKubeUpdateFailed = "cannot update S3 custom resource"
+	errListVersions     = "cannot list object versions of the Bucket"
+	errDeleteObjects    = "cannot delete objects of the Bucket"
 )
 
+// maxEmptyPages is the maximum number of pages of object versions deleted
+// from a force destroyed Bucket per reconcile. Larger Buckets are emptied
+// across several reconciles.
+const maxEmptyPages = 10
+
 // SetupBucket adds a controller that reconciles Buckets.
 func SetupBucket(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
 	name := managed.ControllerName(v1beta1.BucketGroupKind)
@@ -63,10 +75,11 @@ func SetupBucket(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, p
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1beta1.Bucket{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.BucketGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewClient, logger: logger}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewClient, logger: logger}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient())),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(logger),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -88,7 +101,17 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, err
 	}
 	s3client := c.newClientFn(*cfg)
-	return &external{s3client: s3client, subresourceClients: bucket.NewSubresourceClients(s3client), kube: c.kube, logger: c.logger}, nil
+	// Notification targets are only managed for Buckets that ask for it, so
+	// that other Buckets do not need an AWS SDK v1 session.
+	var targets bucket.TargetPolicyClient
+	if nc := cr.Spec.ForProvider.NotificationConfiguration; nc != nil && aws.ToBool(nc.ManageTargetPolicies) {
+		sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.LocationConstraint)
+		if err != nil {
+			return nil, err
+		}
+		targets = bucket.NewNotificationTargetPolicyClient(sqs.NewClient(*cfg), sns.NewTopicClient(*cfg), lambda.New(sess))
+	}
+	return &external{s3client: s3client, subresourceClients: bucket.NewSubresourceClients(s3client, c.kube, targets), kube: c.kube, logger: c.logger, region: cfg.Region}, nil
 }
 
 type external struct {
@@ -96,6 +119,10 @@ type external struct {
 	s3client           s3.BucketClient
 	logger             logging.Logger
 	subresourceClients []bucket.SubresourceClient
+
+	// region the client was configured for, i.e. the LocationConstraint of
+	// the Bucket or the default region of its ProviderConfig.
+	region string
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint: gocyclo
@@ -112,11 +139,15 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	lateInit := false
 	current := cr.Spec.ForProvider.DeepCopy()
+	adopt := cr.GetAnnotations()[v1beta1.AnnotationKeyAdopt] == "true"
+
+	cr.Spec.ForProvider.LocationConstraint = awsclient.LateInitializeString(cr.Spec.ForProvider.LocationConstraint, &e.region)
 
 	for _, awsClient := range e.subresourceClients {
-		if awsClient.SubresourceExists(cr) {
-			// we need this check, because we do not want to late init resources the user has
-			// manually removed, our main late init should happen in the Create method
+		// we need this check, because we do not want to late init resources the user has
+		// manually removed, our main late init should happen in the Create method. A
+		// bucket that is being adopted is late initialized in full, like a created one.
+		if adopt || awsClient.SubresourceExists(cr) {
 			err := awsClient.LateInitialize(ctx, cr)
 			if err != nil {
 				return managed.ExternalObservation{}, err
@@ -124,7 +155,25 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 	}
 
-	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+	if adopt {
+		meta.RemoveAnnotations(cr, v1beta1.AnnotationKeyAdopt)
+	}
+
+	// The default tags of the ProviderConfig and the tags Crossplane adds to
+	// every resource are merged once the existing tags of the bucket were late
+	// initialized, so that they are added to rather than replace them. The
+	// tagging is never nil once they were merged.
+	if cr.Spec.ForProvider.BucketTagging == nil {
+		if err := bucket.NewTaggingConfigurationClient(e.s3client).LateInitialize(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+	recorded := cr.GetAnnotations()[awsclient.AnnotationKeyDefaultTags]
+	if err := mergeTags(ctx, e.kube, cr); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if adopt || !cmp.Equal(current, &cr.Spec.ForProvider) || cr.GetAnnotations()[awsclient.AnnotationKeyDefaultTags] != recorded {
 		lateInit = true
 	}
 
@@ -138,12 +187,6 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 	}
 
-	// TODO: smarter updating for the bucket, we dont need to update the ACL every time
-	err := s3.UpdateBucketACL(ctx, e.s3client, cr)
-	if err != nil {
-		return managed.ExternalObservation{}, err
-	}
-
 	cr.Status.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
@@ -163,11 +206,13 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	current := cr.Spec.ForProvider.DeepCopy()
+	cr.Spec.ForProvider.LocationConstraint = awsclient.LateInitializeString(cr.Spec.ForProvider.LocationConstraint, &e.region)
+
 	_, err := e.s3client.CreateBucket(ctx, s3.GenerateCreateBucketInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
 	if resource.Ignore(s3.IsAlreadyExists, err) != nil {
 		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
 	}
-	current := cr.Spec.ForProvider.DeepCopy()
 
 	errs := make([]error, 0)
 	for _, awsClient := range e.subresourceClients {
@@ -224,6 +269,68 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
+	if aws.ToBool(cr.Spec.ForProvider.ForceDestroy) {
+		empty, err := e.empty(ctx, cr)
+		if err != nil || !empty {
+			return err
+		}
+	}
 	_, err := e.s3client.DeleteBucket(ctx, &awss3.DeleteBucketInput{Bucket: aws.String(meta.GetExternalName(cr))})
 	return resource.Ignore(s3.IsNotFound, err)
 }
+
+// empty deletes up to maxEmptyPages pages of the object versions and delete
+// markers of the supplied Bucket, and returns true if none are left.
+func (e *external) empty(ctx context.Context, cr *v1beta1.Bucket) (bool, error) {
+	name := meta.GetExternalName(cr)
+	input := &awss3.ListObjectVersionsInput{Bucket: aws.String(name)}
+	deleted := 0
+	for i := 0; i < maxEmptyPages; i++ {
+		page, err := e.s3client.ListObjectVersions(ctx, input)
+		if err != nil {
+			return false, awsclient.Wrap(err, errListVersions)
+		}
+		if d := s3.GenerateDeleteObjectsInput(name, page); d != nil {
+			out, err := e.s3client.DeleteObjects(ctx, d)
+			if err == nil {
+				err = s3.DeleteObjectsError(out)
+			}
+			if err != nil {
+				return false, awsclient.Wrap(err, errDeleteObjects)
+			}
+			deleted += len(d.Delete.Objects)
+		}
+		if !page.IsTruncated {
+			cr.Status.SetConditions(v1beta1.Emptied())
+			return true, nil
+		}
+		input.KeyMarker = page.NextKeyMarker
+		input.VersionIdMarker = page.NextVersionIdMarker
+	}
+	cr.Status.SetConditions(v1beta1.Emptying(deleted))
+	return false, nil
+}
+
+// mergeTags merges the default tags of the ProviderConfig and the tags
+// Crossplane adds to every resource into the tags of the supplied Bucket.
+func mergeTags(ctx context.Context, kube client.Client, cr *v1beta1.Bucket) error {
+	tagMap := map[string]string{}
+	if cr.Spec.ForProvider.BucketTagging != nil {
+		for _, t := range cr.Spec.ForProvider.BucketTagging.TagSet {
+			tagMap[t.Key] = t.Value
+		}
+	}
+	tagMap, err := awsclient.MergeDefaultTags(ctx, kube, cr, tagMap)
+	if err != nil {
+		return err
+	}
+	tagSet := make([]v1beta1.Tag, 0, len(tagMap))
+	for k, v := range tagMap {
+		tagSet = append(tagSet, v1beta1.Tag{Key: k, Value: v})
+	}
+	sort.Slice(tagSet, func(i, j int) bool {
+		return tagSet[i].Key < tagSet[j].Key
+	})
+	cr.Spec.ForProvider.BucketTagging = &v1beta1.Tagging{TagSet: tagSet}
+	return nil
+}
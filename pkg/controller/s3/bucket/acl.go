@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	awss3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	aclGetFailed = "cannot get Bucket ACL"
+	aclPutFailed = "cannot put Bucket ACL"
+)
+
+// URIs of the predefined Amazon S3 groups.
+const (
+	allUsersURI           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	authenticatedUsersURI = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+)
+
+// cannedACLs are the canned ACLs a Bucket may specify, in the order they are
+// tried when late initializing.
+var cannedACLs = []string{"private", "public-read", "public-read-write", "authenticated-read"}
+
+// ACLClient is the client for API methods and reconciling the ACL
+type ACLClient struct {
+	client s3.BucketClient
+}
+
+// NewACLClient creates the client for the ACL
+func NewACLClient(client s3.BucketClient) *ACLClient {
+	return &ACLClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *ACLClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	if !in.SubresourceExists(bucket) {
+		return Updated, nil
+	}
+	external, err := in.client.GetBucketAcl(ctx, &awss3.GetBucketAclInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil {
+		return NeedsUpdate, awsclient.Wrap(err, aclGetFailed)
+	}
+	local, ok := localGrants(bucket.Spec.ForProvider, ownerID(external))
+	if !ok {
+		// Grants to email addresses are reported as grants to the canonical
+		// users the addresses belong to, so they cannot be compared.
+		return Updated, nil
+	}
+	if !equalGrants(local, externalGrants(external.Grants)) {
+		return NeedsUpdate, nil
+	}
+	return Updated, nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *ACLClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if !in.SubresourceExists(bucket) {
+		return nil
+	}
+	return awsclient.Wrap(s3.UpdateBucketACL(ctx, in.client, bucket), aclPutFailed)
+}
+
+// Delete does nothing because every bucket has an ACL.
+func (*ACLClient) Delete(_ context.Context, _ *v1beta1.Bucket) error {
+	return nil
+}
+
+// LateInitialize sets the canned ACL of the Bucket if it does not specify
+// any ACL and the external grants match a canned ACL.
+func (in *ACLClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if in.SubresourceExists(bucket) {
+		return nil
+	}
+	external, err := in.client.GetBucketAcl(ctx, &awss3.GetBucketAclInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil {
+		return awsclient.Wrap(err, aclGetFailed)
+	}
+	got := externalGrants(external.Grants)
+	for _, acl := range cannedACLs {
+		if equalGrants(cannedGrants(acl, ownerID(external)), got) {
+			bucket.Spec.ForProvider.ACL = aws.String(acl)
+			return nil
+		}
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *ACLClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	p := bucket.Spec.ForProvider
+	return p.ACL != nil || p.GrantFullControl != nil || p.GrantRead != nil || p.GrantReadACP != nil || p.GrantWrite != nil || p.GrantWriteACP != nil
+}
+
+func ownerID(o *awss3.GetBucketAclOutput) string {
+	if o.Owner == nil {
+		return ""
+	}
+	return aws.ToString(o.Owner.ID)
+}
+
+// A grant is a permission granted to a grantee, formatted as
+// PERMISSION:TYPE=VALUE, e.g. READ:uri=http://acs.amazonaws.com/groups/global/AllUsers.
+type grant string
+
+func newGrant(p awss3types.Permission, key, value string) grant {
+	return grant(string(p) + ":" + key + "=" + value)
+}
+
+// localGrants returns the grants the supplied parameters specify. It returns
+// false if they cannot be compared to external grants.
+func localGrants(p v1beta1.BucketParameters, owner string) ([]grant, bool) {
+	if p.ACL != nil {
+		return cannedGrants(aws.ToString(p.ACL), owner), true
+	}
+	var grants []grant
+	for perm, header := range map[awss3types.Permission]*string{
+		awss3types.PermissionFullControl: p.GrantFullControl,
+		awss3types.PermissionRead:        p.GrantRead,
+		awss3types.PermissionReadAcp:     p.GrantReadACP,
+		awss3types.PermissionWrite:       p.GrantWrite,
+		awss3types.PermissionWriteAcp:    p.GrantWriteACP,
+	} {
+		for _, g := range strings.Split(aws.ToString(header), ",") {
+			kv := strings.SplitN(strings.TrimSpace(g), "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			key := strings.ToLower(strings.TrimSpace(kv[0]))
+			if key != "id" && key != "uri" {
+				return nil, false
+			}
+			grants = append(grants, newGrant(perm, key, strings.Trim(strings.TrimSpace(kv[1]), `"`)))
+		}
+	}
+	return grants, true
+}
+
+// cannedGrants returns the grants of the supplied canned ACL.
+func cannedGrants(acl, owner string) []grant {
+	grants := []grant{newGrant(awss3types.PermissionFullControl, "id", owner)}
+	switch acl {
+	case "public-read":
+		grants = append(grants, newGrant(awss3types.PermissionRead, "uri", allUsersURI))
+	case "public-read-write":
+		grants = append(grants, newGrant(awss3types.PermissionRead, "uri", allUsersURI), newGrant(awss3types.PermissionWrite, "uri", allUsersURI))
+	case "authenticated-read":
+		grants = append(grants, newGrant(awss3types.PermissionRead, "uri", authenticatedUsersURI))
+	}
+	return grants
+}
+
+func externalGrants(in []awss3types.Grant) []grant {
+	grants := make([]grant, 0, len(in))
+	for _, g := range in {
+		if g.Grantee == nil {
+			continue
+		}
+		switch g.Grantee.Type { // nolint:exhaustive
+		case awss3types.TypeCanonicalUser:
+			grants = append(grants, newGrant(g.Permission, "id", aws.ToString(g.Grantee.ID)))
+		case awss3types.TypeGroup:
+			grants = append(grants, newGrant(g.Permission, "uri", aws.ToString(g.Grantee.URI)))
+		default:
+			grants = append(grants, newGrant(g.Permission, "emailAddress", aws.ToString(g.Grantee.EmailAddress)))
+		}
+	}
+	return grants
+}
+
+func equalGrants(a, b []grant) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa := make([]string, len(a))
+	sb := make([]string, len(b))
+	for i := range a {
+		sa[i], sb[i] = string(a[i]), string(b[i])
+	}
+	sort.Strings(sa)
+	sort.Strings(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
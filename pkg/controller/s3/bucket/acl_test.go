@@ -0,0 +1,305 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+const aclOwner = "owner"
+
+func aclBucket(p v1beta1.BucketParameters) *v1beta1.Bucket {
+	return &v1beta1.Bucket{Spec: v1beta1.BucketSpec{ForProvider: p}}
+}
+
+func getACL(grants ...s3types.Grant) func(ctx context.Context, input *s3.GetBucketAclInput, opts []func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+	return func(ctx context.Context, input *s3.GetBucketAclInput, opts []func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+		return &s3.GetBucketAclOutput{Owner: &s3types.Owner{ID: aws.String(aclOwner)}, Grants: grants}, nil
+	}
+}
+
+func userGrant(id string, p s3types.Permission) s3types.Grant {
+	return s3types.Grant{Grantee: &s3types.Grantee{Type: s3types.TypeCanonicalUser, ID: aws.String(id)}, Permission: p}
+}
+
+func groupGrant(uri string, p s3types.Permission) s3types.Grant {
+	return s3types.Grant{Grantee: &s3types.Grantee{Type: s3types.TypeGroup, URI: aws.String(uri)}, Permission: p}
+}
+
+func TestACLObserve(t *testing.T) {
+	type args struct {
+		cl *ACLClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotManaged": {
+			args: args{
+				cr: aclBucket(v1beta1.BucketParameters{}),
+				cl: NewACLClient(fake.MockBucketClient{}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"Error": {
+			args: args{
+				cr: aclBucket(v1beta1.BucketParameters{ACL: aws.String("private")}),
+				cl: NewACLClient(fake.MockBucketClient{
+					MockGetBucketAcl: func(ctx context.Context, input *s3.GetBucketAclInput, opts []func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, aclGetFailed),
+			},
+		},
+		"CannedUpdated": {
+			args: args{
+				cr: aclBucket(v1beta1.BucketParameters{ACL: aws.String("public-read")}),
+				cl: NewACLClient(fake.MockBucketClient{
+					MockGetBucketAcl: getACL(groupGrant(allUsersURI, s3types.PermissionRead), userGrant(aclOwner, s3types.PermissionFullControl)),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"CannedNeedsUpdate": {
+			args: args{
+				cr: aclBucket(v1beta1.BucketParameters{ACL: aws.String("private")}),
+				cl: NewACLClient(fake.MockBucketClient{
+					MockGetBucketAcl: getACL(userGrant(aclOwner, s3types.PermissionFullControl), groupGrant(allUsersURI, s3types.PermissionRead)),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"GrantsUpdated": {
+			args: args{
+				cr: aclBucket(v1beta1.BucketParameters{
+					GrantFullControl: aws.String(`id="owner"`),
+					GrantRead:        aws.String(`id="reader", uri="` + authenticatedUsersURI + `"`),
+				}),
+				cl: NewACLClient(fake.MockBucketClient{
+					MockGetBucketAcl: getACL(
+						userGrant(aclOwner, s3types.PermissionFullControl),
+						userGrant("reader", s3types.PermissionRead),
+						groupGrant(authenticatedUsersURI, s3types.PermissionRead),
+					),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"GrantsNeedsUpdate": {
+			args: args{
+				cr: aclBucket(v1beta1.BucketParameters{
+					GrantFullControl: aws.String(`id="owner"`),
+				}),
+				cl: NewACLClient(fake.MockBucketClient{
+					MockGetBucketAcl: getACL(
+						userGrant(aclOwner, s3types.PermissionFullControl),
+						userGrant("writer", s3types.PermissionWrite),
+					),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"EmailGrantsNotCompared": {
+			args: args{
+				cr: aclBucket(v1beta1.BucketParameters{
+					GrantRead: aws.String(`emailAddress="someone@example.com"`),
+				}),
+				cl: NewACLClient(fake.MockBucketClient{
+					MockGetBucketAcl: getACL(userGrant("someone", s3types.PermissionRead)),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestACLCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *ACLClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Skip": {
+			args: args{
+				cr: aclBucket(v1beta1.BucketParameters{}),
+				cl: NewACLClient(fake.MockBucketClient{}),
+			},
+		},
+		"Error": {
+			args: args{
+				cr: aclBucket(v1beta1.BucketParameters{ACL: aws.String("private")}),
+				cl: NewACLClient(fake.MockBucketClient{
+					MockPutBucketAcl: func(ctx context.Context, input *s3.PutBucketAclInput, opts []func(*s3.Options)) (*s3.PutBucketAclOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, aclPutFailed),
+			},
+		},
+		"Success": {
+			args: args{
+				cr: aclBucket(v1beta1.BucketParameters{ACL: aws.String("private")}),
+				cl: NewACLClient(fake.MockBucketClient{
+					MockPutBucketAcl: func(ctx context.Context, input *s3.PutBucketAclInput, opts []func(*s3.Options)) (*s3.PutBucketAclOutput, error) {
+						return &s3.PutBucketAclOutput{}, nil
+					},
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestACLLateInit(t *testing.T) {
+	type args struct {
+		cl *ACLClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		cr  *v1beta1.Bucket
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: aclBucket(v1beta1.BucketParameters{}),
+				cl: NewACLClient(fake.MockBucketClient{
+					MockGetBucketAcl: func(ctx context.Context, input *s3.GetBucketAclInput, opts []func(*s3.Options)) (*s3.GetBucketAclOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				cr:  aclBucket(v1beta1.BucketParameters{}),
+				err: awsclient.Wrap(errBoom, aclGetFailed),
+			},
+		},
+		"Canned": {
+			args: args{
+				cr: aclBucket(v1beta1.BucketParameters{}),
+				cl: NewACLClient(fake.MockBucketClient{
+					MockGetBucketAcl: getACL(userGrant(aclOwner, s3types.PermissionFullControl), groupGrant(authenticatedUsersURI, s3types.PermissionRead)),
+				}),
+			},
+			want: want{
+				cr: aclBucket(v1beta1.BucketParameters{ACL: aws.String("authenticated-read")}),
+			},
+		},
+		"NotCanned": {
+			args: args{
+				cr: aclBucket(v1beta1.BucketParameters{}),
+				cl: NewACLClient(fake.MockBucketClient{
+					MockGetBucketAcl: getACL(userGrant("someone", s3types.PermissionFullControl)),
+				}),
+			},
+			want: want{
+				cr: aclBucket(v1beta1.BucketParameters{}),
+			},
+		},
+		"NoOverwrite": {
+			args: args{
+				cr: aclBucket(v1beta1.BucketParameters{GrantRead: aws.String(`id="reader"`)}),
+				cl: NewACLClient(fake.MockBucketClient{}),
+			},
+			want: want{
+				cr: aclBucket(v1beta1.BucketParameters{GrantRead: aws.String(`id="reader"`)}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	analyticsListFailed   = "cannot list Bucket analytics configurations"
+	analyticsPutFailed    = "cannot put Bucket analytics configuration"
+	analyticsDeleteFailed = "cannot delete Bucket analytics configuration"
+)
+
+// AnalyticsConfigurationClient is the client for API methods and reconciling
+// the analytics configurations of a Bucket.
+type AnalyticsConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewAnalyticsConfigurationClient creates the client for analytics configurations.
+func NewAnalyticsConfigurationClient(client s3.BucketClient) *AnalyticsConfigurationClient {
+	return &AnalyticsConfigurationClient{client: client}
+}
+
+// Observe checks if the analytics configurations match the local configurations.
+func (in *AnalyticsConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	local := GenerateAnalyticsConfigurations(bucket.Spec.ForProvider.AnalyticsConfigurations)
+	put, del := diffAnalyticsConfigurations(local, external)
+	switch {
+	case len(local) == 0:
+		// The analytics configurations of a bucket without any in its spec are
+		// not managed, so that existing buckets keep theirs.
+		return Updated, nil
+	case len(put) == 0 && len(del) == 0:
+		return Updated, nil
+	default:
+		return NeedsUpdate, nil
+	}
+}
+
+// CreateOrUpdate puts the analytics configurations that are missing or differ
+// from the local configurations, and deletes those that are not specified.
+func (in *AnalyticsConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	put, del := diffAnalyticsConfigurations(GenerateAnalyticsConfigurations(bucket.Spec.ForProvider.AnalyticsConfigurations), external)
+	for i := range put {
+		_, err := in.client.PutBucketAnalyticsConfiguration(ctx, &awss3.PutBucketAnalyticsConfigurationInput{
+			Bucket:                 awsclient.String(meta.GetExternalName(bucket)),
+			Id:                     put[i].Id,
+			AnalyticsConfiguration: &put[i],
+		})
+		if err != nil {
+			return awsclient.Wrap(err, analyticsPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, del)
+}
+
+// Delete deletes all analytics configurations of the bucket.
+func (in *AnalyticsConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, len(external))
+	for i := range external {
+		ids[i] = awsclient.StringValue(external[i].Id)
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+// LateInitialize fills the analytics configurations of the bucket if none
+// are specified.
+func (in *AnalyticsConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if len(bucket.Spec.ForProvider.AnalyticsConfigurations) != 0 {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	bucket.Spec.ForProvider.AnalyticsConfigurations = GenerateLocalAnalyticsConfigurations(external)
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *AnalyticsConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.AnalyticsConfigurations) != 0
+}
+
+func (in *AnalyticsConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.AnalyticsConfiguration, error) {
+	var result []types.AnalyticsConfiguration
+	input := &awss3.ListBucketAnalyticsConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketAnalyticsConfigurations(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, analyticsListFailed)
+		}
+		result = append(result, out.AnalyticsConfigurationList...)
+		if !out.IsTruncated {
+			return result, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *AnalyticsConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		_, err := in.client.DeleteBucketAnalyticsConfiguration(ctx, &awss3.DeleteBucketAnalyticsConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, analyticsDeleteFailed)
+		}
+	}
+	return nil
+}
+
+// diffAnalyticsConfigurations returns the local configurations that have to
+// be put and the IDs of the external configurations that have to be deleted.
+func diffAnalyticsConfigurations(local, external []types.AnalyticsConfiguration) ([]types.AnalyticsConfiguration, []string) {
+	existing := make(map[string]types.AnalyticsConfiguration, len(external))
+	for _, c := range external {
+		// The filter is copied so that the external configuration is not
+		// modified.
+		if and, ok := c.Filter.(*types.AnalyticsFilterMemberAnd); ok {
+			sorted := *and
+			sorted.Value.Tags = s3.SortS3TagSet(and.Value.Tags)
+			c.Filter = &sorted
+		}
+		existing[awsclient.StringValue(c.Id)] = c
+	}
+	var put []types.AnalyticsConfiguration
+	for _, c := range local {
+		e, ok := existing[awsclient.StringValue(c.Id)]
+		if !ok || !cmp.Equal(c, e, cmpopts.EquateEmpty(), cmpopts.IgnoreTypes(document.NoSerde{})) {
+			put = append(put, c)
+		}
+		delete(existing, awsclient.StringValue(c.Id))
+	}
+	del := make([]string, 0, len(existing))
+	for _, c := range external {
+		if _, ok := existing[awsclient.StringValue(c.Id)]; ok {
+			del = append(del, awsclient.StringValue(c.Id))
+		}
+	}
+	return put, del
+}
+
+// GenerateAnalyticsConfigurations creates the AnalyticsConfigurations for the AWS SDK
+func GenerateAnalyticsConfigurations(in []v1beta1.AnalyticsConfiguration) []types.AnalyticsConfiguration {
+	result := make([]types.AnalyticsConfiguration, len(in))
+	for i, local := range in {
+		result[i] = types.AnalyticsConfiguration{
+			Id:                   awsclient.String(local.ID),
+			Filter:               generateAnalyticsFilter(local.Filter),
+			StorageClassAnalysis: &types.StorageClassAnalysis{},
+		}
+		if e := local.StorageClassAnalysis.DataExport; e != nil {
+			d := e.Destination.S3BucketDestination
+			result[i].StorageClassAnalysis.DataExport = &types.StorageClassAnalysisDataExport{
+				OutputSchemaVersion: types.StorageClassAnalysisSchemaVersion(e.OutputSchemaVersion),
+				Destination: &types.AnalyticsExportDestination{
+					S3BucketDestination: &types.AnalyticsS3BucketDestination{
+						Bucket:          awsclient.String(d.Bucket),
+						BucketAccountId: d.BucketAccountID,
+						Format:          types.AnalyticsS3ExportFileFormat(d.Format),
+						Prefix:          d.Prefix,
+					},
+				},
+			}
+		}
+	}
+	return result
+}
+
+func generateAnalyticsFilter(in *v1beta1.AnalyticsFilter) types.AnalyticsFilter {
+	switch {
+	case in == nil:
+		return nil
+	case in.And != nil:
+		return &types.AnalyticsFilterMemberAnd{Value: types.AnalyticsAndOperator{
+			Prefix: in.And.Prefix,
+			Tags:   s3.SortS3TagSet(s3.CopyTags(in.And.Tags)),
+		}}
+	case in.Tag != nil:
+		return &types.AnalyticsFilterMemberTag{Value: types.Tag{Key: awsclient.String(in.Tag.Key), Value: awsclient.String(in.Tag.Value)}}
+	case in.Prefix != nil:
+		return &types.AnalyticsFilterMemberPrefix{Value: *in.Prefix}
+	}
+	return nil
+}
+
+// GenerateLocalAnalyticsConfigurations creates the local analytics
+// configurations from the AWS SDK AnalyticsConfigurations.
+func GenerateLocalAnalyticsConfigurations(in []types.AnalyticsConfiguration) []v1beta1.AnalyticsConfiguration {
+	if len(in) == 0 {
+		return nil
+	}
+	result := make([]v1beta1.AnalyticsConfiguration, len(in))
+	for i, external := range in {
+		result[i] = v1beta1.AnalyticsConfiguration{ID: awsclient.StringValue(external.Id)}
+		switch v := external.Filter.(type) {
+		case *types.AnalyticsFilterMemberAnd:
+			result[i].Filter = &v1beta1.AnalyticsFilter{And: &v1beta1.AnalyticsAndOperator{
+				Prefix: v.Value.Prefix,
+				Tags:   s3.CopyAWSTags(v.Value.Tags),
+			}}
+		case *types.AnalyticsFilterMemberPrefix:
+			result[i].Filter = &v1beta1.AnalyticsFilter{Prefix: awsclient.String(v.Value)}
+		case *types.AnalyticsFilterMemberTag:
+			result[i].Filter = &v1beta1.AnalyticsFilter{Tag: &v1beta1.Tag{
+				Key:   awsclient.StringValue(v.Value.Key),
+				Value: awsclient.StringValue(v.Value.Value),
+			}}
+		}
+		if external.StorageClassAnalysis == nil || external.StorageClassAnalysis.DataExport == nil {
+			continue
+		}
+		e := external.StorageClassAnalysis.DataExport
+		result[i].StorageClassAnalysis.DataExport = &v1beta1.StorageClassAnalysisDataExport{
+			OutputSchemaVersion: string(e.OutputSchemaVersion),
+		}
+		if e.Destination != nil && e.Destination.S3BucketDestination != nil {
+			d := e.Destination.S3BucketDestination
+			result[i].StorageClassAnalysis.DataExport.Destination.S3BucketDestination = v1beta1.AnalyticsS3BucketDestination{
+				Bucket:          awsclient.StringValue(d.Bucket),
+				BucketAccountID: d.BucketAccountId,
+				Format:          string(d.Format),
+				Prefix:          d.Prefix,
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,409 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &AnalyticsConfigurationClient{}
+
+func generateAnalyticsConfig(id string) v1beta1.AnalyticsConfiguration {
+	return v1beta1.AnalyticsConfiguration{
+		ID: id,
+		Filter: &v1beta1.AnalyticsFilter{And: &v1beta1.AnalyticsAndOperator{
+			Prefix: awsclient.String(prefix),
+			Tags:   tags,
+		}},
+		StorageClassAnalysis: v1beta1.StorageClassAnalysis{
+			DataExport: &v1beta1.StorageClassAnalysisDataExport{
+				OutputSchemaVersion: "V_1",
+				Destination: v1beta1.AnalyticsExportDestination{
+					S3BucketDestination: v1beta1.AnalyticsS3BucketDestination{
+						Bucket: "arn:aws:s3:::reports",
+						Format: "CSV",
+						Prefix: awsclient.String(prefix),
+					},
+				},
+			},
+		},
+	}
+}
+
+func generateAWSAnalyticsConfig(id string) s3types.AnalyticsConfiguration {
+	return s3types.AnalyticsConfiguration{
+		Id: awsclient.String(id),
+		Filter: &s3types.AnalyticsFilterMemberAnd{Value: s3types.AnalyticsAndOperator{
+			Prefix: awsclient.String(prefix),
+			Tags:   awsTags,
+		}},
+		StorageClassAnalysis: &s3types.StorageClassAnalysis{
+			DataExport: &s3types.StorageClassAnalysisDataExport{
+				OutputSchemaVersion: s3types.StorageClassAnalysisSchemaVersionV1,
+				Destination: &s3types.AnalyticsExportDestination{
+					S3BucketDestination: &s3types.AnalyticsS3BucketDestination{
+						Bucket: awsclient.String("arn:aws:s3:::reports"),
+						Format: s3types.AnalyticsS3ExportFileFormatCsv,
+						Prefix: awsclient.String(prefix),
+					},
+				},
+			},
+		},
+	}
+}
+
+func listAnalytics(c ...s3types.AnalyticsConfiguration) func(context.Context, *s3.ListBucketAnalyticsConfigurationsInput, []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+	return func(_ context.Context, _ *s3.ListBucketAnalyticsConfigurationsInput, _ []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+		return &s3.ListBucketAnalyticsConfigurationsOutput{AnalyticsConfigurationList: c}, nil
+	}
+}
+
+func TestAnalyticsObserve(t *testing.T) {
+	changed := generateAWSAnalyticsConfig("a")
+	changed.Filter = &s3types.AnalyticsFilterMemberPrefix{Value: prefix}
+
+	type args struct {
+		cl *AnalyticsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfig("a"))),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: func(_ context.Context, _ *s3.ListBucketAnalyticsConfigurationsInput, _ []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, analyticsListFailed),
+			},
+		},
+		"NoUpdateNotExists": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalytics()}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"EmptySpecUnmanaged": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalytics(generateAWSAnalyticsConfig("a"))}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsUpdateMissing": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfig("a"), generateAnalyticsConfig("b"))),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalytics(generateAWSAnalyticsConfig("a"))}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdateChanged": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfig("a"))),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalytics(changed)}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdateUnspecified": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfig("a"))),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalytics(generateAWSAnalyticsConfig("a"), generateAWSAnalyticsConfig("b"))}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NoUpdateExists": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfig("a"), generateAnalyticsConfig("b"))),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalytics(generateAWSAnalyticsConfig("b"), generateAWSAnalyticsConfig("a"))}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnalyticsCreateOrUpdate(t *testing.T) {
+	changed := generateAWSAnalyticsConfig("b")
+	changed.Filter = &s3types.AnalyticsFilterMemberPrefix{Value: prefix}
+
+	type args struct {
+		list func(context.Context, *s3.ListBucketAnalyticsConfigurationsInput, []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error)
+		err  error
+		b    *v1beta1.Bucket
+	}
+
+	type want struct {
+		put     []string
+		deleted []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ListError": {
+			args: args{
+				list: func(_ context.Context, _ *s3.ListBucketAnalyticsConfigurationsInput, _ []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+					return nil, errBoom
+				},
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfig("a"))),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, analyticsListFailed),
+			},
+		},
+		"PutError": {
+			args: args{
+				list: listAnalytics(),
+				err:  errBoom,
+				b:    s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfig("a"))),
+			},
+			want: want{
+				put: []string{"a"},
+				err: awsclient.Wrap(errBoom, analyticsPutFailed),
+			},
+		},
+		"PutAndDelete": {
+			args: args{
+				list: listAnalytics(generateAWSAnalyticsConfig("a"), changed, generateAWSAnalyticsConfig("c")),
+				b:    s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfig("a"), generateAnalyticsConfig("b"), generateAnalyticsConfig("d"))),
+			},
+			want: want{
+				put:     []string{"b", "d"},
+				deleted: []string{"c"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var put, deleted []string
+			cl := NewAnalyticsConfigurationClient(fake.MockBucketClient{
+				MockListBucketAnalyticsConfigurations: tc.args.list,
+				MockPutBucketAnalyticsConfiguration: func(_ context.Context, input *s3.PutBucketAnalyticsConfigurationInput, _ []func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error) {
+					put = append(put, awsclient.StringValue(input.Id))
+					return &s3.PutBucketAnalyticsConfigurationOutput{}, tc.args.err
+				},
+				MockDeleteBucketAnalyticsConfiguration: func(_ context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, _ []func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
+					deleted = append(deleted, awsclient.StringValue(input.Id))
+					return &s3.DeleteBucketAnalyticsConfigurationOutput{}, nil
+				},
+			})
+			err := cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.put, put); diff != "" {
+				t.Errorf("put: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnalyticsDelete(t *testing.T) {
+	type args struct {
+		cl *AnalyticsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalytics(generateAWSAnalyticsConfig("a")),
+					MockDeleteBucketAnalyticsConfiguration: func(_ context.Context, _ *s3.DeleteBucketAnalyticsConfigurationInput, _ []func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, analyticsDeleteFailed),
+			},
+		},
+		"SuccessfulDelete": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalytics(generateAWSAnalyticsConfig("a")),
+					MockDeleteBucketAnalyticsConfiguration: func(_ context.Context, _ *s3.DeleteBucketAnalyticsConfigurationInput, _ []func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
+						return &s3.DeleteBucketAnalyticsConfigurationOutput{}, nil
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.Delete(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnalyticsLateInit(t *testing.T) {
+	type args struct {
+		cl *AnalyticsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: func(_ context.Context, _ *s3.ListBucketAnalyticsConfigurationsInput, _ []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, analyticsListFailed),
+				cr:  s3testing.Bucket(),
+			},
+		},
+		"NoLateInitNone": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalytics()}),
+			},
+			want: want{
+				cr: s3testing.Bucket(),
+			},
+		},
+		"SuccessfulLateInit": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalytics(generateAWSAnalyticsConfig("a"))}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfig("a"))),
+			},
+		},
+		"NoOverwrite": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfig("b"))),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{MockListBucketAnalyticsConfigurations: listAnalytics(generateAWSAnalyticsConfig("a"))}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfig("b"))),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffAnalyticsConfigurationsKeepsExternal(t *testing.T) {
+	external := generateAWSAnalyticsConfig("a")
+	external.Filter = &s3types.AnalyticsFilterMemberAnd{Value: s3types.AnalyticsAndOperator{Tags: []s3types.Tag{
+		{Key: awsclient.String("b"), Value: awsclient.String("2")},
+		{Key: awsclient.String("a"), Value: awsclient.String("1")},
+	}}}
+	diffAnalyticsConfigurations(nil, []s3types.AnalyticsConfiguration{external})
+
+	keys := []string{}
+	for _, tag := range external.Filter.(*s3types.AnalyticsFilterMemberAnd).Value.Tags {
+		keys = append(keys, awsclient.StringValue(tag.Key))
+	}
+	if diff := cmp.Diff([]string{"b", "a"}, keys); diff != "" {
+		t.Errorf("external tags: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	intelligentTieringListFailed   = "cannot list Bucket intelligent tiering configurations"
+	intelligentTieringPutFailed    = "cannot put Bucket intelligent tiering configuration"
+	intelligentTieringDeleteFailed = "cannot delete Bucket intelligent tiering configuration"
+)
+
+// IntelligentTieringConfigurationClient is the client for API methods and
+// reconciling the S3 Intelligent-Tiering configurations of a Bucket.
+type IntelligentTieringConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewIntelligentTieringConfigurationClient creates the client for
+// S3 Intelligent-Tiering configurations.
+func NewIntelligentTieringConfigurationClient(client s3.BucketClient) *IntelligentTieringConfigurationClient {
+	return &IntelligentTieringConfigurationClient{client: client}
+}
+
+// Observe checks if the S3 Intelligent-Tiering configurations match the local
+// configurations.
+func (in *IntelligentTieringConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	local := GenerateIntelligentTieringConfigurations(bucket.Spec.ForProvider.IntelligentTieringConfigurations)
+	put, del := diffIntelligentTieringConfigurations(local, external)
+	switch {
+	case len(local) == 0:
+		// The S3 Intelligent-Tiering configurations of a bucket without any in its spec are
+		// not managed, so that existing buckets keep theirs.
+		return Updated, nil
+	case len(put) == 0 && len(del) == 0:
+		return Updated, nil
+	default:
+		return NeedsUpdate, nil
+	}
+}
+
+// CreateOrUpdate puts the S3 Intelligent-Tiering configurations that are
+// missing or differ from the local configurations, and deletes those that are
+// not specified.
+func (in *IntelligentTieringConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	put, del := diffIntelligentTieringConfigurations(GenerateIntelligentTieringConfigurations(bucket.Spec.ForProvider.IntelligentTieringConfigurations), external)
+	for i := range put {
+		_, err := in.client.PutBucketIntelligentTieringConfiguration(ctx, &awss3.PutBucketIntelligentTieringConfigurationInput{
+			Bucket:                          awsclient.String(meta.GetExternalName(bucket)),
+			Id:                              put[i].Id,
+			IntelligentTieringConfiguration: &put[i],
+		})
+		if err != nil {
+			return awsclient.Wrap(err, intelligentTieringPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, del)
+}
+
+// Delete deletes all S3 Intelligent-Tiering configurations of the bucket.
+func (in *IntelligentTieringConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, len(external))
+	for i := range external {
+		ids[i] = awsclient.StringValue(external[i].Id)
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+// LateInitialize fills the S3 Intelligent-Tiering configurations of the
+// bucket if none are specified.
+func (in *IntelligentTieringConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if len(bucket.Spec.ForProvider.IntelligentTieringConfigurations) != 0 {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	bucket.Spec.ForProvider.IntelligentTieringConfigurations = GenerateLocalIntelligentTieringConfigurations(external)
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *IntelligentTieringConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.IntelligentTieringConfigurations) != 0
+}
+
+func (in *IntelligentTieringConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.IntelligentTieringConfiguration, error) {
+	var result []types.IntelligentTieringConfiguration
+	input := &awss3.ListBucketIntelligentTieringConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketIntelligentTieringConfigurations(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, intelligentTieringListFailed)
+		}
+		result = append(result, out.IntelligentTieringConfigurationList...)
+		if !out.IsTruncated {
+			return result, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *IntelligentTieringConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		_, err := in.client.DeleteBucketIntelligentTieringConfiguration(ctx, &awss3.DeleteBucketIntelligentTieringConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, intelligentTieringDeleteFailed)
+		}
+	}
+	return nil
+}
+
+// diffIntelligentTieringConfigurations returns the local configurations that
+// have to be put and the IDs of the external configurations that have to be
+// deleted.
+func diffIntelligentTieringConfigurations(local, external []types.IntelligentTieringConfiguration) ([]types.IntelligentTieringConfiguration, []string) {
+	existing := make(map[string]types.IntelligentTieringConfiguration, len(external))
+	for _, c := range external {
+		// The filter is copied so that the external configuration is not
+		// modified.
+		if c.Filter != nil && c.Filter.And != nil {
+			filter, and := *c.Filter, *c.Filter.And
+			and.Tags = s3.SortS3TagSet(c.Filter.And.Tags)
+			filter.And = &and
+			c.Filter = &filter
+		}
+		existing[awsclient.StringValue(c.Id)] = c
+	}
+	var put []types.IntelligentTieringConfiguration
+	for _, c := range local {
+		e, ok := existing[awsclient.StringValue(c.Id)]
+		if !ok || !cmp.Equal(c, e, cmpopts.EquateEmpty(), cmpopts.IgnoreTypes(document.NoSerde{}),
+			cmpopts.SortSlices(func(a, b types.Tiering) bool { return a.AccessTier < b.AccessTier })) {
+			put = append(put, c)
+		}
+		delete(existing, awsclient.StringValue(c.Id))
+	}
+	del := make([]string, 0, len(existing))
+	for _, c := range external {
+		if _, ok := existing[awsclient.StringValue(c.Id)]; ok {
+			del = append(del, awsclient.StringValue(c.Id))
+		}
+	}
+	return put, del
+}
+
+// GenerateIntelligentTieringConfigurations creates the
+// IntelligentTieringConfigurations for the AWS SDK
+func GenerateIntelligentTieringConfigurations(in []v1beta1.IntelligentTieringConfiguration) []types.IntelligentTieringConfiguration {
+	result := make([]types.IntelligentTieringConfiguration, len(in))
+	for i, local := range in {
+		result[i] = types.IntelligentTieringConfiguration{
+			Id:       awsclient.String(local.ID),
+			Status:   types.IntelligentTieringStatus(local.Status),
+			Tierings: make([]types.Tiering, len(local.Tierings)),
+		}
+		for j, t := range local.Tierings {
+			result[i].Tierings[j] = types.Tiering{AccessTier: types.IntelligentTieringAccessTier(t.AccessTier), Days: t.Days}
+		}
+		if f := local.Filter; f != nil {
+			result[i].Filter = &types.IntelligentTieringFilter{Prefix: f.Prefix}
+			if f.Tag != nil {
+				result[i].Filter.Tag = &types.Tag{Key: awsclient.String(f.Tag.Key), Value: awsclient.String(f.Tag.Value)}
+			}
+			if f.And != nil {
+				result[i].Filter.And = &types.IntelligentTieringAndOperator{
+					Prefix: f.And.Prefix,
+					Tags:   s3.SortS3TagSet(s3.CopyTags(f.And.Tags)),
+				}
+			}
+		}
+	}
+	return result
+}
+
+// GenerateLocalIntelligentTieringConfigurations creates the local
+// S3 Intelligent-Tiering configurations from the AWS SDK
+// IntelligentTieringConfigurations.
+func GenerateLocalIntelligentTieringConfigurations(in []types.IntelligentTieringConfiguration) []v1beta1.IntelligentTieringConfiguration {
+	if len(in) == 0 {
+		return nil
+	}
+	result := make([]v1beta1.IntelligentTieringConfiguration, len(in))
+	for i, external := range in {
+		result[i] = v1beta1.IntelligentTieringConfiguration{
+			ID:       awsclient.StringValue(external.Id),
+			Status:   string(external.Status),
+			Tierings: make([]v1beta1.Tiering, len(external.Tierings)),
+		}
+		for j, t := range external.Tierings {
+			result[i].Tierings[j] = v1beta1.Tiering{AccessTier: string(t.AccessTier), Days: t.Days}
+		}
+		if f := external.Filter; f != nil {
+			result[i].Filter = &v1beta1.IntelligentTieringFilter{Prefix: f.Prefix}
+			if f.Tag != nil {
+				result[i].Filter.Tag = &v1beta1.Tag{Key: awsclient.StringValue(f.Tag.Key), Value: awsclient.StringValue(f.Tag.Value)}
+			}
+			if f.And != nil {
+				result[i].Filter.And = &v1beta1.IntelligentTieringAndOperator{
+					Prefix: f.And.Prefix,
+					Tags:   s3.CopyAWSTags(f.And.Tags),
+				}
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &IntelligentTieringConfigurationClient{}
+
+func generateIntelligentTieringConfig(id string) v1beta1.IntelligentTieringConfiguration {
+	return v1beta1.IntelligentTieringConfiguration{
+		ID:     id,
+		Filter: &v1beta1.IntelligentTieringFilter{Prefix: awsclient.String(prefix)},
+		Status: enabled,
+		Tierings: []v1beta1.Tiering{
+			{AccessTier: "ARCHIVE_ACCESS", Days: 90},
+			{AccessTier: "DEEP_ARCHIVE_ACCESS", Days: 180},
+		},
+	}
+}
+
+func generateAWSIntelligentTieringConfig(id string) s3types.IntelligentTieringConfiguration {
+	return s3types.IntelligentTieringConfiguration{
+		Id:     awsclient.String(id),
+		Filter: &s3types.IntelligentTieringFilter{Prefix: awsclient.String(prefix)},
+		Status: s3types.IntelligentTieringStatusEnabled,
+		Tierings: []s3types.Tiering{
+			{AccessTier: s3types.IntelligentTieringAccessTierArchiveAccess, Days: 90},
+			{AccessTier: s3types.IntelligentTieringAccessTierDeepArchiveAccess, Days: 180},
+		},
+	}
+}
+
+func listIntelligentTiering(c ...s3types.IntelligentTieringConfiguration) func(context.Context, *s3.ListBucketIntelligentTieringConfigurationsInput, []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	return func(_ context.Context, _ *s3.ListBucketIntelligentTieringConfigurationsInput, _ []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+		return &s3.ListBucketIntelligentTieringConfigurationsOutput{IntelligentTieringConfigurationList: c}, nil
+	}
+}
+
+func TestIntelligentTieringObserve(t *testing.T) {
+	reordered := generateAWSIntelligentTieringConfig("a")
+	reordered.Tierings = []s3types.Tiering{reordered.Tierings[1], reordered.Tierings[0]}
+	longer := generateAWSIntelligentTieringConfig("a")
+	longer.Tierings = []s3types.Tiering{{AccessTier: s3types.IntelligentTieringAccessTierArchiveAccess, Days: 120}}
+
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a"))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(_ context.Context, _ *s3.ListBucketIntelligentTieringConfigurationsInput, _ []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, intelligentTieringListFailed),
+			},
+		},
+		"EmptySpecUnmanaged": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{MockListBucketIntelligentTieringConfigurations: listIntelligentTiering(generateAWSIntelligentTieringConfig("a"))}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a"))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{MockListBucketIntelligentTieringConfigurations: listIntelligentTiering(longer)}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NoUpdateExists": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a"))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{MockListBucketIntelligentTieringConfigurations: listIntelligentTiering(reordered)}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutError": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a"))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTiering(),
+					MockPutBucketIntelligentTieringConfiguration: func(_ context.Context, _ *s3.PutBucketIntelligentTieringConfigurationInput, _ []func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, intelligentTieringPutFailed),
+			},
+		},
+		"Successful": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a"))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTiering(generateAWSIntelligentTieringConfig("b")),
+					MockPutBucketIntelligentTieringConfiguration: func(_ context.Context, _ *s3.PutBucketIntelligentTieringConfigurationInput, _ []func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+						return &s3.PutBucketIntelligentTieringConfigurationOutput{}, nil
+					},
+					MockDeleteBucketIntelligentTieringConfiguration: func(_ context.Context, _ *s3.DeleteBucketIntelligentTieringConfigurationInput, _ []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+						return &s3.DeleteBucketIntelligentTieringConfigurationOutput{}, nil
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringLateInit(t *testing.T) {
+	b := s3testing.Bucket()
+	cl := NewIntelligentTieringConfigurationClient(fake.MockBucketClient{MockListBucketIntelligentTieringConfigurations: listIntelligentTiering(generateAWSIntelligentTieringConfig("a"))})
+	if err := cl.LateInitialize(context.Background(), b); err != nil {
+		t.Fatalf("LateInitialize(...): %s", err)
+	}
+	want := s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfig("a")))
+	if diff := cmp.Diff(want, b); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
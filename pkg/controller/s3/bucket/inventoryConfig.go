@@ -0,0 +1,260 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	inventoryListFailed   = "cannot list Bucket inventory configurations"
+	inventoryPutFailed    = "cannot put Bucket inventory configuration"
+	inventoryDeleteFailed = "cannot delete Bucket inventory configuration"
+)
+
+// InventoryConfigurationClient is the client for API methods and reconciling
+// the inventory configurations of a Bucket.
+type InventoryConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewInventoryConfigurationClient creates the client for inventory configurations.
+func NewInventoryConfigurationClient(client s3.BucketClient) *InventoryConfigurationClient {
+	return &InventoryConfigurationClient{client: client}
+}
+
+// Observe checks if the inventory configurations match the local configurations.
+func (in *InventoryConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	local := GenerateInventoryConfigurations(bucket.Spec.ForProvider.InventoryConfigurations)
+	put, del := diffInventoryConfigurations(local, external)
+	switch {
+	case len(local) == 0:
+		// The inventory configurations of a bucket without any in its spec are
+		// not managed, so that existing buckets keep theirs.
+		return Updated, nil
+	case len(put) == 0 && len(del) == 0:
+		return Updated, nil
+	default:
+		return NeedsUpdate, nil
+	}
+}
+
+// CreateOrUpdate puts the inventory configurations that are missing or differ
+// from the local configurations, and deletes those that are not specified.
+func (in *InventoryConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	put, del := diffInventoryConfigurations(GenerateInventoryConfigurations(bucket.Spec.ForProvider.InventoryConfigurations), external)
+	for i := range put {
+		_, err := in.client.PutBucketInventoryConfiguration(ctx, &awss3.PutBucketInventoryConfigurationInput{
+			Bucket:                 awsclient.String(meta.GetExternalName(bucket)),
+			Id:                     put[i].Id,
+			InventoryConfiguration: &put[i],
+		})
+		if err != nil {
+			return awsclient.Wrap(err, inventoryPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, del)
+}
+
+// Delete deletes all inventory configurations of the bucket.
+func (in *InventoryConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, len(external))
+	for i := range external {
+		ids[i] = awsclient.StringValue(external[i].Id)
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+// LateInitialize fills the inventory configurations of the bucket if none
+// are specified.
+func (in *InventoryConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if len(bucket.Spec.ForProvider.InventoryConfigurations) != 0 {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	bucket.Spec.ForProvider.InventoryConfigurations = GenerateLocalInventoryConfigurations(external)
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *InventoryConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.InventoryConfigurations) != 0
+}
+
+func (in *InventoryConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.InventoryConfiguration, error) {
+	var result []types.InventoryConfiguration
+	input := &awss3.ListBucketInventoryConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketInventoryConfigurations(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, inventoryListFailed)
+		}
+		result = append(result, out.InventoryConfigurationList...)
+		if !out.IsTruncated {
+			return result, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *InventoryConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		_, err := in.client.DeleteBucketInventoryConfiguration(ctx, &awss3.DeleteBucketInventoryConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, inventoryDeleteFailed)
+		}
+	}
+	return nil
+}
+
+// diffInventoryConfigurations returns the local configurations that have to
+// be put and the IDs of the external configurations that have to be deleted.
+func diffInventoryConfigurations(local, external []types.InventoryConfiguration) ([]types.InventoryConfiguration, []string) {
+	existing := make(map[string]types.InventoryConfiguration, len(external))
+	for _, c := range external {
+		existing[awsclient.StringValue(c.Id)] = c
+	}
+	var put []types.InventoryConfiguration
+	for _, c := range local {
+		e, ok := existing[awsclient.StringValue(c.Id)]
+		if !ok || !cmp.Equal(c, e, cmpopts.EquateEmpty(), cmpopts.IgnoreTypes(document.NoSerde{}),
+			cmpopts.SortSlices(func(a, b types.InventoryOptionalField) bool { return a < b })) {
+			put = append(put, c)
+		}
+		delete(existing, awsclient.StringValue(c.Id))
+	}
+	del := make([]string, 0, len(existing))
+	for _, c := range external {
+		if _, ok := existing[awsclient.StringValue(c.Id)]; ok {
+			del = append(del, awsclient.StringValue(c.Id))
+		}
+	}
+	return put, del
+}
+
+// GenerateInventoryConfigurations creates the InventoryConfigurations for the AWS SDK
+func GenerateInventoryConfigurations(in []v1beta1.InventoryConfiguration) []types.InventoryConfiguration {
+	result := make([]types.InventoryConfiguration, len(in))
+	for i, local := range in {
+		d := local.Destination.S3BucketDestination
+		result[i] = types.InventoryConfiguration{
+			Id: awsclient.String(local.ID),
+			Destination: &types.InventoryDestination{
+				S3BucketDestination: &types.InventoryS3BucketDestination{
+					AccountId: d.AccountID,
+					Bucket:    awsclient.String(d.Bucket),
+					Format:    types.InventoryFormat(d.Format),
+					Prefix:    d.Prefix,
+				},
+			},
+			IncludedObjectVersions: types.InventoryIncludedObjectVersions(local.IncludedObjectVersions),
+			IsEnabled:              local.IsEnabled,
+			Schedule:               &types.InventorySchedule{Frequency: types.InventoryFrequency(local.Schedule.Frequency)},
+		}
+		if d.Encryption != nil {
+			e := &types.InventoryEncryption{}
+			if d.Encryption.SSEKMS != nil {
+				e.SSEKMS = &types.SSEKMS{KeyId: awsclient.String(d.Encryption.SSEKMS.KeyID)}
+			}
+			if d.Encryption.SSES3 != nil {
+				e.SSES3 = &types.SSES3{}
+			}
+			result[i].Destination.S3BucketDestination.Encryption = e
+		}
+		if local.Filter != nil {
+			result[i].Filter = &types.InventoryFilter{Prefix: awsclient.String(local.Filter.Prefix)}
+		}
+		for _, f := range local.OptionalFields {
+			result[i].OptionalFields = append(result[i].OptionalFields, types.InventoryOptionalField(f))
+		}
+	}
+	return result
+}
+
+// GenerateLocalInventoryConfigurations creates the local inventory
+// configurations from the AWS SDK InventoryConfigurations.
+func GenerateLocalInventoryConfigurations(in []types.InventoryConfiguration) []v1beta1.InventoryConfiguration {
+	if len(in) == 0 {
+		return nil
+	}
+	result := make([]v1beta1.InventoryConfiguration, len(in))
+	for i, external := range in {
+		result[i] = v1beta1.InventoryConfiguration{
+			ID:                     awsclient.StringValue(external.Id),
+			IncludedObjectVersions: string(external.IncludedObjectVersions),
+			IsEnabled:              external.IsEnabled,
+		}
+		if external.Destination != nil && external.Destination.S3BucketDestination != nil {
+			d := external.Destination.S3BucketDestination
+			result[i].Destination.S3BucketDestination = v1beta1.InventoryS3BucketDestination{
+				AccountID: d.AccountId,
+				Bucket:    awsclient.StringValue(d.Bucket),
+				Format:    string(d.Format),
+				Prefix:    d.Prefix,
+			}
+			if d.Encryption != nil {
+				e := &v1beta1.InventoryEncryption{}
+				if d.Encryption.SSEKMS != nil {
+					e.SSEKMS = &v1beta1.SSEKMS{KeyID: awsclient.StringValue(d.Encryption.SSEKMS.KeyId)}
+				}
+				if d.Encryption.SSES3 != nil {
+					e.SSES3 = &v1beta1.SSES3{}
+				}
+				result[i].Destination.S3BucketDestination.Encryption = e
+			}
+		}
+		if external.Filter != nil {
+			result[i].Filter = &v1beta1.InventoryFilter{Prefix: awsclient.StringValue(external.Filter.Prefix)}
+		}
+		for _, f := range external.OptionalFields {
+			result[i].OptionalFields = append(result[i].OptionalFields, string(f))
+		}
+		if external.Schedule != nil {
+			result[i].Schedule.Frequency = string(external.Schedule.Frequency)
+		}
+	}
+	return result
+}
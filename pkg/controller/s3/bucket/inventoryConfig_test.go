@@ -0,0 +1,273 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &InventoryConfigurationClient{}
+
+func generateInventoryConfig(id string) v1beta1.InventoryConfiguration {
+	return v1beta1.InventoryConfiguration{
+		ID: id,
+		Destination: v1beta1.InventoryDestination{
+			S3BucketDestination: v1beta1.InventoryS3BucketDestination{
+				Bucket:     "arn:aws:s3:::reports",
+				Encryption: &v1beta1.InventoryEncryption{SSES3: &v1beta1.SSES3{}},
+				Format:     "Parquet",
+				Prefix:     awsclient.String(prefix),
+			},
+		},
+		Filter:                 &v1beta1.InventoryFilter{Prefix: prefix},
+		IncludedObjectVersions: "Current",
+		IsEnabled:              true,
+		OptionalFields:         []string{"Size", "StorageClass"},
+		Schedule:               v1beta1.InventorySchedule{Frequency: "Daily"},
+	}
+}
+
+func generateAWSInventoryConfig(id string) s3types.InventoryConfiguration {
+	return s3types.InventoryConfiguration{
+		Id: awsclient.String(id),
+		Destination: &s3types.InventoryDestination{
+			S3BucketDestination: &s3types.InventoryS3BucketDestination{
+				Bucket:     awsclient.String("arn:aws:s3:::reports"),
+				Encryption: &s3types.InventoryEncryption{SSES3: &s3types.SSES3{}},
+				Format:     s3types.InventoryFormatParquet,
+				Prefix:     awsclient.String(prefix),
+			},
+		},
+		Filter:                 &s3types.InventoryFilter{Prefix: awsclient.String(prefix)},
+		IncludedObjectVersions: s3types.InventoryIncludedObjectVersionsCurrent,
+		IsEnabled:              true,
+		OptionalFields:         []s3types.InventoryOptionalField{s3types.InventoryOptionalFieldSize, s3types.InventoryOptionalFieldStorageClass},
+		Schedule:               &s3types.InventorySchedule{Frequency: s3types.InventoryFrequencyDaily},
+	}
+}
+
+func listInventory(c ...s3types.InventoryConfiguration) func(context.Context, *s3.ListBucketInventoryConfigurationsInput, []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+	return func(_ context.Context, _ *s3.ListBucketInventoryConfigurationsInput, _ []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+		return &s3.ListBucketInventoryConfigurationsOutput{InventoryConfigurationList: c}, nil
+	}
+}
+
+func TestInventoryObserve(t *testing.T) {
+	reordered := generateAWSInventoryConfig("a")
+	reordered.OptionalFields = []s3types.InventoryOptionalField{s3types.InventoryOptionalFieldStorageClass, s3types.InventoryOptionalFieldSize}
+	disabled := generateAWSInventoryConfig("a")
+	disabled.IsEnabled = false
+
+	type args struct {
+		cl *InventoryConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfig("a"))),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: func(_ context.Context, _ *s3.ListBucketInventoryConfigurationsInput, _ []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, inventoryListFailed),
+			},
+		},
+		"EmptySpecUnmanaged": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{MockListBucketInventoryConfigurations: listInventory(generateAWSInventoryConfig("a"))}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfig("a"))),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{MockListBucketInventoryConfigurations: listInventory(disabled)}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NoUpdateExists": {
+			args: args{
+				b:  s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfig("a"))),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{MockListBucketInventoryConfigurations: listInventory(reordered)}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInventoryCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *InventoryConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutError": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfig("a"))),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventory(),
+					MockPutBucketInventoryConfiguration: func(_ context.Context, _ *s3.PutBucketInventoryConfigurationInput, _ []func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, inventoryPutFailed),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfig("a"))),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventory(generateAWSInventoryConfig("a"), generateAWSInventoryConfig("b")),
+					MockDeleteBucketInventoryConfiguration: func(_ context.Context, _ *s3.DeleteBucketInventoryConfigurationInput, _ []func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, inventoryDeleteFailed),
+			},
+		},
+		"Successful": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfig("a"))),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventory(generateAWSInventoryConfig("b")),
+					MockPutBucketInventoryConfiguration: func(_ context.Context, _ *s3.PutBucketInventoryConfigurationInput, _ []func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+						return &s3.PutBucketInventoryConfigurationOutput{}, nil
+					},
+					MockDeleteBucketInventoryConfiguration: func(_ context.Context, _ *s3.DeleteBucketInventoryConfigurationInput, _ []func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+						return &s3.DeleteBucketInventoryConfigurationOutput{}, nil
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInventoryLateInit(t *testing.T) {
+	type args struct {
+		cl *InventoryConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+		cr  *v1beta1.Bucket
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulLateInit": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{MockListBucketInventoryConfigurations: listInventory(generateAWSInventoryConfig("a"))}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfig("a"))),
+			},
+		},
+		"NoLateInitNone": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{MockListBucketInventoryConfigurations: listInventory()}),
+			},
+			want: want{
+				cr: s3testing.Bucket(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.b); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
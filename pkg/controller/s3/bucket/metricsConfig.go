@@ -0,0 +1,244 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	metricsListFailed   = "cannot list Bucket metrics configurations"
+	metricsPutFailed    = "cannot put Bucket metrics configuration"
+	metricsDeleteFailed = "cannot delete Bucket metrics configuration"
+)
+
+// MetricsConfigurationClient is the client for API methods and reconciling
+// the metrics configurations of a Bucket.
+type MetricsConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewMetricsConfigurationClient creates the client for metrics configurations.
+func NewMetricsConfigurationClient(client s3.BucketClient) *MetricsConfigurationClient {
+	return &MetricsConfigurationClient{client: client}
+}
+
+// Observe checks if the metrics configurations match the local configurations.
+func (in *MetricsConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	local := GenerateMetricsConfigurations(bucket.Spec.ForProvider.MetricsConfigurations)
+	put, del := diffMetricsConfigurations(local, external)
+	switch {
+	case len(local) == 0:
+		// The metrics configurations of a bucket without any in its spec are
+		// not managed, so that existing buckets keep theirs.
+		return Updated, nil
+	case len(put) == 0 && len(del) == 0:
+		return Updated, nil
+	default:
+		return NeedsUpdate, nil
+	}
+}
+
+// CreateOrUpdate puts the metrics configurations that are missing or differ
+// from the local configurations, and deletes those that are not specified.
+func (in *MetricsConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	put, del := diffMetricsConfigurations(GenerateMetricsConfigurations(bucket.Spec.ForProvider.MetricsConfigurations), external)
+	for i := range put {
+		_, err := in.client.PutBucketMetricsConfiguration(ctx, &awss3.PutBucketMetricsConfigurationInput{
+			Bucket:               awsclient.String(meta.GetExternalName(bucket)),
+			Id:                   put[i].Id,
+			MetricsConfiguration: &put[i],
+		})
+		if err != nil {
+			return awsclient.Wrap(err, metricsPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, del)
+}
+
+// Delete deletes all metrics configurations of the bucket.
+func (in *MetricsConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, len(external))
+	for i := range external {
+		ids[i] = awsclient.StringValue(external[i].Id)
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+// LateInitialize fills the metrics configurations of the bucket if none are
+// specified.
+func (in *MetricsConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if len(bucket.Spec.ForProvider.MetricsConfigurations) != 0 {
+		return nil
+	}
+	external, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	bucket.Spec.ForProvider.MetricsConfigurations = GenerateLocalMetricsConfigurations(external)
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *MetricsConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return len(bucket.Spec.ForProvider.MetricsConfigurations) != 0
+}
+
+func (in *MetricsConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]types.MetricsConfiguration, error) {
+	var result []types.MetricsConfiguration
+	input := &awss3.ListBucketMetricsConfigurationsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketMetricsConfigurations(ctx, input)
+		if err != nil {
+			return nil, awsclient.Wrap(err, metricsListFailed)
+		}
+		result = append(result, out.MetricsConfigurationList...)
+		if !out.IsTruncated {
+			return result, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+func (in *MetricsConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		_, err := in.client.DeleteBucketMetricsConfiguration(ctx, &awss3.DeleteBucketMetricsConfigurationInput{
+			Bucket: awsclient.String(meta.GetExternalName(bucket)),
+			Id:     awsclient.String(id),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, metricsDeleteFailed)
+		}
+	}
+	return nil
+}
+
+// diffMetricsConfigurations returns the local configurations that have to be
+// put and the IDs of the external configurations that have to be deleted.
+func diffMetricsConfigurations(local, external []types.MetricsConfiguration) ([]types.MetricsConfiguration, []string) {
+	existing := make(map[string]types.MetricsConfiguration, len(external))
+	for _, c := range external {
+		// The filter is copied so that the external configuration is not
+		// modified.
+		if and, ok := c.Filter.(*types.MetricsFilterMemberAnd); ok {
+			sorted := *and
+			sorted.Value.Tags = s3.SortS3TagSet(and.Value.Tags)
+			c.Filter = &sorted
+		}
+		existing[awsclient.StringValue(c.Id)] = c
+	}
+	var put []types.MetricsConfiguration
+	for _, c := range local {
+		e, ok := existing[awsclient.StringValue(c.Id)]
+		if !ok || !cmp.Equal(c, e, cmpopts.EquateEmpty(), cmpopts.IgnoreTypes(document.NoSerde{})) {
+			put = append(put, c)
+		}
+		delete(existing, awsclient.StringValue(c.Id))
+	}
+	del := make([]string, 0, len(existing))
+	for _, c := range external {
+		if _, ok := existing[awsclient.StringValue(c.Id)]; ok {
+			del = append(del, awsclient.StringValue(c.Id))
+		}
+	}
+	return put, del
+}
+
+// GenerateMetricsConfigurations creates the MetricsConfigurations for the AWS SDK
+func GenerateMetricsConfigurations(in []v1beta1.MetricsConfiguration) []types.MetricsConfiguration {
+	result := make([]types.MetricsConfiguration, len(in))
+	for i, local := range in {
+		result[i] = types.MetricsConfiguration{
+			Id:     awsclient.String(local.ID),
+			Filter: generateMetricsFilter(local.Filter),
+		}
+	}
+	return result
+}
+
+func generateMetricsFilter(in *v1beta1.MetricsFilter) types.MetricsFilter {
+	switch {
+	case in == nil:
+		return nil
+	case in.And != nil:
+		return &types.MetricsFilterMemberAnd{Value: types.MetricsAndOperator{
+			AccessPointArn: in.And.AccessPointARN,
+			Prefix:         in.And.Prefix,
+			Tags:           s3.SortS3TagSet(s3.CopyTags(in.And.Tags)),
+		}}
+	case in.Tag != nil:
+		return &types.MetricsFilterMemberTag{Value: types.Tag{Key: awsclient.String(in.Tag.Key), Value: awsclient.String(in.Tag.Value)}}
+	case in.Prefix != nil:
+		return &types.MetricsFilterMemberPrefix{Value: *in.Prefix}
+	case in.AccessPointARN != nil:
+		return &types.MetricsFilterMemberAccessPointArn{Value: *in.AccessPointARN}
+	}
+	return nil
+}
+
+// GenerateLocalMetricsConfigurations creates the local metrics configurations
+// from the AWS SDK MetricsConfigurations.
+func GenerateLocalMetricsConfigurations(in []types.MetricsConfiguration) []v1beta1.MetricsConfiguration {
+	if len(in) == 0 {
+		return nil
+	}
+	result := make([]v1beta1.MetricsConfiguration, len(in))
+	for i, external := range in {
+		result[i] = v1beta1.MetricsConfiguration{ID: awsclient.StringValue(external.Id)}
+		switch v := external.Filter.(type) {
+		case *types.MetricsFilterMemberAnd:
+			result[i].Filter = &v1beta1.MetricsFilter{And: &v1beta1.MetricsAndOperator{
+				AccessPointARN: v.Value.AccessPointArn,
+				Prefix:         v.Value.Prefix,
+				Tags:           s3.CopyAWSTags(v.Value.Tags),
+			}}
+		case *types.MetricsFilterMemberAccessPointArn:
+			result[i].Filter = &v1beta1.MetricsFilter{AccessPointARN: awsclient.String(v.Value)}
+		case *types.MetricsFilterMemberPrefix:
+			result[i].Filter = &v1beta1.MetricsFilter{Prefix: awsclient.String(v.Value)}
+		case *types.MetricsFilterMemberTag:
+			result[i].Filter = &v1beta1.MetricsFilter{Tag: &v1beta1.Tag{
+				Key:   awsclient.StringValue(v.Value.Key),
+				Value: awsclient.StringValue(v.Value.Value),
+			}}
+		}
+	}
+	return result
+}
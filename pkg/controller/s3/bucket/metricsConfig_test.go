@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+var _ SubresourceClient = &MetricsConfigurationClient{}
+
+var accessPointARN = "arn:aws:s3:us-east-1:123456789012:accesspoint/test"
+
+func listMetrics(c ...s3types.MetricsConfiguration) func(context.Context, *s3.ListBucketMetricsConfigurationsInput, []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+	return func(_ context.Context, _ *s3.ListBucketMetricsConfigurationsInput, _ []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+		return &s3.ListBucketMetricsConfigurationsOutput{MetricsConfigurationList: c}, nil
+	}
+}
+
+func TestMetricsObserve(t *testing.T) {
+	all := v1beta1.MetricsConfiguration{ID: "all"}
+	ap := v1beta1.MetricsConfiguration{ID: "ap", Filter: &v1beta1.MetricsFilter{AccessPointARN: &accessPointARN}}
+	and := v1beta1.MetricsConfiguration{ID: "and", Filter: &v1beta1.MetricsFilter{And: &v1beta1.MetricsAndOperator{Prefix: &prefix, Tags: tags}}}
+
+	type args struct {
+		cl *MetricsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(all)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: func(_ context.Context, _ *s3.ListBucketMetricsConfigurationsInput, _ []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, metricsListFailed),
+			},
+		},
+		"EmptySpecUnmanaged": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{MockListBucketMetricsConfigurations: listMetrics(s3types.MetricsConfiguration{Id: awsclient.String("all")})}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(ap)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{MockListBucketMetricsConfigurations: listMetrics(s3types.MetricsConfiguration{
+					Id:     awsclient.String("ap"),
+					Filter: &s3types.MetricsFilterMemberPrefix{Value: prefix},
+				})}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NoUpdateExists": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(all, ap, and)),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{MockListBucketMetricsConfigurations: listMetrics(
+					s3types.MetricsConfiguration{Id: awsclient.String("all")},
+					s3types.MetricsConfiguration{Id: awsclient.String("ap"), Filter: &s3types.MetricsFilterMemberAccessPointArn{Value: accessPointARN}},
+					s3types.MetricsConfiguration{Id: awsclient.String("and"), Filter: &s3types.MetricsFilterMemberAnd{Value: s3types.MetricsAndOperator{Prefix: &prefix, Tags: awsTags}}},
+				)}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMetricsCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *MetricsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutError": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(v1beta1.MetricsConfiguration{ID: "all"})),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: listMetrics(),
+					MockPutBucketMetricsConfiguration: func(_ context.Context, _ *s3.PutBucketMetricsConfigurationInput, _ []func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, metricsPutFailed),
+			},
+		},
+		"Successful": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(v1beta1.MetricsConfiguration{ID: "all"})),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: listMetrics(s3types.MetricsConfiguration{Id: awsclient.String("other")}),
+					MockPutBucketMetricsConfiguration: func(_ context.Context, _ *s3.PutBucketMetricsConfigurationInput, _ []func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error) {
+						return &s3.PutBucketMetricsConfigurationOutput{}, nil
+					},
+					MockDeleteBucketMetricsConfiguration: func(_ context.Context, _ *s3.DeleteBucketMetricsConfigurationInput, _ []func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error) {
+						return &s3.DeleteBucketMetricsConfigurationOutput{}, nil
+					},
+				}),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
@@ -35,18 +36,37 @@ import (
 )
 
 const (
-	notificationGetFailed = "cannot get Bucket notification"
-	notificationPutFailed = "cannot put Bucket notification"
+	notificationGetFailed          = "cannot get Bucket notification"
+	notificationPutFailed          = "cannot put Bucket notification"
+	notificationTargetPolicyFailed = "cannot allow Bucket to publish notifications to target"
+	notificationTargetGetFailed    = "cannot check whether Bucket may publish notifications to target"
 )
 
+// A NotificationConfigurationOption configures a
+// NotificationConfigurationClient.
+type NotificationConfigurationOption func(*NotificationConfigurationClient)
+
+// WithTargetPolicyClient lets the NotificationConfigurationClient manage the
+// policies of notification targets of Buckets that ask it to.
+func WithTargetPolicyClient(t TargetPolicyClient) NotificationConfigurationOption {
+	return func(c *NotificationConfigurationClient) {
+		c.targets = t
+	}
+}
+
 // NotificationConfigurationClient is the client for API methods and reconciling the LifecycleConfiguration
 type NotificationConfigurationClient struct {
-	client s3.BucketClient
+	client  s3.BucketClient
+	targets TargetPolicyClient
 }
 
 // NewNotificationConfigurationClient creates the client for Accelerate Configuration
-func NewNotificationConfigurationClient(client s3.BucketClient) *NotificationConfigurationClient {
-	return &NotificationConfigurationClient{client: client}
+func NewNotificationConfigurationClient(client s3.BucketClient, opts ...NotificationConfigurationOption) *NotificationConfigurationClient {
+	c := &NotificationConfigurationClient{client: client}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
 }
 
 // Observe checks if the resource exists and if it matches the local configuration
@@ -56,7 +76,32 @@ func (in *NotificationConfigurationClient) Observe(ctx context.Context, bucket *
 		return NeedsUpdate, awsclient.Wrap(err, notificationGetFailed)
 	}
 
-	return IsNotificationConfigurationUpToDate(bucket.Spec.ForProvider.NotificationConfiguration, external)
+	status, err := IsNotificationConfigurationUpToDate(bucket.Spec.ForProvider.NotificationConfiguration, external)
+	if err != nil || status != Updated {
+		return status, err
+	}
+	return in.observeTargets(ctx, bucket)
+}
+
+// observeTargets returns NeedsUpdate if the supplied Bucket manages the
+// policies of its notification targets and one of them no longer allows it
+// to publish notifications, e.g. because it was changed outside Crossplane.
+func (in *NotificationConfigurationClient) observeTargets(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	config := bucket.Spec.ForProvider.NotificationConfiguration
+	if config == nil || !awsclient.BoolValue(config.ManageTargetPolicies) || in.targets == nil {
+		return Updated, nil
+	}
+	bucketARN := s3.GenerateBucketObservation(meta.GetExternalName(bucket)).ARN
+	for _, target := range notificationTargets(config) {
+		allowed, err := in.targets.IsBucketAllowed(ctx, bucketARN, target)
+		if err != nil {
+			return NeedsUpdate, errors.Wrap(err, notificationTargetGetFailed)
+		}
+		if !allowed {
+			return NeedsUpdate, nil
+		}
+	}
+	return Updated, nil
 }
 
 // IsNotificationConfigurationUpToDate determines whether a notification configuration needs to be updated
@@ -242,14 +287,39 @@ func GenerateNotificationConfigurationInput(name string, config *v1beta1.Notific
 
 // CreateOrUpdate sends a request to have resource created on AWS
 func (in *NotificationConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
-	if bucket.Spec.ForProvider.NotificationConfiguration == nil {
+	config := bucket.Spec.ForProvider.NotificationConfiguration
+	if config == nil {
 		return nil
 	}
-	input := GenerateNotificationConfigurationInput(meta.GetExternalName(bucket), bucket.Spec.ForProvider.NotificationConfiguration)
+	if awsclient.BoolValue(config.ManageTargetPolicies) && in.targets != nil {
+		bucketARN := s3.GenerateBucketObservation(meta.GetExternalName(bucket)).ARN
+		for _, target := range notificationTargets(config) {
+			if err := in.targets.AllowBucket(ctx, bucketARN, target); err != nil {
+				return errors.Wrap(err, notificationTargetPolicyFailed)
+			}
+		}
+	}
+	input := GenerateNotificationConfigurationInput(meta.GetExternalName(bucket), config)
 	_, err := in.client.PutBucketNotificationConfiguration(ctx, input)
 	return awsclient.Wrap(err, notificationPutFailed)
 }
 
+// notificationTargets returns the ARNs of the queues, topics and functions
+// the supplied configuration publishes to.
+func notificationTargets(config *v1beta1.NotificationConfiguration) []string {
+	targets := make([]string, 0, len(config.QueueConfigurations)+len(config.TopicConfigurations)+len(config.LambdaFunctionConfigurations))
+	for _, c := range config.QueueConfigurations {
+		targets = append(targets, c.QueueArn)
+	}
+	for _, c := range config.TopicConfigurations {
+		targets = append(targets, awsclient.StringValue(c.TopicArn))
+	}
+	for _, c := range config.LambdaFunctionConfigurations {
+		targets = append(targets, c.LambdaFunctionArn)
+	}
+	return targets
+}
+
 // Delete does nothing because there is no corresponding deletion call in awsclient.
 func (*NotificationConfigurationClient) Delete(_ context.Context, _ *v1beta1.Bucket) error {
 	return nil
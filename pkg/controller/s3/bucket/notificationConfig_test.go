@@ -24,6 +24,7 @@ import (
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
@@ -128,6 +129,16 @@ func TestNotificationObserve(t *testing.T) {
 		err    error
 	}
 
+	upToDate := fake.MockBucketClient{
+		MockGetBucketNotificationConfiguration: func(ctx context.Context, input *s3.GetBucketNotificationConfigurationInput, opts []func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+			return &s3.GetBucketNotificationConfigurationOutput{
+				LambdaFunctionConfigurations: generateAWSNotification().LambdaFunctionConfigurations,
+				QueueConfigurations:          generateAWSNotification().QueueConfigurations,
+				TopicConfigurations:          generateAWSNotification().TopicConfigurations,
+			}, nil
+		},
+	}
+
 	cases := map[string]struct {
 		args
 		want
@@ -209,6 +220,46 @@ func TestNotificationObserve(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"TargetsAllowed": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithNotificationConfig(generateManagedNotificationConfig())),
+				cl: NewNotificationConfigurationClient(upToDate, WithTargetPolicyClient(&mockTargetPolicyClient{
+					MockIsBucketAllowed: func(ctx context.Context, bucketARN, targetARN string) (bool, error) {
+						return true, nil
+					},
+				})),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"TargetNotAllowed": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithNotificationConfig(generateManagedNotificationConfig())),
+				cl: NewNotificationConfigurationClient(upToDate, WithTargetPolicyClient(&mockTargetPolicyClient{
+					MockIsBucketAllowed: func(ctx context.Context, bucketARN, targetARN string) (bool, error) {
+						return targetARN != topicArn, nil
+					},
+				})),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"TargetError": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithNotificationConfig(generateManagedNotificationConfig())),
+				cl: NewNotificationConfigurationClient(upToDate, WithTargetPolicyClient(&mockTargetPolicyClient{
+					MockIsBucketAllowed: func(ctx context.Context, bucketARN, targetARN string) (bool, error) {
+						return false, errBoom
+					},
+				})),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errors.Wrap(errBoom, notificationTargetGetFailed),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -224,6 +275,25 @@ func TestNotificationObserve(t *testing.T) {
 	}
 }
 
+type mockTargetPolicyClient struct {
+	MockIsBucketAllowed func(ctx context.Context, bucketARN, targetARN string) (bool, error)
+	MockAllowBucket     func(ctx context.Context, bucketARN, targetARN string) error
+}
+
+func (m *mockTargetPolicyClient) IsBucketAllowed(ctx context.Context, bucketARN, targetARN string) (bool, error) {
+	return m.MockIsBucketAllowed(ctx, bucketARN, targetARN)
+}
+
+func (m *mockTargetPolicyClient) AllowBucket(ctx context.Context, bucketARN, targetARN string) error {
+	return m.MockAllowBucket(ctx, bucketARN, targetARN)
+}
+
+func generateManagedNotificationConfig() *v1beta1.NotificationConfiguration {
+	c := generateNotificationConfig()
+	c.ManageTargetPolicies = awsclient.Bool(true)
+	return c
+}
+
 func TestNotificationCreateOrUpdate(t *testing.T) {
 	type args struct {
 		cl *NotificationConfigurationClient
@@ -277,6 +347,42 @@ func TestNotificationCreateOrUpdate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"ManageTargetPolicies": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithNotificationConfig(generateManagedNotificationConfig())),
+				cl: NewNotificationConfigurationClient(fake.MockBucketClient{
+					MockPutBucketNotificationConfiguration: func(ctx context.Context, input *s3.PutBucketNotificationConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketNotificationConfigurationOutput, error) {
+						return &s3.PutBucketNotificationConfigurationOutput{}, nil
+					},
+				}, WithTargetPolicyClient(&mockTargetPolicyClient{
+					MockAllowBucket: func(ctx context.Context, bucketARN, targetARN string) error {
+						if bucketARN != "arn:aws:s3:::"+s3testing.BucketName {
+							return errors.Errorf("unexpected bucket %s", bucketARN)
+						}
+						if targetARN != queueArn && targetARN != topicArn && targetARN != lambdaArn {
+							return errors.Errorf("unexpected target %s", targetARN)
+						}
+						return nil
+					},
+				})),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"ManageTargetPoliciesError": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithNotificationConfig(generateManagedNotificationConfig())),
+				cl: NewNotificationConfigurationClient(fake.MockBucketClient{}, WithTargetPolicyClient(&mockTargetPolicyClient{
+					MockAllowBucket: func(ctx context.Context, bucketARN, targetARN string) error {
+						return errBoom
+					},
+				})),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, notificationTargetPolicyFailed),
+			},
+		},
 	}
 
 	for name, tc := range cases {
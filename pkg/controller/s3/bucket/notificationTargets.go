@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	sqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/pkg/errors"
+
+	policyv1alpha1 "github.com/crossplane/provider-aws/apis/policy/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
+	snsclient "github.com/crossplane/provider-aws/pkg/clients/sns"
+	sqsclient "github.com/crossplane/provider-aws/pkg/clients/sqs"
+)
+
+const (
+	targetParseARNFailed       = "cannot parse notification target ARN"
+	targetUnsupported          = "unsupported notification target service"
+	targetGetQueueURLFailed    = "cannot get URL of notification target queue"
+	targetGetQueuePolicyFailed = "cannot get policy of notification target queue"
+	targetSetQueuePolicyFailed = "cannot set policy of notification target queue"
+	targetGetTopicPolicyFailed = "cannot get policy of notification target topic"
+	targetSetTopicPolicyFailed = "cannot set policy of notification target topic"
+	targetGetFuncPolicyFailed  = "cannot get policy of notification target function"
+	targetParseFuncPolicy      = "cannot parse policy of notification target function"
+	targetAddFuncPermFailed    = "cannot add permission to notification target function"
+
+	// s3Principal is the service principal Amazon S3 publishes bucket
+	// notifications as.
+	s3Principal = "s3.amazonaws.com"
+
+	topicAttributePolicy = "Policy"
+)
+
+// A TargetPolicyClient allows Buckets to publish notifications to SQS
+// queues, SNS topics and Lambda functions.
+type TargetPolicyClient interface {
+	IsBucketAllowed(ctx context.Context, bucketARN, targetARN string) (bool, error)
+	AllowBucket(ctx context.Context, bucketARN, targetARN string) error
+}
+
+// NotificationTargetPolicyClient allows Buckets to publish notifications to
+// targets by adding a statement to the resource policy of the targets.
+type NotificationTargetPolicyClient struct {
+	queue  sqsclient.Client
+	topic  snsclient.TopicClient
+	lambda lambdaiface.LambdaAPI
+}
+
+// NewNotificationTargetPolicyClient returns a NotificationTargetPolicyClient
+// that uses the supplied clients.
+func NewNotificationTargetPolicyClient(queue sqsclient.Client, topic snsclient.TopicClient, lambda lambdaiface.LambdaAPI) *NotificationTargetPolicyClient {
+	return &NotificationTargetPolicyClient{queue: queue, topic: topic, lambda: lambda}
+}
+
+// IsBucketAllowed returns true if the policy of the supplied target allows
+// the supplied bucket to publish notifications to it.
+func (c *NotificationTargetPolicyClient) IsBucketAllowed(ctx context.Context, bucketARN, targetARN string) (bool, error) {
+	return c.allow(ctx, bucketARN, targetARN, false)
+}
+
+// AllowBucket allows the supplied bucket to publish notifications to the
+// supplied target. It does nothing if the policy of the target already
+// allows it.
+func (c *NotificationTargetPolicyClient) AllowBucket(ctx context.Context, bucketARN, targetARN string) error {
+	_, err := c.allow(ctx, bucketARN, targetARN, true)
+	return err
+}
+
+// allow returns true if the supplied bucket is allowed to publish
+// notifications to the supplied target, after allowing it if apply is true.
+func (c *NotificationTargetPolicyClient) allow(ctx context.Context, bucketARN, targetARN string, apply bool) (bool, error) {
+	a, err := arn.Parse(targetARN)
+	if err != nil {
+		return false, errors.Wrap(err, targetParseARNFailed)
+	}
+	switch a.Service {
+	case "sqs":
+		return c.allowQueue(ctx, bucketARN, a, apply)
+	case "sns":
+		return c.allowTopic(ctx, bucketARN, targetARN, apply)
+	case "lambda":
+		return c.allowFunction(ctx, bucketARN, targetARN, apply)
+	}
+	return false, errors.Errorf("%s: %s", targetUnsupported, a.Service)
+}
+
+func (c *NotificationTargetPolicyClient) allowQueue(ctx context.Context, bucketARN string, target arn.ARN, apply bool) (bool, error) {
+	u, err := c.queue.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
+		QueueName:              aws.String(target.Resource),
+		QueueOwnerAWSAccountId: aws.String(target.AccountID),
+	})
+	if err != nil {
+		return false, awsclient.Wrap(err, targetGetQueueURLFailed)
+	}
+	attrs, err := c.queue.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       u.QueueUrl,
+		AttributeNames: []sqstypes.QueueAttributeName{sqstypes.QueueAttributeNamePolicy},
+	})
+	if err != nil {
+		return false, awsclient.Wrap(err, targetGetQueuePolicyFailed)
+	}
+	doc, changed, err := policyclient.SetStatement(attrs.Attributes[string(sqstypes.QueueAttributeNamePolicy)], TargetStatement(bucketARN, target.String(), "sqs:SendMessage"))
+	if err != nil || !changed || !apply {
+		return !changed, err
+	}
+	_, err = c.queue.SetQueueAttributes(ctx, &sqs.SetQueueAttributesInput{
+		QueueUrl:   u.QueueUrl,
+		Attributes: map[string]string{string(sqstypes.QueueAttributeNamePolicy): doc},
+	})
+	return err == nil, awsclient.Wrap(err, targetSetQueuePolicyFailed)
+}
+
+func (c *NotificationTargetPolicyClient) allowTopic(ctx context.Context, bucketARN, targetARN string, apply bool) (bool, error) {
+	attrs, err := c.topic.GetTopicAttributes(ctx, &sns.GetTopicAttributesInput{TopicArn: aws.String(targetARN)})
+	if err != nil {
+		return false, awsclient.Wrap(err, targetGetTopicPolicyFailed)
+	}
+	doc, changed, err := policyclient.SetStatement(attrs.Attributes[topicAttributePolicy], TargetStatement(bucketARN, targetARN, "sns:Publish"))
+	if err != nil || !changed || !apply {
+		return !changed, err
+	}
+	_, err = c.topic.SetTopicAttributes(ctx, &sns.SetTopicAttributesInput{
+		TopicArn:       aws.String(targetARN),
+		AttributeName:  aws.String(topicAttributePolicy),
+		AttributeValue: aws.String(doc),
+	})
+	return err == nil, awsclient.Wrap(err, targetSetTopicPolicyFailed)
+}
+
+// allowFunction adds a permission to the supplied function unless its policy
+// already has a statement with the Sid the permission would get. Lambda
+// permissions cannot be updated, and their Sid is derived from the ARN of
+// the bucket, so an existing statement is assumed to be equivalent.
+func (c *NotificationTargetPolicyClient) allowFunction(ctx context.Context, bucketARN, targetARN string, apply bool) (bool, error) {
+	sid := targetStatementSID(bucketARN)
+	p, err := c.lambda.GetPolicyWithContext(ctx, &lambda.GetPolicyInput{FunctionName: awsv1.String(targetARN)})
+	if err != nil && !isLambdaNotFound(err) {
+		return false, awsclient.Wrap(err, targetGetFuncPolicyFailed)
+	}
+	if err == nil && awsv1.StringValue(p.Policy) != "" {
+		doc := struct {
+			Statement []struct {
+				Sid string
+			}
+		}{}
+		if err := json.Unmarshal([]byte(awsv1.StringValue(p.Policy)), &doc); err != nil {
+			return false, errors.Wrap(err, targetParseFuncPolicy)
+		}
+		for _, s := range doc.Statement {
+			if s.Sid == sid {
+				return true, nil
+			}
+		}
+	}
+	if !apply {
+		return false, nil
+	}
+	_, err = c.lambda.AddPermissionWithContext(ctx, &lambda.AddPermissionInput{
+		FunctionName: awsv1.String(targetARN),
+		StatementId:  awsv1.String(sid),
+		Action:       awsv1.String("lambda:InvokeFunction"),
+		Principal:    awsv1.String(s3Principal),
+		SourceArn:    awsv1.String(bucketARN),
+	})
+	return err == nil, awsclient.Wrap(err, targetAddFuncPermFailed)
+}
+
+func isLambdaNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == lambda.ErrCodeResourceNotFoundException
+}
+
+// TargetStatement returns a statement that allows the supplied bucket to
+// perform the supplied action on the supplied notification target.
+func TargetStatement(bucketARN, targetARN, action string) policyv1alpha1.Statement {
+	return policyv1alpha1.Statement{
+		SID:       aws.String(targetStatementSID(bucketARN)),
+		Effect:    "Allow",
+		Principal: &policyv1alpha1.Principal{Service: []string{s3Principal}},
+		Action:    []string{action},
+		Resource:  []string{targetARN},
+		Condition: []policyv1alpha1.Condition{{
+			OperatorKey: "ArnLike",
+			Conditions: []policyv1alpha1.ConditionPair{{
+				ConditionKey:         "aws:SourceArn",
+				ConditionStringValue: aws.String(bucketARN),
+			}},
+		}},
+	}
+}
+
+// targetStatementSID returns the Sid of the statements that allow the
+// supplied bucket to publish notifications. Sids may only contain
+// alphanumeric characters, so the ARN is hashed rather than included. The
+// controllers of queues and topics ignore statements with this prefix.
+func targetStatementSID(bucketARN string) string {
+	h := sha256.Sum256([]byte(bucketARN))
+	return policyclient.BucketNotificationSIDPrefix + hex.EncodeToString(h[:8])
+}
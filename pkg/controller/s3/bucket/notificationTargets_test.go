@@ -0,0 +1,358 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsrequest "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
+	snsfake "github.com/crossplane/provider-aws/pkg/clients/sns/fake"
+	sqsfake "github.com/crossplane/provider-aws/pkg/clients/sqs/fake"
+)
+
+var (
+	targetBucketARN = "arn:aws:s3:::source"
+	targetQueueARN  = "arn:aws:sqs:us-east-1:111122223333:target"
+	targetTopicARN  = "arn:aws:sns:us-east-1:111122223333:target"
+	targetFuncARN   = "arn:aws:lambda:us-east-1:111122223333:function:target"
+	targetQueueURL  = "https://sqs.us-east-1.amazonaws.com/111122223333/target"
+
+	_ TargetPolicyClient = &NotificationTargetPolicyClient{}
+)
+
+type mockLambdaClient struct {
+	lambdaiface.LambdaAPI
+
+	MockGetPolicy     func(*lambda.GetPolicyInput) (*lambda.GetPolicyOutput, error)
+	MockAddPermission func(*lambda.AddPermissionInput) (*lambda.AddPermissionOutput, error)
+}
+
+func (m *mockLambdaClient) GetPolicyWithContext(_ awsv1.Context, in *lambda.GetPolicyInput, _ ...awsrequest.Option) (*lambda.GetPolicyOutput, error) {
+	return m.MockGetPolicy(in)
+}
+
+func (m *mockLambdaClient) AddPermissionWithContext(_ awsv1.Context, in *lambda.AddPermissionInput, _ ...awsrequest.Option) (*lambda.AddPermissionOutput, error) {
+	return m.MockAddPermission(in)
+}
+
+// targetPolicy returns a policy that allows the source bucket to perform the
+// supplied action on the supplied target.
+func targetPolicy(t *testing.T, targetARN, action string) string {
+	t.Helper()
+	doc, _, err := policyclient.SetStatement("", TargetStatement(targetBucketARN, targetARN, action))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestAllowBucket(t *testing.T) {
+	type args struct {
+		queue     *sqsfake.MockSQSClient
+		topic     *snsfake.MockTopicClient
+		lambda    *mockLambdaClient
+		targetARN string
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidARN": {
+			args: args{
+				targetARN: "queue",
+			},
+			want: want{
+				err: errors.Wrap(errors.New("arn: invalid prefix"), targetParseARNFailed),
+			},
+		},
+		"UnsupportedService": {
+			args: args{
+				targetARN: "arn:aws:s3:::target",
+			},
+			want: want{
+				err: errors.Errorf("%s: %s", targetUnsupported, "s3"),
+			},
+		},
+		"QueueAllowed": {
+			args: args{
+				queue: &sqsfake.MockSQSClient{
+					MockGetQueueURL: func(_ context.Context, in *sqs.GetQueueUrlInput, _ []func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error) {
+						if aws.ToString(in.QueueName) != "target" || aws.ToString(in.QueueOwnerAWSAccountId) != "111122223333" {
+							return nil, errBoom
+						}
+						return &sqs.GetQueueUrlOutput{QueueUrl: aws.String(targetQueueURL)}, nil
+					},
+					MockGetQueueAttributes: func(_ context.Context, _ *sqs.GetQueueAttributesInput, _ []func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+						return &sqs.GetQueueAttributesOutput{Attributes: map[string]string{}}, nil
+					},
+					MockSetQueueAttributes: func(_ context.Context, in *sqs.SetQueueAttributesInput, _ []func(*sqs.Options)) (*sqs.SetQueueAttributesOutput, error) {
+						if !policyclient.Equal(in.Attributes["Policy"], targetPolicy(t, targetQueueARN, "sqs:SendMessage")) {
+							return nil, errors.Errorf("unexpected policy %s", in.Attributes["Policy"])
+						}
+						return &sqs.SetQueueAttributesOutput{}, nil
+					},
+				},
+				targetARN: targetQueueARN,
+			},
+		},
+		"QueueAlreadyAllowed": {
+			args: args{
+				queue: &sqsfake.MockSQSClient{
+					MockGetQueueURL: func(_ context.Context, _ *sqs.GetQueueUrlInput, _ []func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error) {
+						return &sqs.GetQueueUrlOutput{QueueUrl: aws.String(targetQueueURL)}, nil
+					},
+					MockGetQueueAttributes: func(_ context.Context, _ *sqs.GetQueueAttributesInput, _ []func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+						return &sqs.GetQueueAttributesOutput{Attributes: map[string]string{"Policy": targetPolicy(t, targetQueueARN, "sqs:SendMessage")}}, nil
+					},
+				},
+				targetARN: targetQueueARN,
+			},
+		},
+		"QueueGetAttributesError": {
+			args: args{
+				queue: &sqsfake.MockSQSClient{
+					MockGetQueueURL: func(_ context.Context, _ *sqs.GetQueueUrlInput, _ []func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error) {
+						return &sqs.GetQueueUrlOutput{QueueUrl: aws.String(targetQueueURL)}, nil
+					},
+					MockGetQueueAttributes: func(_ context.Context, _ *sqs.GetQueueAttributesInput, _ []func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				targetARN: targetQueueARN,
+			},
+			want: want{
+				err: errors.Wrap(errBoom, targetGetQueuePolicyFailed),
+			},
+		},
+		"TopicAllowed": {
+			args: args{
+				topic: &snsfake.MockTopicClient{
+					MockGetTopicAttributes: func(_ context.Context, _ *sns.GetTopicAttributesInput, _ []func(*sns.Options)) (*sns.GetTopicAttributesOutput, error) {
+						return &sns.GetTopicAttributesOutput{Attributes: map[string]string{
+							"Policy": `{"Version":"2008-10-17","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":"SNS:Publish","Resource":"` + targetTopicARN + `"}]}`,
+						}}, nil
+					},
+					MockSetTopicAttributes: func(_ context.Context, in *sns.SetTopicAttributesInput, _ []func(*sns.Options)) (*sns.SetTopicAttributesOutput, error) {
+						want := `{"Version":"2008-10-17","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":"SNS:Publish","Resource":"` + targetTopicARN + `"},` +
+							`{"Sid":"` + targetStatementSID(targetBucketARN) + `","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"sns:Publish","Resource":"` + targetTopicARN + `",` +
+							`"Condition":{"ArnLike":{"aws:SourceArn":"` + targetBucketARN + `"}}}]}`
+						if !policyclient.Equal(aws.ToString(in.AttributeValue), want) {
+							return nil, errors.Errorf("unexpected policy %s", aws.ToString(in.AttributeValue))
+						}
+						return &sns.SetTopicAttributesOutput{}, nil
+					},
+				},
+				targetARN: targetTopicARN,
+			},
+		},
+		"TopicSetAttributesError": {
+			args: args{
+				topic: &snsfake.MockTopicClient{
+					MockGetTopicAttributes: func(_ context.Context, _ *sns.GetTopicAttributesInput, _ []func(*sns.Options)) (*sns.GetTopicAttributesOutput, error) {
+						return &sns.GetTopicAttributesOutput{Attributes: map[string]string{}}, nil
+					},
+					MockSetTopicAttributes: func(_ context.Context, _ *sns.SetTopicAttributesInput, _ []func(*sns.Options)) (*sns.SetTopicAttributesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				targetARN: targetTopicARN,
+			},
+			want: want{
+				err: errors.Wrap(errBoom, targetSetTopicPolicyFailed),
+			},
+		},
+		"FunctionAllowed": {
+			args: args{
+				lambda: &mockLambdaClient{
+					MockGetPolicy: func(_ *lambda.GetPolicyInput) (*lambda.GetPolicyOutput, error) {
+						return nil, awserr.New(lambda.ErrCodeResourceNotFoundException, "", nil)
+					},
+					MockAddPermission: func(in *lambda.AddPermissionInput) (*lambda.AddPermissionOutput, error) {
+						if awsv1.StringValue(in.SourceArn) != targetBucketARN || awsv1.StringValue(in.StatementId) != targetStatementSID(targetBucketARN) {
+							return nil, errors.Errorf("unexpected permission %s", in.String())
+						}
+						return &lambda.AddPermissionOutput{}, nil
+					},
+				},
+				targetARN: targetFuncARN,
+			},
+		},
+		"FunctionAlreadyAllowed": {
+			args: args{
+				lambda: &mockLambdaClient{
+					MockGetPolicy: func(_ *lambda.GetPolicyInput) (*lambda.GetPolicyOutput, error) {
+						return &lambda.GetPolicyOutput{Policy: awsv1.String(`{"Version":"2012-10-17","Statement":[{"Sid":"` + targetStatementSID(targetBucketARN) + `"}]}`)}, nil
+					},
+				},
+				targetARN: targetFuncARN,
+			},
+		},
+		"FunctionGetPolicyError": {
+			args: args{
+				lambda: &mockLambdaClient{
+					MockGetPolicy: func(_ *lambda.GetPolicyInput) (*lambda.GetPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				targetARN: targetFuncARN,
+			},
+			want: want{
+				err: errors.Wrap(errBoom, targetGetFuncPolicyFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewNotificationTargetPolicyClient(tc.args.queue, tc.args.topic, tc.args.lambda)
+			err := c.AllowBucket(context.Background(), targetBucketARN, tc.args.targetARN)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsBucketAllowed(t *testing.T) {
+	type args struct {
+		queue     *sqsfake.MockSQSClient
+		topic     *snsfake.MockTopicClient
+		lambda    *mockLambdaClient
+		targetARN string
+	}
+
+	type want struct {
+		allowed bool
+		err     error
+	}
+
+	// The mocks do not implement the calls that change policies, so that
+	// any attempt to allow the bucket fails the test.
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"QueueAllowed": {
+			args: args{
+				queue: &sqsfake.MockSQSClient{
+					MockGetQueueURL: func(_ context.Context, _ *sqs.GetQueueUrlInput, _ []func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error) {
+						return &sqs.GetQueueUrlOutput{QueueUrl: aws.String(targetQueueURL)}, nil
+					},
+					MockGetQueueAttributes: func(_ context.Context, _ *sqs.GetQueueAttributesInput, _ []func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+						return &sqs.GetQueueAttributesOutput{Attributes: map[string]string{"Policy": targetPolicy(t, targetQueueARN, "sqs:SendMessage")}}, nil
+					},
+				},
+				targetARN: targetQueueARN,
+			},
+			want: want{
+				allowed: true,
+			},
+		},
+		"QueueNotAllowed": {
+			args: args{
+				queue: &sqsfake.MockSQSClient{
+					MockGetQueueURL: func(_ context.Context, _ *sqs.GetQueueUrlInput, _ []func(*sqs.Options)) (*sqs.GetQueueUrlOutput, error) {
+						return &sqs.GetQueueUrlOutput{QueueUrl: aws.String(targetQueueURL)}, nil
+					},
+					MockGetQueueAttributes: func(_ context.Context, _ *sqs.GetQueueAttributesInput, _ []func(*sqs.Options)) (*sqs.GetQueueAttributesOutput, error) {
+						return &sqs.GetQueueAttributesOutput{Attributes: map[string]string{}}, nil
+					},
+				},
+				targetARN: targetQueueARN,
+			},
+		},
+		"TopicNotAllowed": {
+			args: args{
+				topic: &snsfake.MockTopicClient{
+					MockGetTopicAttributes: func(_ context.Context, _ *sns.GetTopicAttributesInput, _ []func(*sns.Options)) (*sns.GetTopicAttributesOutput, error) {
+						return &sns.GetTopicAttributesOutput{Attributes: map[string]string{
+							"Policy": `{"Version":"2008-10-17","Statement":[{"Sid":"__default_statement_ID","Effect":"Allow","Principal":{"AWS":"*"},"Action":"SNS:Publish","Resource":"` + targetTopicARN + `"}]}`,
+						}}, nil
+					},
+				},
+				targetARN: targetTopicARN,
+			},
+		},
+		"TopicGetAttributesError": {
+			args: args{
+				topic: &snsfake.MockTopicClient{
+					MockGetTopicAttributes: func(_ context.Context, _ *sns.GetTopicAttributesInput, _ []func(*sns.Options)) (*sns.GetTopicAttributesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				targetARN: targetTopicARN,
+			},
+			want: want{
+				err: errors.Wrap(errBoom, targetGetTopicPolicyFailed),
+			},
+		},
+		"FunctionAllowed": {
+			args: args{
+				lambda: &mockLambdaClient{
+					MockGetPolicy: func(_ *lambda.GetPolicyInput) (*lambda.GetPolicyOutput, error) {
+						return &lambda.GetPolicyOutput{Policy: awsv1.String(`{"Version":"2012-10-17","Statement":[{"Sid":"` + targetStatementSID(targetBucketARN) + `"}]}`)}, nil
+					},
+				},
+				targetARN: targetFuncARN,
+			},
+			want: want{
+				allowed: true,
+			},
+		},
+		"FunctionNotAllowed": {
+			args: args{
+				lambda: &mockLambdaClient{
+					MockGetPolicy: func(_ *lambda.GetPolicyInput) (*lambda.GetPolicyOutput, error) {
+						return nil, awserr.New(lambda.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				targetARN: targetFuncARN,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewNotificationTargetPolicyClient(tc.args.queue, tc.args.topic, tc.args.lambda)
+			allowed, err := c.IsBucketAllowed(context.Background(), targetBucketARN, tc.args.targetARN)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.allowed, allowed); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
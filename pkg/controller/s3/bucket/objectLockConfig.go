@@ -0,0 +1,153 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	awss3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+const (
+	objectLockGetFailed = "cannot get Bucket object lock configuration"
+	objectLockPutFailed = "cannot put Bucket object lock configuration"
+)
+
+// ObjectLockConfigurationClient is the client for API methods and reconciling the ObjectLockConfiguration
+type ObjectLockConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewObjectLockConfigurationClient creates the client for Object Lock Configuration
+func NewObjectLockConfigurationClient(client s3.BucketClient) *ObjectLockConfigurationClient {
+	return &ObjectLockConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *ObjectLockConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	external, err := in.client.GetObjectLockConfiguration(ctx, &awss3.GetObjectLockConfigurationInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if resource.Ignore(s3.ObjectLockConfigurationNotFound, err) != nil {
+		return NeedsUpdate, awsclient.Wrap(err, objectLockGetFailed)
+	}
+	if bucket.Spec.ForProvider.ObjectLockConfiguration == nil {
+		return Updated, nil
+	}
+	if err != nil || external.ObjectLockConfiguration == nil {
+		return NeedsUpdate, nil
+	}
+	return CompareObjectLockConfiguration(bucket.Spec.ForProvider.ObjectLockConfiguration, external.ObjectLockConfiguration), nil
+}
+
+// CompareObjectLockConfiguration compares the local and external object lock configurations.
+func CompareObjectLockConfiguration(local *v1beta1.ObjectLockConfiguration, external *awss3types.ObjectLockConfiguration) ResourceStatus {
+	if local.ObjectLockEnabled != nil && aws.ToString(local.ObjectLockEnabled) != string(external.ObjectLockEnabled) {
+		return NeedsUpdate
+	}
+	var lr *v1beta1.DefaultRetention
+	if local.Rule != nil {
+		lr = local.Rule.DefaultRetention
+	}
+	var er *awss3types.DefaultRetention
+	if external.Rule != nil {
+		er = external.Rule.DefaultRetention
+	}
+	switch {
+	case lr == nil && er == nil:
+		return Updated
+	case lr == nil || er == nil:
+		return NeedsUpdate
+	case lr.Mode != string(er.Mode), aws.ToInt32(lr.Days) != er.Days, aws.ToInt32(lr.Years) != er.Years:
+		return NeedsUpdate
+	}
+	return Updated
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *ObjectLockConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	if bucket.Spec.ForProvider.ObjectLockConfiguration == nil {
+		return nil
+	}
+	input := GeneratePutObjectLockConfigurationInput(meta.GetExternalName(bucket), bucket.Spec.ForProvider.ObjectLockConfiguration)
+	_, err := in.client.PutObjectLockConfiguration(ctx, input)
+	return awsclient.Wrap(err, objectLockPutFailed)
+}
+
+// Delete does nothing because Object Lock cannot be disabled once it is
+// enabled for a bucket.
+func (*ObjectLockConfigurationClient) Delete(_ context.Context, _ *v1beta1.Bucket) error {
+	return nil
+}
+
+// LateInitialize is responsible for initializing the resource based on the external value
+func (in *ObjectLockConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	external, err := in.client.GetObjectLockConfiguration(ctx, &awss3.GetObjectLockConfigurationInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(s3.ObjectLockConfigurationNotFound, err), objectLockGetFailed)
+	}
+	if external.ObjectLockConfiguration == nil {
+		return nil
+	}
+
+	if bucket.Spec.ForProvider.ObjectLockConfiguration == nil {
+		bucket.Spec.ForProvider.ObjectLockConfiguration = &v1beta1.ObjectLockConfiguration{}
+	}
+	config := bucket.Spec.ForProvider.ObjectLockConfiguration
+	config.ObjectLockEnabled = awsclient.LateInitializeStringPtr(config.ObjectLockEnabled, awsclient.String(string(external.ObjectLockConfiguration.ObjectLockEnabled)))
+	if config.Rule == nil && external.ObjectLockConfiguration.Rule != nil && external.ObjectLockConfiguration.Rule.DefaultRetention != nil {
+		r := external.ObjectLockConfiguration.Rule.DefaultRetention
+		config.Rule = &v1beta1.ObjectLockRule{DefaultRetention: &v1beta1.DefaultRetention{Mode: string(r.Mode)}}
+		if r.Days != 0 {
+			config.Rule.DefaultRetention.Days = aws.Int32(r.Days)
+		}
+		if r.Years != 0 {
+			config.Rule.DefaultRetention.Years = aws.Int32(r.Years)
+		}
+	}
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *ObjectLockConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return bucket.Spec.ForProvider.ObjectLockConfiguration != nil
+}
+
+// GeneratePutObjectLockConfigurationInput creates the input for the PutObjectLockConfiguration request for the S3 Client
+func GeneratePutObjectLockConfigurationInput(name string, config *v1beta1.ObjectLockConfiguration) *awss3.PutObjectLockConfigurationInput {
+	// Object Lock configurations can only be put with Object Lock enabled.
+	olc := &awss3types.ObjectLockConfiguration{ObjectLockEnabled: awss3types.ObjectLockEnabledEnabled}
+	if config.Rule != nil && config.Rule.DefaultRetention != nil {
+		r := config.Rule.DefaultRetention
+		olc.Rule = &awss3types.ObjectLockRule{DefaultRetention: &awss3types.DefaultRetention{
+			Mode:  awss3types.ObjectLockRetentionMode(r.Mode),
+			Days:  aws.ToInt32(r.Days),
+			Years: aws.ToInt32(r.Years),
+		}}
+	}
+	return &awss3.PutObjectLockConfigurationInput{
+		Bucket:                  awsclient.String(name),
+		ObjectLockConfiguration: olc,
+	}
+}
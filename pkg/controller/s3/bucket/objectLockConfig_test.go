@@ -0,0 +1,308 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	clients3 "github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+func objectLockBucket(c *v1beta1.ObjectLockConfiguration) *v1beta1.Bucket {
+	return &v1beta1.Bucket{Spec: v1beta1.BucketSpec{ForProvider: v1beta1.BucketParameters{ObjectLockConfiguration: c}}}
+}
+
+func governance(days int32) *v1beta1.ObjectLockConfiguration {
+	return &v1beta1.ObjectLockConfiguration{
+		ObjectLockEnabled: aws.String("Enabled"),
+		Rule: &v1beta1.ObjectLockRule{DefaultRetention: &v1beta1.DefaultRetention{
+			Mode: "GOVERNANCE",
+			Days: aws.Int32(days),
+		}},
+	}
+}
+
+func externalGovernance(days int32) *s3.GetObjectLockConfigurationOutput {
+	return &s3.GetObjectLockConfigurationOutput{ObjectLockConfiguration: &s3types.ObjectLockConfiguration{
+		ObjectLockEnabled: s3types.ObjectLockEnabledEnabled,
+		Rule: &s3types.ObjectLockRule{DefaultRetention: &s3types.DefaultRetention{
+			Mode: s3types.ObjectLockRetentionModeGovernance,
+			Days: days,
+		}},
+	}}
+}
+
+func TestObjectLockObserve(t *testing.T) {
+	type args struct {
+		cl *ObjectLockConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: objectLockBucket(nil),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, objectLockGetFailed),
+			},
+		},
+		"NotFoundNotNeeded": {
+			args: args{
+				cr: objectLockBucket(nil),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clients3.ObjectLockNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NotFoundNeedsUpdate": {
+			args: args{
+				cr: objectLockBucket(governance(1)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clients3.ObjectLockNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				cr: objectLockBucket(governance(1)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return externalGovernance(2), nil
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdateRuleRemoved": {
+			args: args{
+				cr: objectLockBucket(&v1beta1.ObjectLockConfiguration{ObjectLockEnabled: aws.String("Enabled")}),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return externalGovernance(1), nil
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Updated": {
+			args: args{
+				cr: objectLockBucket(governance(1)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return externalGovernance(1), nil
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectLockCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *ObjectLockConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Skip": {
+			args: args{
+				cr: objectLockBucket(nil),
+			},
+		},
+		"Error": {
+			args: args{
+				cr: objectLockBucket(governance(1)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockPutObjectLockConfiguration: func(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, objectLockPutFailed),
+			},
+		},
+		"Success": {
+			args: args{
+				cr: objectLockBucket(governance(1)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockPutObjectLockConfiguration: func(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
+						if diff := cmp.Diff(externalGovernance(1).ObjectLockConfiguration, input.ObjectLockConfiguration, cmp.AllowUnexported(s3types.ObjectLockConfiguration{}, s3types.ObjectLockRule{}, s3types.DefaultRetention{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &s3.PutObjectLockConfigurationOutput{}, nil
+					},
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectLockLateInit(t *testing.T) {
+	type args struct {
+		cl *ObjectLockConfigurationClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		cr  *v1beta1.Bucket
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: objectLockBucket(nil),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				cr:  objectLockBucket(nil),
+				err: awsclient.Wrap(errBoom, objectLockGetFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cr: objectLockBucket(nil),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clients3.ObjectLockNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				cr: objectLockBucket(nil),
+			},
+		},
+		"Success": {
+			args: args{
+				cr: objectLockBucket(nil),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return externalGovernance(1), nil
+					},
+				}),
+			},
+			want: want{
+				cr: objectLockBucket(governance(1)),
+			},
+		},
+		"NoOverwrite": {
+			args: args{
+				cr: objectLockBucket(governance(3)),
+				cl: NewObjectLockConfigurationClient(fake.MockBucketClient{
+					MockGetObjectLockConfiguration: func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error) {
+						return externalGovernance(1), nil
+					},
+				}),
+			},
+			want: want{
+				cr: objectLockBucket(governance(3)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	clients3 "github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+func ownershipControlsBucket(rules ...string) *v1beta1.Bucket {
+	cr := &v1beta1.Bucket{}
+	if len(rules) > 0 {
+		cr.Spec.ForProvider.OwnershipControls = &v1beta1.OwnershipControls{}
+		for _, r := range rules {
+			cr.Spec.ForProvider.OwnershipControls.Rules = append(cr.Spec.ForProvider.OwnershipControls.Rules, v1beta1.OwnershipControlsRule{ObjectOwnership: r})
+		}
+	}
+	return cr
+}
+
+func TestOwnershipControlsObserve(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: ownershipControlsBucket(),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    awsclient.Wrap(errBoom, ownershipControlsGetFailed),
+			},
+		},
+		"NotFoundNotNeeded": {
+			args: args{
+				cr: ownershipControlsBucket(),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clients3.OwnershipControlsNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NotFoundNeedsUpdate": {
+			args: args{
+				cr: ownershipControlsBucket("BucketOwnerPreferred"),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clients3.OwnershipControlsNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				cr: ownershipControlsBucket("BucketOwnerPreferred"),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return &s3.GetBucketOwnershipControlsOutput{OwnershipControls: &s3types.OwnershipControls{
+							Rules: []s3types.OwnershipControlsRule{{ObjectOwnership: s3types.ObjectOwnershipObjectWriter}},
+						}}, nil
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"Updated": {
+			args: args{
+				cr: ownershipControlsBucket("BucketOwnerPreferred"),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return &s3.GetBucketOwnershipControlsOutput{OwnershipControls: &s3types.OwnershipControls{
+							Rules: []s3types.OwnershipControlsRule{{ObjectOwnership: s3types.ObjectOwnershipBucketOwnerPreferred}},
+						}}, nil
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NilSpecUnmanaged": {
+			args: args{
+				cr: ownershipControlsBucket(),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return &s3.GetBucketOwnershipControlsOutput{OwnershipControls: &s3types.OwnershipControls{
+							Rules: []s3types.OwnershipControlsRule{{ObjectOwnership: "BucketOwnerEnforced"}},
+						}}, nil
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnershipControlsCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Skip": {
+			args: args{
+				cr: ownershipControlsBucket(),
+			},
+		},
+		"Error": {
+			args: args{
+				cr: ownershipControlsBucket("ObjectWriter"),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockPutBucketOwnershipControls: func(ctx context.Context, input *s3.PutBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, ownershipControlsPutFailed),
+			},
+		},
+		"Success": {
+			args: args{
+				cr: ownershipControlsBucket("ObjectWriter"),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockPutBucketOwnershipControls: func(ctx context.Context, input *s3.PutBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error) {
+						return &s3.PutBucketOwnershipControlsOutput{}, nil
+					},
+				}),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.CreateOrUpdate(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnershipControlsLateInit(t *testing.T) {
+	type args struct {
+		cl *OwnershipControlsClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		cr  *v1beta1.Bucket
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Error": {
+			args: args{
+				cr: ownershipControlsBucket(),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				cr:  ownershipControlsBucket(),
+				err: awsclient.Wrap(errBoom, ownershipControlsGetFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				cr: ownershipControlsBucket(),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: clients3.OwnershipControlsNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				cr: ownershipControlsBucket(),
+			},
+		},
+		"Success": {
+			args: args{
+				cr: ownershipControlsBucket(),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return &s3.GetBucketOwnershipControlsOutput{OwnershipControls: &s3types.OwnershipControls{
+							Rules: []s3types.OwnershipControlsRule{{ObjectOwnership: s3types.ObjectOwnershipBucketOwnerPreferred}},
+						}}, nil
+					},
+				}),
+			},
+			want: want{
+				cr: ownershipControlsBucket("BucketOwnerPreferred"),
+			},
+		},
+		"NoOverwrite": {
+			args: args{
+				cr: ownershipControlsBucket("ObjectWriter"),
+				cl: NewOwnershipControlsClient(fake.MockBucketClient{
+					MockGetBucketOwnershipControls: func(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts []func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error) {
+						return &s3.GetBucketOwnershipControlsOutput{OwnershipControls: &s3types.OwnershipControls{
+							Rules: []s3types.OwnershipControlsRule{{ObjectOwnership: s3types.ObjectOwnershipBucketOwnerPreferred}},
+						}}, nil
+					},
+				}),
+			},
+			want: want{
+				cr: ownershipControlsBucket("ObjectWriter"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -28,26 +28,79 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/s3"
 )
 
 const (
-	replicationGetFailed    = "cannot get replication configuration"
-	replicationPutFailed    = "cannot put Bucket replication"
-	replicationDeleteFailed = "cannot delete Bucket replication"
+	replicationGetFailed                = "cannot get replication configuration"
+	replicationPutFailed                = "cannot put Bucket replication"
+	replicationDeleteFailed             = "cannot delete Bucket replication"
+	replicationInvalid                  = "invalid replication configuration"
+	replicationGetDestinationFailed     = "cannot get replication destination Bucket"
+	replicationGetProviderConfigFailed  = "cannot get ProviderConfig of replication destination Bucket"
+	replicationDestinationAccountFailed = "cannot get account of replication destination Bucket"
+
+	replicationNoRole                     = "role is required"
+	replicationNoRules                    = "at least one rule is required"
+	replicationTooManyRules               = "at most 1000 rules are allowed"
+	replicationDuplicateID                = "rule IDs must be unique"
+	replicationDuplicatePriority          = "rule priorities must be unique"
+	replicationNoDestinationBucket        = "destination bucket is required"
+	replicationNoDeleteMarkerReplication  = "deleteMarkerReplication is required"
+	replicationTagDeleteMarkerReplication = "deleteMarkerReplication cannot be enabled for rules that filter by tags"
+	replicationTimeWithoutMetrics         = "replicationTime requires metrics to be enabled"
+	replicationTimeMinutes                = "replicationTime and metrics eventThreshold must be 15 minutes"
+	replicationOwnerWithoutAccount        = "accessControlTranslation requires the destination account"
+	replicationSSEKMSWithoutKey           = "sseKmsEncryptedObjects requires the destination encryptionConfiguration"
+
+	// maxReplicationRules is the maximum number of rules of a replication
+	// configuration.
+	maxReplicationRules = 1000
+
+	// replicationTimeMinutesValue is the only time S3 Replication Time
+	// Control supports.
+	replicationTimeMinutesValue = 15
 )
 
+// A DestinationAccountFn returns the ID of the AWS account the supplied
+// ProviderConfig authenticates to.
+type DestinationAccountFn func(ctx context.Context, pc *awsv1beta1.ProviderConfig, region string) (string, error)
+
+// A ReplicationConfigurationOption configures a ReplicationConfigurationClient.
+type ReplicationConfigurationOption func(*ReplicationConfigurationClient)
+
+// WithKubeClient lets the ReplicationConfigurationClient read the Buckets
+// replication rules reference, so it can default the account of destination
+// Buckets that use a different ProviderConfig than their source Bucket.
+func WithKubeClient(kube client.Client) ReplicationConfigurationOption {
+	return func(c *ReplicationConfigurationClient) {
+		c.kube = kube
+		c.account = func(ctx context.Context, pc *awsv1beta1.ProviderConfig, region string) (string, error) {
+			return awsclient.GetAccountID(ctx, kube, pc, region)
+		}
+	}
+}
+
 // ReplicationConfigurationClient is the client for API methods and reconciling the ReplicationConfiguration
 type ReplicationConfigurationClient struct {
-	client s3.BucketClient
+	client  s3.BucketClient
+	kube    client.Client
+	account DestinationAccountFn
 }
 
 // NewReplicationConfigurationClient creates the client for Replication Configuration
-func NewReplicationConfigurationClient(client s3.BucketClient) *ReplicationConfigurationClient {
-	return &ReplicationConfigurationClient{client: client}
+func NewReplicationConfigurationClient(client s3.BucketClient, opts ...ReplicationConfigurationOption) *ReplicationConfigurationClient {
+	c := &ReplicationConfigurationClient{client: client}
+	for _, o := range opts {
+		o(c)
+	}
+	return c
 }
 
 // Observe checks if the resource exists and if it matches the local configuration
@@ -70,7 +123,10 @@ func (in *ReplicationConfigurationClient) Observe(ctx context.Context, bucket *v
 		return NeedsDeletion, nil
 	}
 
-	source := GenerateReplicationConfiguration(config)
+	source, err := in.generateReplicationConfiguration(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
 
 	return IsUpToDate(external.ReplicationConfiguration, source)
 }
@@ -89,11 +145,136 @@ func (in *ReplicationConfigurationClient) CreateOrUpdate(ctx context.Context, bu
 	if bucket.Spec.ForProvider.ReplicationConfiguration == nil {
 		return nil
 	}
-	input := GeneratePutBucketReplicationInput(meta.GetExternalName(bucket), bucket.Spec.ForProvider.ReplicationConfiguration)
-	_, err := in.client.PutBucketReplication(ctx, input)
+	config, err := in.generateReplicationConfiguration(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if err := ValidateReplicationConfiguration(config); err != nil {
+		return errors.Wrap(err, replicationInvalid)
+	}
+	input := &awss3.PutBucketReplicationInput{
+		Bucket:                   awsclient.String(meta.GetExternalName(bucket)),
+		ReplicationConfiguration: config,
+	}
+	_, err = in.client.PutBucketReplication(ctx, input)
 	return awsclient.Wrap(err, replicationPutFailed)
 }
 
+// generateReplicationConfiguration generates the replication configuration
+// of the supplied Bucket. Rules whose destination is a Bucket that uses a
+// different ProviderConfig than the supplied Bucket default their account to
+// the one that ProviderConfig authenticates to.
+func (in *ReplicationConfigurationClient) generateReplicationConfiguration(ctx context.Context, bucket *v1beta1.Bucket) (*types.ReplicationConfiguration, error) {
+	config := GenerateReplicationConfiguration(bucket.Spec.ForProvider.ReplicationConfiguration)
+	if in.kube == nil {
+		return config, nil
+	}
+	accounts := map[string]string{}
+	for i, rule := range bucket.Spec.ForProvider.ReplicationConfiguration.Rules {
+		if rule.Destination.Account != nil || rule.Destination.BucketRef == nil {
+			continue
+		}
+		dst := &v1beta1.Bucket{}
+		if err := in.kube.Get(ctx, client.ObjectKey{Name: rule.Destination.BucketRef.Name}, dst); err != nil {
+			return nil, errors.Wrap(err, replicationGetDestinationFailed)
+		}
+		name := providerConfigName(dst)
+		if name == "" || name == providerConfigName(bucket) {
+			continue
+		}
+		if _, ok := accounts[name]; !ok {
+			pc := &awsv1beta1.ProviderConfig{}
+			if err := in.kube.Get(ctx, client.ObjectKey{Name: name}, pc); err != nil {
+				return nil, errors.Wrap(err, replicationGetProviderConfigFailed)
+			}
+			id, err := in.account(ctx, pc, dst.Spec.ForProvider.LocationConstraint)
+			if err != nil {
+				return nil, errors.Wrap(err, replicationDestinationAccountFailed)
+			}
+			accounts[name] = id
+		}
+		config.Rules[i].Destination.Account = aws.String(accounts[name])
+	}
+	return config, nil
+}
+
+func providerConfigName(mg resource.Managed) string {
+	if ref := mg.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
+
+// ValidateReplicationConfiguration returns an error if the supplied
+// replication configuration is one PutBucketReplication would reject.
+func ValidateReplicationConfiguration(config *types.ReplicationConfiguration) error {
+	switch {
+	case aws.ToString(config.Role) == "":
+		return errors.New(replicationNoRole)
+	case len(config.Rules) == 0:
+		return errors.New(replicationNoRules)
+	case len(config.Rules) > maxReplicationRules:
+		return errors.New(replicationTooManyRules)
+	}
+	ids := map[string]bool{}
+	priorities := map[int32]bool{}
+	for i, rule := range config.Rules {
+		if err := validateReplicationRule(rule); err != nil {
+			return errors.Wrapf(err, "rules[%d]", i)
+		}
+		if id := aws.ToString(rule.ID); id != "" {
+			if ids[id] {
+				return errors.Wrapf(errors.New(replicationDuplicateID), "rules[%d]", i)
+			}
+			ids[id] = true
+		}
+		if priorities[rule.Priority] {
+			return errors.Wrapf(errors.New(replicationDuplicatePriority), "rules[%d]", i)
+		}
+		priorities[rule.Priority] = true
+	}
+	return nil
+}
+
+func validateReplicationRule(rule types.ReplicationRule) error { // nolint:gocyclo
+	d := rule.Destination
+	switch {
+	case d == nil || aws.ToString(d.Bucket) == "":
+		return errors.New(replicationNoDestinationBucket)
+	case rule.DeleteMarkerReplication == nil:
+		return errors.New(replicationNoDeleteMarkerReplication)
+	case rule.DeleteMarkerReplication.Status == types.DeleteMarkerReplicationStatusEnabled && filtersByTags(rule.Filter):
+		return errors.New(replicationTagDeleteMarkerReplication)
+	case d.AccessControlTranslation != nil && aws.ToString(d.Account) == "":
+		return errors.New(replicationOwnerWithoutAccount)
+	}
+	if d.ReplicationTime != nil && d.ReplicationTime.Status == types.ReplicationTimeStatusEnabled {
+		if d.Metrics == nil || d.Metrics.Status != types.MetricsStatusEnabled {
+			return errors.New(replicationTimeWithoutMetrics)
+		}
+		if d.ReplicationTime.Time == nil || d.ReplicationTime.Time.Minutes != replicationTimeMinutesValue ||
+			d.Metrics.EventThreshold == nil || d.Metrics.EventThreshold.Minutes != replicationTimeMinutesValue {
+			return errors.New(replicationTimeMinutes)
+		}
+	}
+	if c := rule.SourceSelectionCriteria; c != nil && c.SseKmsEncryptedObjects != nil && c.SseKmsEncryptedObjects.Status == types.SseKmsEncryptedObjectsStatusEnabled {
+		if d.EncryptionConfiguration == nil || aws.ToString(d.EncryptionConfiguration.ReplicaKmsKeyID) == "" {
+			return errors.New(replicationSSEKMSWithoutKey)
+		}
+	}
+	return nil
+}
+
+func filtersByTags(filter types.ReplicationRuleFilter) bool {
+	switch f := filter.(type) {
+	case *types.ReplicationRuleFilterMemberTag:
+		return true
+	case *types.ReplicationRuleFilterMemberAnd:
+		return len(f.Value.Tags) != 0
+	}
+	return false
+}
+
 // Delete creates the request to delete the resource on AWS or set it to the default value.
 func (in *ReplicationConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
 	_, err := in.client.DeleteBucketReplication(ctx,
@@ -208,9 +389,14 @@ func createReplicationRulesFromExternal(external *types.ReplicationConfiguration
 			config.Rules[i].ExistingObjectReplication = &v1beta1.ExistingObjectReplication{}
 			config.Rules[i].ExistingObjectReplication.Status = string(rule.ExistingObjectReplication.Status)
 		}
-		if rule.SourceSelectionCriteria != nil && rule.SourceSelectionCriteria.SseKmsEncryptedObjects != nil {
+		if c := rule.SourceSelectionCriteria; c != nil && (c.SseKmsEncryptedObjects != nil || c.ReplicaModifications != nil) {
 			config.Rules[i].SourceSelectionCriteria = &v1beta1.SourceSelectionCriteria{}
-			config.Rules[i].SourceSelectionCriteria.SseKmsEncryptedObjects.Status = string(rule.SourceSelectionCriteria.SseKmsEncryptedObjects.Status)
+			if c.SseKmsEncryptedObjects != nil {
+				config.Rules[i].SourceSelectionCriteria.SseKmsEncryptedObjects = &v1beta1.SseKmsEncryptedObjects{Status: string(c.SseKmsEncryptedObjects.Status)}
+			}
+			if c.ReplicaModifications != nil {
+				config.Rules[i].SourceSelectionCriteria.ReplicaModifications = &v1beta1.ReplicaModifications{Status: string(c.ReplicaModifications.Status)}
+			}
 		}
 	}
 }
@@ -298,10 +484,16 @@ func createRule(input v1beta1.ReplicationRule) types.ReplicationRule {
 		}
 	}
 	if Rule.SourceSelectionCriteria != nil {
-		newRule.SourceSelectionCriteria = &types.SourceSelectionCriteria{
-			SseKmsEncryptedObjects: &types.SseKmsEncryptedObjects{
+		newRule.SourceSelectionCriteria = &types.SourceSelectionCriteria{}
+		if Rule.SourceSelectionCriteria.SseKmsEncryptedObjects != nil {
+			newRule.SourceSelectionCriteria.SseKmsEncryptedObjects = &types.SseKmsEncryptedObjects{
 				Status: types.SseKmsEncryptedObjectsStatus(Rule.SourceSelectionCriteria.SseKmsEncryptedObjects.Status),
-			},
+			}
+		}
+		if Rule.SourceSelectionCriteria.ReplicaModifications != nil {
+			newRule.SourceSelectionCriteria.ReplicaModifications = &types.ReplicaModifications{
+				Status: types.ReplicaModificationsStatus(Rule.SourceSelectionCriteria.ReplicaModifications.Status),
+			}
 		}
 	}
 	if Rule.ExistingObjectReplication != nil {
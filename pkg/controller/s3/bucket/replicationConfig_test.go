@@ -23,10 +23,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	clientss3 "github.com/crossplane/provider-aws/pkg/clients/s3"
 	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
@@ -34,20 +38,22 @@ import (
 )
 
 var (
-	role                              = "replication-role"
-	owner                             = "Destination"
-	accountID                         = "test-account-id"
-	kmsID                             = "encKmsID"
-	replicationTime                   = 15
-	priority        int32             = 1
-	_               SubresourceClient = &ReplicationConfigurationClient{}
+	role                                        = "replication-role"
+	owner                                       = "Destination"
+	accountID                                   = "test-account-id"
+	destinationAccount                          = "222233334444"
+	destinationProviderConfig                   = "destination"
+	kmsID                                       = "encKmsID"
+	replicationTime                             = 15
+	priority                  int32             = 1
+	_                         SubresourceClient = &ReplicationConfigurationClient{}
 )
 
 func generateReplicationConfig() *v1beta1.ReplicationConfiguration {
 	return &v1beta1.ReplicationConfiguration{
 		Role: &role,
 		Rules: []v1beta1.ReplicationRule{{
-			DeleteMarkerReplication: &v1beta1.DeleteMarkerReplication{Status: enabled},
+			DeleteMarkerReplication: &v1beta1.DeleteMarkerReplication{Status: string(s3types.DeleteMarkerReplicationStatusDisabled)},
 			Destination: v1beta1.Destination{
 				AccessControlTranslation: &v1beta1.AccessControlTranslation{Owner: owner},
 				Account:                  &accountID,
@@ -70,10 +76,13 @@ func generateReplicationConfig() *v1beta1.ReplicationConfiguration {
 					Tags:   tags,
 				},
 			},
-			ID:                      &id,
-			Priority:                priority,
-			SourceSelectionCriteria: &v1beta1.SourceSelectionCriteria{SseKmsEncryptedObjects: v1beta1.SseKmsEncryptedObjects{Status: enabled}},
-			Status:                  enabled,
+			ID:       &id,
+			Priority: priority,
+			SourceSelectionCriteria: &v1beta1.SourceSelectionCriteria{
+				ReplicaModifications:   &v1beta1.ReplicaModifications{Status: enabled},
+				SseKmsEncryptedObjects: &v1beta1.SseKmsEncryptedObjects{Status: enabled},
+			},
+			Status: enabled,
 		}},
 	}
 }
@@ -82,7 +91,7 @@ func generateAWSReplication() *s3types.ReplicationConfiguration {
 	return &s3types.ReplicationConfiguration{
 		Role: &role,
 		Rules: []s3types.ReplicationRule{{
-			DeleteMarkerReplication: &s3types.DeleteMarkerReplication{Status: s3types.DeleteMarkerReplicationStatusEnabled},
+			DeleteMarkerReplication: &s3types.DeleteMarkerReplication{Status: s3types.DeleteMarkerReplicationStatusDisabled},
 			Destination: &s3types.Destination{
 				AccessControlTranslation: &s3types.AccessControlTranslation{Owner: s3types.OwnerOverrideDestination},
 				Account:                  &accountID,
@@ -105,10 +114,13 @@ func generateAWSReplication() *s3types.ReplicationConfiguration {
 					Tags:   awsTags,
 				},
 			},
-			ID:                      &id,
-			Priority:                priority,
-			SourceSelectionCriteria: &s3types.SourceSelectionCriteria{SseKmsEncryptedObjects: &s3types.SseKmsEncryptedObjects{Status: s3types.SseKmsEncryptedObjectsStatusEnabled}},
-			Status:                  s3types.ReplicationRuleStatusEnabled,
+			ID:       &id,
+			Priority: priority,
+			SourceSelectionCriteria: &s3types.SourceSelectionCriteria{
+				ReplicaModifications:   &s3types.ReplicaModifications{Status: s3types.ReplicaModificationsStatusEnabled},
+				SseKmsEncryptedObjects: &s3types.SseKmsEncryptedObjects{Status: s3types.SseKmsEncryptedObjectsStatusEnabled},
+			},
+			Status: s3types.ReplicationRuleStatusEnabled,
 		}},
 	}
 }
@@ -256,17 +268,95 @@ func TestReplicationCreateOrUpdate(t *testing.T) {
 		},
 		"InvalidConfig": {
 			args: args{
-				b: s3testing.Bucket(s3testing.WithReplConfig(generateReplicationConfig())),
+				b: s3testing.Bucket(s3testing.WithReplConfig(&v1beta1.ReplicationConfiguration{
+					Rules: generateReplicationConfig().Rules,
+				})),
 				cl: NewReplicationConfigurationClient(fake.MockBucketClient{
 					MockPutBucketReplication: func(ctx context.Context, input *s3.PutBucketReplicationInput, opts []func(*s3.Options)) (*s3.PutBucketReplicationOutput, error) {
 						return &s3.PutBucketReplicationOutput{}, nil
 					},
 				}),
 			},
+			want: want{
+				err: errors.Wrap(errors.New(replicationNoRole), replicationInvalid),
+			},
+		},
+		"CrossAccountDestination": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithReplConfig(crossAccountReplicationConfig())),
+				cl: &ReplicationConfigurationClient{
+					client: fake.MockBucketClient{
+						MockPutBucketReplication: func(ctx context.Context, input *s3.PutBucketReplicationInput, opts []func(*s3.Options)) (*s3.PutBucketReplicationOutput, error) {
+							if diff := cmp.Diff(destinationAccount, awsclient.StringValue(input.ReplicationConfiguration.Rules[0].Destination.Account)); diff != "" {
+								return nil, errors.New(diff)
+							}
+							return &s3.PutBucketReplicationOutput{}, nil
+						},
+					},
+					kube: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							if b, ok := obj.(*v1beta1.Bucket); ok {
+								b.SetProviderConfigReference(&xpv1.Reference{Name: destinationProviderConfig})
+							}
+							return nil
+						}),
+					},
+					account: func(ctx context.Context, pc *awsv1beta1.ProviderConfig, region string) (string, error) {
+						return destinationAccount, nil
+					},
+				},
+			},
 			want: want{
 				err: nil,
 			},
 		},
+		"CrossAccountDestinationFromProviderConfigStatus": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithReplConfig(crossAccountReplicationConfig())),
+				cl: NewReplicationConfigurationClient(fake.MockBucketClient{
+					MockPutBucketReplication: func(ctx context.Context, input *s3.PutBucketReplicationInput, opts []func(*s3.Options)) (*s3.PutBucketReplicationOutput, error) {
+						if diff := cmp.Diff(destinationAccount, awsclient.StringValue(input.ReplicationConfiguration.Rules[0].Destination.Account)); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &s3.PutBucketReplicationOutput{}, nil
+					},
+				}, WithKubeClient(&test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						switch o := obj.(type) {
+						case *v1beta1.Bucket:
+							o.SetProviderConfigReference(&xpv1.Reference{Name: destinationProviderConfig})
+						case *awsv1beta1.ProviderConfig:
+							o.Status.AccountID = awsclient.String(destinationAccount)
+						}
+						return nil
+					}),
+				})),
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"CrossAccountDestinationError": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithReplConfig(crossAccountReplicationConfig())),
+				cl: &ReplicationConfigurationClient{
+					kube: &test.MockClient{
+						MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+							if b, ok := obj.(*v1beta1.Bucket); ok {
+								b.SetProviderConfigReference(&xpv1.Reference{Name: destinationProviderConfig})
+							}
+							return nil
+						}),
+					},
+					account: func(ctx context.Context, pc *awsv1beta1.ProviderConfig, region string) (string, error) {
+						return "", errBoom
+					},
+				},
+			},
+			want: want{
+				err: errors.Wrap(errBoom, replicationDestinationAccountFailed),
+			},
+		},
 		"SuccessfulCreate": {
 			args: args{
 				b: s3testing.Bucket(s3testing.WithReplConfig(generateReplicationConfig())),
@@ -292,6 +382,187 @@ func TestReplicationCreateOrUpdate(t *testing.T) {
 	}
 }
 
+func crossAccountReplicationConfig() *v1beta1.ReplicationConfiguration {
+	config := generateReplicationConfig()
+	config.Rules[0].Destination.Account = nil
+	config.Rules[0].Destination.BucketRef = &xpv1.Reference{Name: bucketName}
+	return config
+}
+
+func TestValidateReplicationConfiguration(t *testing.T) {
+	type args struct {
+		config *s3types.ReplicationConfiguration
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Valid": {
+			args: args{
+				config: generateAWSReplication(),
+			},
+			want: want{},
+		},
+		"NoRole": {
+			args: args{
+				config: func() *s3types.ReplicationConfiguration {
+					c := generateAWSReplication()
+					c.Role = nil
+					return c
+				}(),
+			},
+			want: want{
+				err: errors.New(replicationNoRole),
+			},
+		},
+		"NoRules": {
+			args: args{
+				config: &s3types.ReplicationConfiguration{Role: &role},
+			},
+			want: want{
+				err: errors.New(replicationNoRules),
+			},
+		},
+		"DuplicateID": {
+			args: args{
+				config: func() *s3types.ReplicationConfiguration {
+					c := generateAWSReplication()
+					r := *generateAWSReplication()
+					r.Rules[0].Priority = priority + 1
+					c.Rules = append(c.Rules, r.Rules[0])
+					return c
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(replicationDuplicateID), "rules[1]"),
+			},
+		},
+		"DuplicatePriority": {
+			args: args{
+				config: func() *s3types.ReplicationConfiguration {
+					c := generateAWSReplication()
+					r := *generateAWSReplication()
+					r.Rules[0].ID = awsclient.String("other")
+					c.Rules = append(c.Rules, r.Rules[0])
+					return c
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(replicationDuplicatePriority), "rules[1]"),
+			},
+		},
+		"NoDestinationBucket": {
+			args: args{
+				config: func() *s3types.ReplicationConfiguration {
+					c := generateAWSReplication()
+					c.Rules[0].Destination.Bucket = nil
+					return c
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(replicationNoDestinationBucket), "rules[0]"),
+			},
+		},
+		"NoDeleteMarkerReplication": {
+			args: args{
+				config: func() *s3types.ReplicationConfiguration {
+					c := generateAWSReplication()
+					c.Rules[0].DeleteMarkerReplication = nil
+					return c
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(replicationNoDeleteMarkerReplication), "rules[0]"),
+			},
+		},
+		"TagDeleteMarkerReplication": {
+			args: args{
+				config: func() *s3types.ReplicationConfiguration {
+					c := generateAWSReplication()
+					c.Rules[0].DeleteMarkerReplication.Status = s3types.DeleteMarkerReplicationStatusEnabled
+					return c
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(replicationTagDeleteMarkerReplication), "rules[0]"),
+			},
+		},
+		"PrefixDeleteMarkerReplication": {
+			args: args{
+				config: func() *s3types.ReplicationConfiguration {
+					c := generateAWSReplication()
+					c.Rules[0].DeleteMarkerReplication.Status = s3types.DeleteMarkerReplicationStatusEnabled
+					c.Rules[0].Filter = &s3types.ReplicationRuleFilterMemberPrefix{Value: prefix}
+					return c
+				}(),
+			},
+			want: want{},
+		},
+		"OwnerWithoutAccount": {
+			args: args{
+				config: func() *s3types.ReplicationConfiguration {
+					c := generateAWSReplication()
+					c.Rules[0].Destination.Account = nil
+					return c
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(replicationOwnerWithoutAccount), "rules[0]"),
+			},
+		},
+		"ReplicationTimeWithoutMetrics": {
+			args: args{
+				config: func() *s3types.ReplicationConfiguration {
+					c := generateAWSReplication()
+					c.Rules[0].Destination.Metrics.Status = s3types.MetricsStatusDisabled
+					return c
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(replicationTimeWithoutMetrics), "rules[0]"),
+			},
+		},
+		"ReplicationTimeMinutes": {
+			args: args{
+				config: func() *s3types.ReplicationConfiguration {
+					c := generateAWSReplication()
+					c.Rules[0].Destination.ReplicationTime.Time.Minutes = 30
+					return c
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(replicationTimeMinutes), "rules[0]"),
+			},
+		},
+		"SSEKMSWithoutKey": {
+			args: args{
+				config: func() *s3types.ReplicationConfiguration {
+					c := generateAWSReplication()
+					c.Rules[0].Destination.EncryptionConfiguration = nil
+					return c
+				}(),
+			},
+			want: want{
+				err: errors.Wrap(errors.New(replicationSSEKMSWithoutKey), "rules[0]"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateReplicationConfiguration(tc.args.config)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestReplicationDelete(t *testing.T) {
 	type args struct {
 		cl *ReplicationConfigurationClient
@@ -504,7 +775,7 @@ func TestIsUpToDate(t *testing.T) {
 						},
 						ID:                      &id,
 						Priority:                priority,
-						SourceSelectionCriteria: &v1beta1.SourceSelectionCriteria{SseKmsEncryptedObjects: v1beta1.SseKmsEncryptedObjects{Status: enabled}},
+						SourceSelectionCriteria: &v1beta1.SourceSelectionCriteria{SseKmsEncryptedObjects: &v1beta1.SseKmsEncryptedObjects{Status: enabled}},
 						Status:                  enabled,
 					}},
 				},
@@ -575,7 +846,7 @@ func TestIsUpToDate(t *testing.T) {
 						},
 						ID:                      awsclient.String("rule-1"),
 						Priority:                priority,
-						SourceSelectionCriteria: &v1beta1.SourceSelectionCriteria{SseKmsEncryptedObjects: v1beta1.SseKmsEncryptedObjects{Status: enabled}},
+						SourceSelectionCriteria: &v1beta1.SourceSelectionCriteria{SseKmsEncryptedObjects: &v1beta1.SseKmsEncryptedObjects{Status: enabled}},
 						Status:                  enabled,
 					},
 						{
@@ -604,7 +875,7 @@ func TestIsUpToDate(t *testing.T) {
 							},
 							ID:                      awsclient.String("rule-2"),
 							Priority:                priority,
-							SourceSelectionCriteria: &v1beta1.SourceSelectionCriteria{SseKmsEncryptedObjects: v1beta1.SseKmsEncryptedObjects{Status: enabled}},
+							SourceSelectionCriteria: &v1beta1.SourceSelectionCriteria{SseKmsEncryptedObjects: &v1beta1.SseKmsEncryptedObjects{Status: enabled}},
 							Status:                  enabled,
 						}},
 				},
@@ -711,7 +982,7 @@ func TestIsUpToDate(t *testing.T) {
 						},
 						ID:                      &id,
 						Priority:                priority,
-						SourceSelectionCriteria: &v1beta1.SourceSelectionCriteria{SseKmsEncryptedObjects: v1beta1.SseKmsEncryptedObjects{Status: enabled}},
+						SourceSelectionCriteria: &v1beta1.SourceSelectionCriteria{SseKmsEncryptedObjects: &v1beta1.SseKmsEncryptedObjects{Status: enabled}},
 						Status:                  enabled,
 					}},
 				},
@@ -786,7 +1057,7 @@ func TestIsUpToDate(t *testing.T) {
 						Filter:                    nil,
 						ID:                        &id,
 						Priority:                  priority,
-						SourceSelectionCriteria:   &v1beta1.SourceSelectionCriteria{SseKmsEncryptedObjects: v1beta1.SseKmsEncryptedObjects{Status: enabled}},
+						SourceSelectionCriteria:   &v1beta1.SourceSelectionCriteria{SseKmsEncryptedObjects: &v1beta1.SseKmsEncryptedObjects{Status: enabled}},
 						Status:                    enabled,
 					}},
 				},
@@ -854,7 +1125,7 @@ func TestIsUpToDate(t *testing.T) {
 						},
 						ID:                      &id,
 						Priority:                priority,
-						SourceSelectionCriteria: &v1beta1.SourceSelectionCriteria{SseKmsEncryptedObjects: v1beta1.SseKmsEncryptedObjects{Status: enabled}},
+						SourceSelectionCriteria: &v1beta1.SourceSelectionCriteria{SseKmsEncryptedObjects: &v1beta1.SseKmsEncryptedObjects{Status: enabled}},
 						Status:                  "Disabled",
 					}},
 				},
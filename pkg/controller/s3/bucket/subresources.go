@@ -19,6 +19,8 @@ package bucket
 import (
 	"context"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
 	"github.com/crossplane/provider-aws/pkg/clients/s3"
 )
@@ -33,7 +35,7 @@ type SubresourceClient interface {
 }
 
 // NewSubresourceClients creates the array of all clients for a given BucketProvider
-func NewSubresourceClients(client s3.BucketClient) []SubresourceClient {
+func NewSubresourceClients(client s3.BucketClient, kube client.Client, targets TargetPolicyClient) []SubresourceClient {
 	return []SubresourceClient{
 		// Note: Moved VersioningClient up, since ReplicationConfiguration may be blocked
 		// by an invalid VersioningConfig, see https://github.com/crossplane/provider-aws/issues/553
@@ -42,13 +44,20 @@ func NewSubresourceClients(client s3.BucketClient) []SubresourceClient {
 		NewCORSConfigurationClient(client),
 		NewLifecycleConfigurationClient(client),
 		NewLoggingConfigurationClient(client),
-		NewNotificationConfigurationClient(client),
-		NewReplicationConfigurationClient(client),
+		NewNotificationConfigurationClient(client, WithTargetPolicyClient(targets)),
+		NewReplicationConfigurationClient(client, WithKubeClient(kube)),
 		NewRequestPaymentConfigurationClient(client),
 		NewSSEConfigurationClient(client),
 		NewTaggingConfigurationClient(client),
 		NewWebsiteConfigurationClient(client),
 		NewPublicAccessBlockClient(client),
+		NewOwnershipControlsClient(client),
+		NewObjectLockConfigurationClient(client),
+		NewACLClient(client),
+		NewAnalyticsConfigurationClient(client),
+		NewInventoryConfigurationClient(client),
+		NewMetricsConfigurationClient(client),
+		NewIntelligentTieringConfigurationClient(client),
 	}
 }
 
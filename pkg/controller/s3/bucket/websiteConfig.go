@@ -102,8 +102,8 @@ func (in *WebsiteConfigurationClient) Delete(ctx context.Context, bucket *v1beta
 	return awsclient.Wrap(err, websiteDeleteFailed)
 }
 
-// LateInitialize does nothing because the resource might have been deleted by
-// the user.
+// LateInitialize fills the empty fields in *v1beta1.WebsiteConfiguration with
+// the values seen in the external bucket.
 func (in *WebsiteConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
 	external, err := in.client.GetBucketWebsite(ctx, &awss3.GetBucketWebsiteInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
 	if err != nil {
@@ -37,6 +37,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	clients3 "github.com/crossplane/provider-aws/pkg/clients/s3"
 	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
@@ -57,6 +58,38 @@ type args struct {
 }
 
 func TestObserve(t *testing.T) {
+	existingSSE := func(ctx context.Context, input *awss3.GetBucketEncryptionInput, opts []func(*awss3.Options)) (*awss3.GetBucketEncryptionOutput, error) {
+		return &awss3.GetBucketEncryptionOutput{
+			ServerSideEncryptionConfiguration: &awss3types.ServerSideEncryptionConfiguration{
+				Rules: []awss3types.ServerSideEncryptionRule{
+					{
+						ApplyServerSideEncryptionByDefault: &awss3types.ServerSideEncryptionByDefault{
+							KMSMasterKeyID: aws.String("1234567890"),
+							SSEAlgorithm:   awss3types.ServerSideEncryptionAwsKms,
+						},
+					},
+				},
+			},
+		}, nil
+	}
+
+	// Observe merges the tags Crossplane adds to every resource into the tags
+	// of every Bucket. Unless a case is about tags the Bucket has them already.
+	withTags := func(b *v1beta1.Bucket) {
+		b.Spec.ForProvider.BucketTagging = &v1beta1.Tagging{TagSet: []v1beta1.Tag{
+			{Key: resource.ExternalResourceTagKeyKind},
+			{Key: resource.ExternalResourceTagKeyName},
+		}}
+	}
+	withProviderConfig := func(b *v1beta1.Bucket) {
+		b.SetProviderConfigReference(&xpv1.Reference{Name: "pc"})
+	}
+	withGetTags := s3Testing.WithGetTagging(func(ctx context.Context, input *awss3.GetBucketTaggingInput, opts []func(*awss3.Options)) (*awss3.GetBucketTaggingOutput, error) {
+		return &awss3.GetBucketTaggingOutput{TagSet: []awss3types.Tag{
+			{Key: aws.String(resource.ExternalResourceTagKeyKind), Value: aws.String("")},
+			{Key: aws.String(resource.ExternalResourceTagKeyName), Value: aws.String("")},
+		}}, nil
+	})
 
 	type want struct {
 		cr     resource.Managed
@@ -106,11 +139,12 @@ func TestObserve(t *testing.T) {
 		},
 		"ValidInputNoLateInitialize": {
 			args: args{
-				s3: s3Testing.Client(),
-				cr: s3Testing.Bucket(),
+				s3: s3Testing.Client(withGetTags),
+				cr: s3Testing.Bucket(withTags),
 			},
 			want: want{
 				cr: s3Testing.Bucket(
+					withTags,
 					s3Testing.WithConditions(xpv1.Available()),
 					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
 				),
@@ -124,33 +158,56 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
-		"ValidInputNoLateInitializeUpdateACLFail": {
+		"ValidInputNoLateInitializeGetACLFail": {
 			args: args{
-				s3: s3Testing.Client(s3Testing.WithPutACL(func(ctx context.Context, input *awss3.PutBucketAclInput, opts []func(*awss3.Options)) (*awss3.PutBucketAclOutput, error) {
+				s3: s3Testing.Client(withGetTags, s3Testing.WithGetACL(func(ctx context.Context, input *awss3.GetBucketAclInput, opts []func(*awss3.Options)) (*awss3.GetBucketAclOutput, error) {
 					return nil, errBoom
 				})),
-				cr: s3Testing.Bucket(),
+				cr: s3Testing.Bucket(withTags),
 			},
 			want: want{
 				cr: s3Testing.Bucket(
+					withTags,
 					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
 				),
-				err:    errBoom,
+				err:    awsclient.Wrap(errBoom, "cannot get Bucket ACL"),
 				result: managed.ExternalObservation{},
 			},
 		},
+		"ACLNeedsUpdate": {
+			args: args{
+				s3: s3Testing.Client(withGetTags, s3Testing.WithGetACL(func(ctx context.Context, input *awss3.GetBucketAclInput, opts []func(*awss3.Options)) (*awss3.GetBucketAclOutput, error) {
+					return &awss3.GetBucketAclOutput{
+						Owner: &awss3types.Owner{ID: aws.String(s3Testing.OwnerID)},
+						Grants: []awss3types.Grant{{
+							Grantee:    &awss3types.Grantee{Type: awss3types.TypeGroup, URI: aws.String("http://acs.amazonaws.com/groups/global/AllUsers")},
+							Permission: awss3types.PermissionRead,
+						}},
+					}, nil
+				})),
+				cr: s3Testing.Bucket(withTags),
+			},
+			want: want{
+				cr: s3Testing.Bucket(
+					withTags,
+					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
+				),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
 		"LateInitialize": {
 			args: args{
-				s3: s3Testing.Client(
+				s3: s3Testing.Client(withGetTags,
 					s3Testing.WithGetRequestPayment(func(ctx context.Context, input *awss3.GetBucketRequestPaymentInput, opts []func(*awss3.Options)) (*awss3.GetBucketRequestPaymentOutput, error) {
 						return &awss3.GetBucketRequestPaymentOutput{Payer: awss3types.PayerRequester}, nil
 					},
 					),
 				),
-				cr: s3Testing.Bucket(s3Testing.WithPayerConfig(&v1beta1.PaymentConfiguration{})),
+				cr: s3Testing.Bucket(withTags, s3Testing.WithPayerConfig(&v1beta1.PaymentConfiguration{})),
 			},
 			want: want{
 				cr: s3Testing.Bucket(
+					withTags,
 					s3Testing.WithConditions(xpv1.Available()),
 					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
 					s3Testing.WithPayerConfig(&v1beta1.PaymentConfiguration{Payer: "Requester"}),
@@ -169,16 +226,17 @@ func TestObserve(t *testing.T) {
 		"LateInitializeNotOccurNil": {
 			// this case is the same as needing an update, we should not late init here.
 			args: args{
-				s3: s3Testing.Client(
+				s3: s3Testing.Client(withGetTags,
 					s3Testing.WithGetRequestPayment(func(ctx context.Context, input *awss3.GetBucketRequestPaymentInput, opts []func(*awss3.Options)) (*awss3.GetBucketRequestPaymentOutput, error) {
 						return &awss3.GetBucketRequestPaymentOutput{Payer: awss3types.PayerRequester}, nil
 					},
 					),
 				),
-				cr: s3Testing.Bucket(),
+				cr: s3Testing.Bucket(withTags),
 			},
 			want: want{
 				cr: s3Testing.Bucket(
+					withTags,
 					s3Testing.WithConditions(xpv1.Available()),
 					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
 				),
@@ -196,7 +254,7 @@ func TestObserve(t *testing.T) {
 		"LateInitializeNotOccurExistsList": {
 			// Validating that late init should not occur here because SSE already exists.
 			args: args{
-				s3: s3Testing.Client(
+				s3: s3Testing.Client(withGetTags,
 					s3Testing.WithGetSSE(func(ctx context.Context, input *awss3.GetBucketEncryptionInput, opts []func(*awss3.Options)) (*awss3.GetBucketEncryptionOutput, error) {
 						return &awss3.GetBucketEncryptionOutput{
 							ServerSideEncryptionConfiguration: &awss3types.ServerSideEncryptionConfiguration{
@@ -213,6 +271,7 @@ func TestObserve(t *testing.T) {
 					}),
 				),
 				cr: s3Testing.Bucket(
+					withTags,
 					s3Testing.WithSSEConfig(&v1beta1.ServerSideEncryptionConfiguration{
 						Rules: []v1beta1.ServerSideEncryptionRule{
 							{
@@ -227,6 +286,7 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				cr: s3Testing.Bucket(
+					withTags,
 					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
 					s3Testing.WithSSEConfig(&v1beta1.ServerSideEncryptionConfiguration{
 						Rules: []v1beta1.ServerSideEncryptionRule{
@@ -245,11 +305,141 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"NotAdoptedNeedsDeletion": {
+			// SSE exists on the bucket but not in the spec, so it would be removed.
+			args: args{
+				s3: s3Testing.Client(withGetTags, s3Testing.WithGetSSE(existingSSE)),
+				cr: s3Testing.Bucket(withTags),
+			},
+			want: want{
+				cr: s3Testing.Bucket(
+					withTags,
+					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Adopt": {
+			// Validating that an adopted bucket late initializes unset subresources.
+			args: args{
+				s3: s3Testing.Client(withGetTags, s3Testing.WithGetSSE(existingSSE)),
+				cr: s3Testing.Bucket(withTags, s3Testing.WithAnnotations(map[string]string{v1beta1.AnnotationKeyAdopt: "true"})),
+			},
+			want: want{
+				cr: s3Testing.Bucket(
+					withTags,
+					s3Testing.WithConditions(xpv1.Available()),
+					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
+					s3Testing.WithSSEConfig(&v1beta1.ServerSideEncryptionConfiguration{
+						Rules: []v1beta1.ServerSideEncryptionRule{
+							{
+								ApplyServerSideEncryptionByDefault: v1beta1.ServerSideEncryptionByDefault{
+									KMSMasterKeyID: aws.String("1234567890"),
+									SSEAlgorithm:   "aws:kms",
+								},
+							},
+						},
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: map[string][]byte{
+						xpv1.ResourceCredentialsSecretEndpointKey:  []byte(s3Testing.BucketName),
+						v1beta1.ResourceCredentialsSecretRegionKey: []byte(s3Testing.Region),
+					},
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"DefaultTags": {
+			// Validating that the default tags of the ProviderConfig are merged into the tags.
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						obj.(*awsv1beta1.ProviderConfig).Spec.DefaultTags = map[string]string{"foo": "default", "team": "platform"}
+						return nil
+					}),
+				},
+				s3: s3Testing.Client(withGetTags),
+				cr: s3Testing.Bucket(withProviderConfig, s3Testing.WithTaggingConfig(&v1beta1.Tagging{TagSet: []v1beta1.Tag{{Key: "foo", Value: "bar"}}})),
+			},
+			want: want{
+				cr: s3Testing.Bucket(
+					withProviderConfig,
+					s3Testing.WithAnnotations(map[string]string{awsclient.AnnotationKeyDefaultTags: `{"team":"platform"}`}),
+					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
+					s3Testing.WithTaggingConfig(&v1beta1.Tagging{TagSet: []v1beta1.Tag{
+						{Key: resource.ExternalResourceTagKeyKind},
+						{Key: resource.ExternalResourceTagKeyName},
+						{Key: resource.ExternalResourceTagKeyProvider, Value: "pc"},
+						{Key: "foo", Value: "bar"},
+						{Key: "team", Value: "platform"},
+					}}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"AdoptTagged": {
+			// Validating that the existing tags of an adopted bucket are kept.
+			args: args{
+				s3: s3Testing.Client(s3Testing.WithGetTagging(func(ctx context.Context, input *awss3.GetBucketTaggingInput, opts []func(*awss3.Options)) (*awss3.GetBucketTaggingOutput, error) {
+					return &awss3.GetBucketTaggingOutput{TagSet: []awss3types.Tag{{Key: aws.String("owner"), Value: aws.String("data")}}}, nil
+				})),
+				cr: s3Testing.Bucket(s3Testing.WithAnnotations(map[string]string{v1beta1.AnnotationKeyAdopt: "true"})),
+			},
+			want: want{
+				cr: s3Testing.Bucket(
+					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
+					s3Testing.WithTaggingConfig(&v1beta1.Tagging{TagSet: []v1beta1.Tag{
+						{Key: resource.ExternalResourceTagKeyKind},
+						{Key: resource.ExternalResourceTagKeyName},
+						{Key: "owner", Value: "data"},
+					}}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NewTags": {
+			// Validating that the existing tags of a bucket that has no tags in its spec yet are kept.
+			args: args{
+				s3: s3Testing.Client(s3Testing.WithGetTagging(func(ctx context.Context, input *awss3.GetBucketTaggingInput, opts []func(*awss3.Options)) (*awss3.GetBucketTaggingOutput, error) {
+					return &awss3.GetBucketTaggingOutput{TagSet: []awss3types.Tag{{Key: aws.String("owner"), Value: aws.String("data")}}}, nil
+				})),
+				cr: s3Testing.Bucket(),
+			},
+			want: want{
+				cr: s3Testing.Bucket(
+					s3Testing.WithArn(fmt.Sprintf("arn:aws:s3:::%s", s3Testing.BucketName)),
+					s3Testing.WithTaggingConfig(&v1beta1.Tagging{TagSet: []v1beta1.Tag{
+						{Key: resource.ExternalResourceTagKeyKind},
+						{Key: resource.ExternalResourceTagKeyName},
+						{Key: "owner", Value: "data"},
+					}}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{s3client: tc.s3, subresourceClients: bucket.NewSubresourceClients(tc.s3), kube: tc.kube}
+			e := &external{s3client: tc.s3, subresourceClients: bucket.NewSubresourceClients(tc.s3, tc.kube, nil), kube: tc.kube}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -289,6 +479,23 @@ func TestCreate(t *testing.T) {
 				cr: s3Testing.Bucket(),
 			},
 		},
+		"DefaultLocationConstraint": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				s3: s3Testing.Client(s3Testing.WithCreateBucket(func(ctx context.Context, input *awss3.CreateBucketInput, opts []func(*awss3.Options)) (*awss3.CreateBucketOutput, error) {
+					if input.CreateBucketConfiguration != nil {
+						return nil, errBoom
+					}
+					return &awss3.CreateBucketOutput{}, nil
+				})),
+				cr: s3Testing.Bucket(s3Testing.WithLocationConstraint("")),
+			},
+			want: want{
+				cr: s3Testing.Bucket(),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				kube: &test.MockClient{
@@ -446,7 +653,7 @@ func TestCreate(t *testing.T) {
 	for name, tc := range cases {
 		noop := logging.NewNopLogger()
 		t.Run(name, func(t *testing.T) {
-			e := &external{s3client: tc.s3, kube: tc.kube, logger: noop, subresourceClients: bucket.NewSubresourceClients(tc.s3)}
+			e := &external{s3client: tc.s3, kube: tc.kube, logger: noop, region: s3Testing.Region, subresourceClients: bucket.NewSubresourceClients(tc.s3, tc.kube, nil)}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -644,7 +851,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{s3client: tc.s3, subresourceClients: bucket.NewSubresourceClients(tc.s3)}
+			e := &external{s3client: tc.s3, subresourceClients: bucket.NewSubresourceClients(tc.s3, tc.kube, nil)}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -720,6 +927,74 @@ func TestDelete(t *testing.T) {
 				cr: s3Testing.Bucket(s3Testing.WithConditions(xpv1.Deleting())),
 			},
 		},
+		"ForceDestroy": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListObjectVersions: func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						if aws.ToString(input.KeyMarker) == "" {
+							return &awss3.ListObjectVersionsOutput{
+								Versions:            []awss3types.ObjectVersion{{Key: aws.String("a"), VersionId: aws.String("1")}},
+								IsTruncated:         true,
+								NextKeyMarker:       aws.String("a"),
+								NextVersionIdMarker: aws.String("1"),
+							}, nil
+						}
+						return &awss3.ListObjectVersionsOutput{
+							DeleteMarkers: []awss3types.DeleteMarkerEntry{{Key: aws.String("b"), VersionId: aws.String("2")}},
+						}, nil
+					},
+					MockDeleteObjects: func(ctx context.Context, input *awss3.DeleteObjectsInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectsOutput, error) {
+						return &awss3.DeleteObjectsOutput{}, nil
+					},
+					MockDeleteBucket: func(ctx context.Context, input *awss3.DeleteBucketInput, opts []func(*awss3.Options)) (*awss3.DeleteBucketOutput, error) {
+						return &awss3.DeleteBucketOutput{}, nil
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true)),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true), s3Testing.WithConditions(xpv1.Deleting(), v1beta1.Emptied())),
+			},
+		},
+		"ForceDestroyMoreRemain": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListObjectVersions: func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						return &awss3.ListObjectVersionsOutput{
+							Versions:      []awss3types.ObjectVersion{{Key: aws.String("a"), VersionId: aws.String("1")}},
+							IsTruncated:   true,
+							NextKeyMarker: aws.String("a"),
+						}, nil
+					},
+					MockDeleteObjects: func(ctx context.Context, input *awss3.DeleteObjectsInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectsOutput, error) {
+						return &awss3.DeleteObjectsOutput{}, nil
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true)),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true), s3Testing.WithConditions(xpv1.Deleting(), v1beta1.Emptying(maxEmptyPages))),
+			},
+		},
+		"ForceDestroyDeleteObjectsError": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListObjectVersions: func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						return &awss3.ListObjectVersionsOutput{
+							Versions: []awss3types.ObjectVersion{{Key: aws.String("a"), VersionId: aws.String("1")}},
+						}, nil
+					},
+					MockDeleteObjects: func(ctx context.Context, input *awss3.DeleteObjectsInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true)),
+			},
+			want: want{
+				cr:  s3Testing.Bucket(s3Testing.WithForceDestroy(true), s3Testing.WithConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeleteObjects),
+			},
+		},
 	}
 
 	for name, tc := range cases {
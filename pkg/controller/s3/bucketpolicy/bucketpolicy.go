@@ -18,12 +18,10 @@ package bucketpolicy
 
 import (
 	"context"
-	"encoding/json"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -39,7 +37,9 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
 	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -62,9 +62,9 @@ func SetupBucketPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimi
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1alpha3.BucketPolicy{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1alpha3.BucketPolicyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(),
 				newClientFn: s3.NewBucketPolicyClient}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithPollInterval(poll),
@@ -121,7 +121,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	// If our version and the external version are the same, we return ResourceUpToDate: true
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: cmp.Equal(*policyData, *resp.Policy),
+		ResourceUpToDate: policyclient.Equal(*policyData, aws.ToString(resp.Policy)),
 	}, nil
 }
 
@@ -134,16 +134,10 @@ func (e *external) formatBucketPolicy(original *v1alpha3.BucketPolicy) (*string,
 	case original.Spec.Parameters.RawPolicy != nil:
 		return original.Spec.Parameters.RawPolicy, nil
 	case original.Spec.Parameters.Policy != nil:
-		c := original.DeepCopy()
-		body, err := s3.Serialize(c.Spec.Parameters.Policy)
+		str, err := s3.Serialize(original.Spec.Parameters.Policy)
 		if err != nil {
 			return nil, err
 		}
-		byteData, err := json.Marshal(body)
-		if err != nil {
-			return nil, err
-		}
-		str := string(byteData)
 		return &str, nil
 	}
 	return nil, errors.New(errNotSpecified)
@@ -0,0 +1,242 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an Object resource"
+	errHead             = "failed to get Object"
+	errGetTagging       = "failed to get Object tags"
+	errPut              = "failed to put Object"
+	errDelete           = "failed to delete Object"
+	errNoContent        = "exactly one of content, contentBase64 or contentFrom must be specified"
+	errNoContentSource  = "exactly one of configMapKeyRef or secretKeyRef must be specified"
+	errDecodeContent    = "cannot decode contentBase64"
+	errGetConfigMap     = "cannot get ConfigMap of Object content"
+	errGetSecret        = "cannot get Secret of Object content"
+	errNoKey            = "key %q of %s %s/%s does not exist"
+)
+
+// SetupObject adds a controller that reconciles Objects.
+func SetupObject(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, poll time.Duration) error {
+	name := managed.ControllerName(v1alpha3.ObjectGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewController(rl),
+		}).
+		For(&v1alpha3.Object{}).
+		Complete(drift.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha3.ObjectGroupVersionKind),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewObjectClient}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithPollInterval(poll),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) s3.ObjectClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client s3.ObjectClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	head, err := e.client.HeadObject(ctx, &awss3.HeadObjectInput{
+		Bucket: cr.Spec.ForProvider.BucketName,
+		Key:    awsclient.String(cr.Spec.ForProvider.Key),
+	})
+	if s3.IsNotFound(err) {
+		return managed.ExternalObservation{}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errHead)
+	}
+	cr.Status.AtProvider = v1alpha3.ObjectObservation{ETag: head.ETag, VersionID: head.VersionId}
+
+	// The content of a deleted Object may no longer be available.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	content, err := e.content(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	tagging, err := e.client.GetObjectTagging(ctx, &awss3.GetObjectTaggingInput{
+		Bucket:    cr.Spec.ForProvider.BucketName,
+		Key:       awsclient.String(cr.Spec.ForProvider.Key),
+		VersionId: head.VersionId,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetTagging)
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: s3.IsObjectUpToDate(cr.Spec.ForProvider, content, head, tagging.TagSet),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.put(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, e.put(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.Object)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteObject(ctx, &awss3.DeleteObjectInput{
+		Bucket: cr.Spec.ForProvider.BucketName,
+		Key:    awsclient.String(cr.Spec.ForProvider.Key),
+	})
+	return awsclient.Wrap(resource.Ignore(s3.IsErrorBucketNotFound, err), errDelete)
+}
+
+// put stores the desired content of the Object, replacing its current
+// content, metadata and tags.
+func (e *external) put(ctx context.Context, cr *v1alpha3.Object) error {
+	content, err := e.content(ctx, cr)
+	if err != nil {
+		return err
+	}
+	out, err := e.client.PutObject(ctx, s3.GeneratePutObjectInput(cr.Spec.ForProvider, content))
+	if err != nil {
+		return awsclient.Wrap(err, errPut)
+	}
+	cr.Status.AtProvider = v1alpha3.ObjectObservation{ETag: out.ETag, VersionID: out.VersionId}
+	return nil
+}
+
+// content returns the desired content of the Object.
+func (e *external) content(ctx context.Context, cr *v1alpha3.Object) ([]byte, error) {
+	p := cr.Spec.ForProvider
+	set := 0
+	for _, ok := range []bool{p.Content != nil, p.ContentBase64 != nil, p.ContentFrom != nil} {
+		if ok {
+			set++
+		}
+	}
+	switch {
+	case set != 1:
+		return nil, errors.New(errNoContent)
+	case p.Content != nil:
+		return []byte(*p.Content), nil
+	case p.ContentBase64 != nil:
+		b, err := base64.StdEncoding.DecodeString(*p.ContentBase64)
+		return b, errors.Wrap(err, errDecodeContent)
+	}
+	return e.contentFrom(ctx, p.ContentFrom)
+}
+
+// contentFrom returns the value of the ConfigMap or Secret key the supplied
+// source selects.
+func (e *external) contentFrom(ctx context.Context, src *v1alpha3.ObjectContentSource) ([]byte, error) {
+	switch {
+	case src.ConfigMapKeyRef != nil && src.SecretKeyRef == nil:
+		s := src.ConfigMapKeyRef
+		cm := &corev1.ConfigMap{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: s.Name, Namespace: s.Namespace}, cm); err != nil {
+			return nil, errors.Wrap(err, errGetConfigMap)
+		}
+		if v, ok := cm.Data[s.Key]; ok {
+			return []byte(v), nil
+		}
+		if v, ok := cm.BinaryData[s.Key]; ok {
+			return v, nil
+		}
+		return nil, errors.Errorf(errNoKey, s.Key, "ConfigMap", s.Namespace, s.Name)
+	case src.SecretKeyRef != nil && src.ConfigMapKeyRef == nil:
+		s := src.SecretKeyRef
+		secret := &corev1.Secret{}
+		if err := e.kube.Get(ctx, types.NamespacedName{Name: s.Name, Namespace: s.Namespace}, secret); err != nil {
+			return nil, errors.Wrap(err, errGetSecret)
+		}
+		if v, ok := secret.Data[s.Key]; ok {
+			return v, nil
+		}
+		return nil, errors.Errorf(errNoKey, s.Key, "Secret", s.Namespace, s.Name)
+	}
+	return nil, errors.New(errNoContentSource)
+}
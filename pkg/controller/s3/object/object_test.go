@@ -0,0 +1,404 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"testing"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	bucketName     = "test.s3.crossplane.com"
+	key            = "index.html"
+	content        = "<html></html>"
+	etag           = `"etag"`
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	s3   s3.ObjectClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type objectModifier func(*v1alpha3.Object)
+
+func withConditions(c ...xpv1.Condition) objectModifier {
+	return func(r *v1alpha3.Object) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withContent(c string) objectModifier {
+	return func(r *v1alpha3.Object) { r.Spec.ForProvider.Content = &c }
+}
+
+func withContentFrom(s *v1alpha3.ObjectContentSource) objectModifier {
+	return func(r *v1alpha3.Object) { r.Spec.ForProvider.ContentFrom = s }
+}
+
+func withETag(e string) objectModifier {
+	return func(r *v1alpha3.Object) { r.Status.AtProvider.ETag = &e }
+}
+
+func object(m ...objectModifier) *v1alpha3.Object {
+	cr := &v1alpha3.Object{
+		Spec: v1alpha3.ObjectSpec{
+			ForProvider: v1alpha3.ObjectParameters{
+				BucketName: &bucketName,
+				Key:        key,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func head(c string) func(context.Context, *awss3.HeadObjectInput, []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+	return func(_ context.Context, _ *awss3.HeadObjectInput, _ []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+		return &awss3.HeadObjectOutput{
+			ETag:     &etag,
+			Metadata: map[string]string{s3.MetadataKeyContentSHA256: s3.ContentSHA256([]byte(c))},
+		}, nil
+	}
+}
+
+func noTags(_ context.Context, _ *awss3.GetObjectTaggingInput, _ []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+	return &awss3.GetObjectTaggingOutput{}, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NotFound": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(_ context.Context, _ *awss3.HeadObjectInput, _ []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, &s3types.NotFound{}
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content)),
+			},
+		},
+		"HeadError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(_ context.Context, _ *awss3.HeadObjectInput, _ []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr:  object(withContent(content)),
+				err: awsclient.Wrap(errBoom, errHead),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				s3: &fake.MockObjectClient{MockHeadObject: head(content), MockGetObjectTagging: noTags},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content), withETag(etag), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ContentChanged": {
+			args: args{
+				s3: &fake.MockObjectClient{MockHeadObject: head("<html>old</html>"), MockGetObjectTagging: noTags},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content), withETag(etag), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ContentFromConfigMap": {
+			args: args{
+				s3: &fake.MockObjectClient{MockHeadObject: head(content), MockGetObjectTagging: noTags},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(o client.Object) error {
+						o.(*corev1.ConfigMap).BinaryData = map[string][]byte{"index": []byte(content)}
+						return nil
+					}),
+				},
+				cr: object(withContentFrom(&v1alpha3.ObjectContentSource{
+					ConfigMapKeyRef: &v1alpha3.ConfigMapKeySelector{Name: "site", Namespace: "default", Key: "index"},
+				})),
+			},
+			want: want{
+				cr: object(withContentFrom(&v1alpha3.ObjectContentSource{
+					ConfigMapKeyRef: &v1alpha3.ConfigMapKeySelector{Name: "site", Namespace: "default", Key: "index"},
+				}), withETag(etag), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ContentFromSecretMissingKey": {
+			args: args{
+				s3:   &fake.MockObjectClient{MockHeadObject: head(content), MockGetObjectTagging: noTags},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				cr: object(withContentFrom(&v1alpha3.ObjectContentSource{
+					SecretKeyRef: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "site", Namespace: "default"}, Key: "index"},
+				})),
+			},
+			want: want{
+				cr: object(withContentFrom(&v1alpha3.ObjectContentSource{
+					SecretKeyRef: &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "site", Namespace: "default"}, Key: "index"},
+				}), withETag(etag)),
+				err: errors.Errorf(errNoKey, "index", "Secret", "default", "site"),
+			},
+		},
+		"NoContent": {
+			args: args{
+				s3: &fake.MockObjectClient{MockHeadObject: head(content), MockGetObjectTagging: noTags},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(withETag(etag)),
+				err: errors.New(errNoContent),
+			},
+		},
+		"TaggingError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: head(content),
+					MockGetObjectTagging: func(_ context.Context, _ *awss3.GetObjectTaggingInput, _ []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr:  object(withContent(content), withETag(etag)),
+				err: awsclient.Wrap(errBoom, errGetTagging),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.s3, kube: tc.args.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(_ context.Context, input *awss3.PutObjectInput, _ []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						if input.Metadata[s3.MetadataKeyContentSHA256] != s3.ContentSHA256([]byte(content)) {
+							return nil, errBoom
+						}
+						return &awss3.PutObjectOutput{ETag: &etag}, nil
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content), withETag(etag), withConditions(xpv1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(_ context.Context, _ *awss3.PutObjectInput, _ []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr:  object(withContent(content), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+		"ConfigMapError": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr: object(withContentFrom(&v1alpha3.ObjectContentSource{
+					ConfigMapKeyRef: &v1alpha3.ConfigMapKeySelector{Name: "site", Namespace: "default", Key: "index"},
+				})),
+			},
+			want: want{
+				cr: object(withContentFrom(&v1alpha3.ObjectContentSource{
+					ConfigMapKeyRef: &v1alpha3.ConfigMapKeySelector{Name: "site", Namespace: "default", Key: "index"},
+				}), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errGetConfigMap),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.s3, kube: tc.args.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(_ context.Context, _ *awss3.DeleteObjectInput, _ []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return &awss3.DeleteObjectOutput{}, nil
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content), withConditions(xpv1.Deleting())),
+			},
+		},
+		"BucketNotFound": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(_ context.Context, _ *awss3.DeleteObjectInput, _ []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return nil, &s3types.NoSuchBucket{}
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content), withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(_ context.Context, _ *awss3.DeleteObjectInput, _ []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr:  object(withContent(content), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.s3, kube: tc.args.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -3,7 +3,9 @@ package testing
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	awss3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/smithy-go"
 
 	"github.com/crossplane/provider-aws/pkg/clients/s3"
@@ -11,6 +13,9 @@ import (
 	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
 )
 
+// OwnerID is the canonical user ID of the owner of the s3 bucket in testing
+const OwnerID = "owner"
+
 // Client creates a MockBucketClient with default request functions and an optional list of
 // ClientModifiers
 func Client(m ...ClientModifier) *fake.MockBucketClient {
@@ -66,6 +71,33 @@ func Client(m ...ClientModifier) *fake.MockBucketClient {
 		MockDeletePublicAccessBlock: func(ctx context.Context, input *awss3.DeletePublicAccessBlockInput, opts []func(*awss3.Options)) (*awss3.DeletePublicAccessBlockOutput, error) {
 			return &awss3.DeletePublicAccessBlockOutput{}, nil
 		},
+		MockGetBucketOwnershipControls: func(ctx context.Context, input *awss3.GetBucketOwnershipControlsInput, opts []func(*awss3.Options)) (*awss3.GetBucketOwnershipControlsOutput, error) {
+			return nil, &smithy.GenericAPIError{Code: clients3.OwnershipControlsNotFoundErrCode}
+		},
+		MockGetObjectLockConfiguration: func(ctx context.Context, input *awss3.GetObjectLockConfigurationInput, opts []func(*awss3.Options)) (*awss3.GetObjectLockConfigurationOutput, error) {
+			return nil, &smithy.GenericAPIError{Code: clients3.ObjectLockNotFoundErrCode}
+		},
+		MockGetBucketAcl: func(ctx context.Context, input *awss3.GetBucketAclInput, opts []func(*awss3.Options)) (*awss3.GetBucketAclOutput, error) {
+			return &awss3.GetBucketAclOutput{
+				Owner: &awss3types.Owner{ID: aws.String(OwnerID)},
+				Grants: []awss3types.Grant{{
+					Grantee:    &awss3types.Grantee{Type: awss3types.TypeCanonicalUser, ID: aws.String(OwnerID)},
+					Permission: awss3types.PermissionFullControl,
+				}},
+			}, nil
+		},
+		MockListBucketAnalyticsConfigurations: func(ctx context.Context, input *awss3.ListBucketAnalyticsConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketAnalyticsConfigurationsOutput, error) {
+			return &awss3.ListBucketAnalyticsConfigurationsOutput{}, nil
+		},
+		MockListBucketInventoryConfigurations: func(ctx context.Context, input *awss3.ListBucketInventoryConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketInventoryConfigurationsOutput, error) {
+			return &awss3.ListBucketInventoryConfigurationsOutput{}, nil
+		},
+		MockListBucketMetricsConfigurations: func(ctx context.Context, input *awss3.ListBucketMetricsConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketMetricsConfigurationsOutput, error) {
+			return &awss3.ListBucketMetricsConfigurationsOutput{}, nil
+		},
+		MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *awss3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*awss3.Options)) (*awss3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+			return &awss3.ListBucketIntelligentTieringConfigurationsOutput{}, nil
+		},
 	}
 	for _, v := range m {
 		v(client)
@@ -117,3 +149,17 @@ func WithPutACL(input func(ctx context.Context, input *awss3.PutBucketAclInput,
 		client.MockPutBucketAcl = input
 	}
 }
+
+// WithGetACL sets the MockGetBucketAclRequest of the mock S3 Client
+func WithGetACL(input func(ctx context.Context, input *awss3.GetBucketAclInput, opts []func(*awss3.Options)) (*awss3.GetBucketAclOutput, error)) ClientModifier {
+	return func(client *fake.MockBucketClient) {
+		client.MockGetBucketAcl = input
+	}
+}
+
+// WithGetTagging sets the MockGetBucketTagging of the mock S3 Client
+func WithGetTagging(input func(ctx context.Context, input *awss3.GetBucketTaggingInput, opts []func(*awss3.Options)) (*awss3.GetBucketTaggingOutput, error)) ClientModifier {
+	return func(client *fake.MockBucketClient) {
+		client.MockGetBucketTagging = input
+	}
+}
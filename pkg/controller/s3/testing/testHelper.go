@@ -48,6 +48,18 @@ func WithArn(arn string) BucketModifier {
 	}
 }
 
+// WithLocationConstraint sets the LocationConstraint for an S3 Bucket
+func WithLocationConstraint(l string) BucketModifier { //nolint
+	return func(bucket *v1beta1.Bucket) {
+		bucket.Spec.ForProvider.LocationConstraint = l
+	}
+}
+
+// WithAnnotations adds the annotations to an S3 Bucket
+func WithAnnotations(a map[string]string) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { meta.AddAnnotations(r, a) }
+}
+
 // WithConditions sets the Conditions for an S3 Bucket
 func WithConditions(c ...xpv1.Condition) BucketModifier { //nolint
 	return func(r *v1beta1.Bucket) { r.Status.ConditionedStatus.Conditions = c }
@@ -108,6 +120,31 @@ func WithNotificationConfig(s *v1beta1.NotificationConfiguration) BucketModifier
 	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.NotificationConfiguration = s }
 }
 
+// WithAnalyticsConfigs sets the AnalyticsConfigurations for an S3 Bucket
+func WithAnalyticsConfigs(s ...v1beta1.AnalyticsConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.AnalyticsConfigurations = s }
+}
+
+// WithInventoryConfigs sets the InventoryConfigurations for an S3 Bucket
+func WithInventoryConfigs(s ...v1beta1.InventoryConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.InventoryConfigurations = s }
+}
+
+// WithMetricsConfigs sets the MetricsConfigurations for an S3 Bucket
+func WithMetricsConfigs(s ...v1beta1.MetricsConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.MetricsConfigurations = s }
+}
+
+// WithIntelligentTieringConfigs sets the IntelligentTieringConfigurations for an S3 Bucket
+func WithIntelligentTieringConfigs(s ...v1beta1.IntelligentTieringConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.IntelligentTieringConfigurations = s }
+}
+
+// WithForceDestroy sets ForceDestroy for an S3 Bucket
+func WithForceDestroy(b bool) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.ForceDestroy = &b }
+}
+
 // Bucket creates a v1beta1 Bucket for use in testing
 func Bucket(m ...BucketModifier) *v1beta1.Bucket {
 	cr := &v1beta1.Bucket{
@@ -41,6 +41,8 @@ import (
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/secretsmanager/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	policyclient "github.com/crossplane/provider-aws/pkg/clients/policy"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -79,9 +81,9 @@ func SetupSecret(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, p
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&svcapitypes.Secret{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.SecretGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithPollInterval(poll),
@@ -140,15 +142,15 @@ func (e *hooks) isUpToDate(cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOu
 		return false, errors.Wrap(err, errGetResourcePolicy)
 	}
 	if pol.ResourcePolicy != nil && cr.Spec.ForProvider.ResourcePolicy != nil {
-		compactCurrentPolicy, err := awsclients.CompactAndEscapeJSON(awsclients.StringValue(pol.ResourcePolicy))
+		currentPolicy, err := policyclient.Canonicalize(awsclients.StringValue(pol.ResourcePolicy))
 		if err != nil {
 			return false, errors.Wrap(err, errInvalidCurrentPolicy)
 		}
-		compactSpecPolicy, err := awsclients.CompactAndEscapeJSON(awsclients.StringValue(cr.Spec.ForProvider.ResourcePolicy))
+		specPolicy, err := policyclient.Canonicalize(awsclients.StringValue(cr.Spec.ForProvider.ResourcePolicy))
 		if err != nil {
 			return false, errors.Wrap(err, errInvalidSpecPolicy)
 		}
-		if compactCurrentPolicy != compactSpecPolicy {
+		if currentPolicy != specPolicy {
 			return false, nil
 		}
 	} else if !(pol.ResourcePolicy == nil && cr.Spec.ForProvider.ResourcePolicy == nil) {
@@ -307,8 +309,9 @@ func (t *tagger) Initialize(ctx context.Context, mg resource.Managed) error {
 	for _, tags := range cr.Spec.ForProvider.Tags {
 		tagMap[awsclients.StringValue(tags.Key)] = awsclients.StringValue(tags.Value)
 	}
-	for k, v := range resource.GetExternalTags(mg) {
-		tagMap[k] = v
+	tagMap, err := awsclients.MergeDefaultTags(ctx, t.kube, mg, tagMap)
+	if err != nil {
+		return err
 	}
 	cr.Spec.ForProvider.Tags = make([]*svcapitypes.Tag, len(tagMap))
 	i := 0
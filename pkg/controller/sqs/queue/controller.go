@@ -41,6 +41,7 @@ import (
 	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/sqs"
+	"github.com/crossplane/provider-aws/pkg/controller/drift"
 )
 
 const (
@@ -53,6 +54,7 @@ const (
 	errGetQueueURLFailed        = "cannot get Queue URL"
 	errListQueueTagsFailed      = "cannot list Queue tags"
 	errUpdateFailed             = "failed to update the Queue resource"
+	errUpToDateFailed           = "cannot check whether the Queue is up to date"
 )
 
 // SetupQueue adds a controller that reconciles Queue.
@@ -65,9 +67,9 @@ func SetupQueue(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter, po
 			RateLimiter: ratelimiter.NewController(rl),
 		}).
 		For(&v1beta1.Queue{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(drift.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.QueueGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sqs.NewClient}),
+			drift.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sqs.NewClient}),
 			managed.WithPollInterval(poll),
 			managed.WithLogger(l.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
@@ -137,9 +139,14 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	cr.Status.AtProvider = sqs.GenerateQueueObservation(*getURLOutput.QueueUrl, resAttributes.Attributes)
 
+	upToDate, err := sqs.IsUpToDate(cr.Spec.ForProvider, resAttributes.Attributes, resTags.Tags)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  sqs.IsUpToDate(cr.Spec.ForProvider, resAttributes.Attributes, resTags.Tags),
+		ResourceUpToDate:  upToDate,
 		ConnectionDetails: sqs.GetConnectionDetails(*cr),
 	}, nil
 }
@@ -152,8 +159,13 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	cr.SetConditions(xpv1.Creating())
 
+	attributes, err := sqs.GenerateCreateAttributes(&cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateFailed)
+	}
+
 	resp, err := e.client.CreateQueue(ctx, &awssqs.CreateQueueInput{
-		Attributes: sqs.GenerateCreateAttributes(&cr.Spec.ForProvider),
+		Attributes: attributes,
 		QueueName:  aws.String(meta.GetExternalName(cr)),
 		Tags:       cr.Spec.ForProvider.Tags,
 	})
@@ -176,9 +188,26 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, nil
 	}
 
-	_, err := e.client.SetQueueAttributes(ctx, &awssqs.SetQueueAttributesInput{
+	attributes, err := sqs.GenerateQueueAttributes(&cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	}
+	if _, ok := attributes[v1beta1.AttributePolicy]; ok {
+		res, err := e.client.GetQueueAttributes(ctx, &awssqs.GetQueueAttributesInput{
+			QueueUrl:       aws.String(cr.Status.AtProvider.URL),
+			AttributeNames: []awssqstypes.QueueAttributeName{awssqstypes.QueueAttributeNamePolicy},
+		})
+		if err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errGetQueueAttributesFailed)
+		}
+		if err := sqs.KeepBucketStatements(attributes, res.Attributes[v1beta1.AttributePolicy]); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+		}
+	}
+
+	_, err = e.client.SetQueueAttributes(ctx, &awssqs.SetQueueAttributesInput{
 		QueueUrl:   aws.String(cr.Status.AtProvider.URL),
-		Attributes: sqs.GenerateQueueAttributes(&cr.Spec.ForProvider),
+		Attributes: attributes,
 	})
 	if err != nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateFailed)
@@ -332,6 +332,39 @@ func TestUpdate(t *testing.T) {
 				})),
 			},
 		},
+		"KeepBucketStatements": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockGetQueueAttributes: func(ctx context.Context, input *awssqs.GetQueueAttributesInput, opts []func(*awssqs.Options)) (*awssqs.GetQueueAttributesOutput, error) {
+						return &awssqs.GetQueueAttributesOutput{Attributes: map[string]string{
+							v1beta1.AttributePolicy: `{"Version":"2012-10-17","Statement":[{"Sid":"S3BucketNotification0123","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"sqs:SendMessage","Resource":"arn"}]}`,
+						}}, nil
+					},
+					MockSetQueueAttributes: func(ctx context.Context, input *awssqs.SetQueueAttributesInput, opts []func(*awssqs.Options)) (*awssqs.SetQueueAttributesOutput, error) {
+						want := `{"Statement":[{"Action":"sqs:SendMessage","Effect":"Deny","Resource":"arn"},{"Action":"sqs:SendMessage","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Resource":"arn","Sid":"S3BucketNotification0123"}],"Version":"2012-10-17"}`
+						if diff := cmp.Diff(want, input.Attributes[v1beta1.AttributePolicy]); diff != "" {
+							t.Errorf("SetQueueAttributes(...): -want policy, +got policy:\n%s", diff)
+						}
+						return &awssqs.SetQueueAttributesOutput{}, nil
+					},
+					MockListQueueTags: func(ctx context.Context, input *awssqs.ListQueueTagsInput, opts []func(*awssqs.Options)) (*awssqs.ListQueueTagsOutput, error) {
+						return &awssqs.ListQueueTagsOutput{}, nil
+					},
+				},
+				cr: queue(withSpec(v1beta1.QueueParameters{
+					Policy: awsclient.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"sqs:SendMessage","Resource":"arn"}]}`),
+				}), withStatus(v1beta1.QueueObservation{
+					URL: queueURL,
+				})),
+			},
+			want: want{
+				cr: queue(withSpec(v1beta1.QueueParameters{
+					Policy: awsclient.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"sqs:SendMessage","Resource":"arn"}]}`),
+				}), withStatus(v1beta1.QueueObservation{
+					URL: queueURL,
+				})),
+			},
+		},
 		"UpdateFailure": {
 			args: args{
 				sqs: &fake.MockSQSClient{